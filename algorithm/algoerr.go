@@ -30,12 +30,21 @@ var ErrWrongFractionRange = fmt.Errorf("Fraction is not in range (0.0 - 1.0]")
 // ErrWrongTolerance ...
 var ErrWrongTolerance = fmt.Errorf("Tolerance must be non-negative")
 
+// ErrWrongEdgeLength ...
+var ErrWrongEdgeLength = fmt.Errorf("Edge length must be non-negative")
+
+// ErrWrongSegmentLength ...
+var ErrWrongSegmentLength = fmt.Errorf("Segment length must be positive")
+
 // ErrWrongExponent ...
 var ErrWrongExponent = fmt.Errorf("Exponent out of bounds")
 
 // ErrComputeOffsetZero ...
 var ErrComputeOffsetZero = fmt.Errorf("Cannot compute offset from zero-length line segment")
 
+// ErrNoMeasure ...
+var ErrNoMeasure = fmt.Errorf("Geometry has no measure")
+
 // ErrEdgeTooFewPoint ...
 var ErrEdgeTooFewPoint = fmt.Errorf("Edge must have >= 2 points")
 
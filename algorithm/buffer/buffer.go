@@ -18,9 +18,21 @@ type ComputerBuffer struct {
 // Each offset curve has an attached  indicating
 // its left and right location.
 func Buffer(geom matrix.Steric, distance float64, quadrantSegments int) matrix.Steric {
+	param := DefaultCurveParameters()
+	param.QuadrantSegments = quadrantSegments
+	return BufferWithParams(geom, distance, param)
+}
+
+// BufferWithParams Computes the set of raw offset curves for the buffer
+// with the end cap style, join style, mitre limit and single side of the parameters.
+// Nil or empty parameters are the default parameters.
+// For a single-sided buffer of a line the sign of distance indicates the side, positive is left.
+func BufferWithParams(geom matrix.Steric, distance float64, param *CurveParameters) matrix.Steric {
 	eb := ComputerBuffer{}
-	eb.param = DefaultCurveParameters()
-	eb.param.QuadrantSegments = quadrantSegments
+	if param == nil || param.IsEmpty() {
+		param = DefaultCurveParameters()
+	}
+	eb.param = param
 	eb.distance = distance
 	eb.CurveBuilder = &CurveBuilder{
 		Curve: CurveWithParameters(eb.param, eb.distance),
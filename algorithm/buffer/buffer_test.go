@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/spatial-go/geoos"
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
@@ -125,3 +126,66 @@ func TestBuffer(t *testing.T) {
 		})
 	}
 }
+
+func TestBufferWithParams(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	type args struct {
+		geom     matrix.Steric
+		distance float64
+		params   *CurveParameters
+	}
+	tests := []struct {
+		name string
+		args args
+		want matrix.Steric
+	}{
+		{name: "flat cap mitre join", args: args{
+			geom:     line,
+			distance: 1,
+			params: &CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+				MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor},
+		}, want: matrix.PolygonMatrix{
+			{{9, 1}, {9, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 1}, {9, 1}},
+		},
+		},
+		{name: "square cap bevel join", args: args{
+			geom:     line,
+			distance: 1,
+			params: &CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapSquare, JoinStyle: calc.JoinBevel,
+				MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor},
+		}, want: matrix.PolygonMatrix{
+			{{9, 1}, {9, 10}, {9, 11}, {11, 11}, {11, 0}, {10, -1}, {0, -1}, {-1, -1}, {-1, 1}, {9, 1}},
+		},
+		},
+		{name: "single sided left", args: args{
+			geom:     line,
+			distance: 1,
+			params: &CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+				MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor, IsSingleSided: true},
+		}, want: matrix.PolygonMatrix{
+			{{10, 10}, {10, 0}, {0, 0}, {0, 1}, {9, 1}, {9, 10}, {10, 10}},
+		},
+		},
+		{name: "single sided right", args: args{
+			geom:     line,
+			distance: -1,
+			params: &CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+				MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor, IsSingleSided: true},
+		}, want: matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 0}},
+		},
+		},
+		{name: "nil params", args: args{
+			geom:     matrix.LineMatrix{{100, 100}, {300, 300}},
+			distance: 50,
+		}, want: Buffer(matrix.LineMatrix{{100, 100}, {300, 300}}, 50, calc.QuadrantSegments),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BufferWithParams(tt.args.geom, tt.args.distance, tt.args.params); got == nil || !got.EqualsExact(tt.want, 0.0000001) {
+				t.Errorf("BufferWithParams() = %v,\n want %v", got, tt.want)
+			}
+		})
+	}
+}
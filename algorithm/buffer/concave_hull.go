@@ -0,0 +1,394 @@
+package buffer
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/algorithm/triangulate"
+)
+
+// ConcaveHullComputer Computes the concave hull of a Geometry.
+// The concave hull is computed by eroding the Delaunay triangulation of the vertices from its border,
+// the border triangles of edges longer than the maximum edge length are removed (the chi-shape algorithm).
+// The hull is a polygon containing all the vertices, no triangle is removed if it disconnects the hull.
+// If the geometry has polygons, the triangulation is constrained by their rings and the triangles inside
+// the polygons are never removed, so the hull contains the polygons and fills the gaps between them.
+type ConcaveHullComputer struct {
+	geom            matrix.Steric
+	maxEdgeLength   float64
+	maxEdgeRatio    float64
+	isRatio         bool
+	isHolesAllowed  bool
+	vertices        matrix.LineMatrix
+	triangles       []*hullTriangle
+	borderEdgeCount []int
+}
+
+// hullTriangle a counter-clockwise triangle of the hull, adj[k] is the triangle across the edge from v[k] to v[k+1],
+// -1 if the edge is on the border.
+type hullTriangle struct {
+	v       [3]int
+	adj     [3]int
+	fixed   bool
+	removed bool
+}
+
+// ConcaveHullByLength Returns the concave hull of the geometry, the border edges longer than maxEdgeLength are removed.
+// A maxEdgeLength of 0 gives the most concave hull.
+func ConcaveHullByLength(geom matrix.Steric, maxEdgeLength float64, isHolesAllowed bool) matrix.Steric {
+	c := ConcaveHullWithGeom(geom)
+	c.SetMaximumEdgeLength(maxEdgeLength)
+	c.SetHolesAllowed(isHolesAllowed)
+	return c.ConcaveHull()
+}
+
+// ConcaveHullByLengthRatio Returns the concave hull of the geometry, the maximum edge length is the
+// ratio in [0,1] between the shortest and the longest edges of the triangulation.
+// A ratio of 1 gives the convex hull, a ratio of 0 gives the most concave hull.
+func ConcaveHullByLengthRatio(geom matrix.Steric, lengthRatio float64, isHolesAllowed bool) matrix.Steric {
+	c := ConcaveHullWithGeom(geom)
+	c.SetMaximumEdgeLengthRatio(lengthRatio)
+	c.SetHolesAllowed(isHolesAllowed)
+	return c.ConcaveHull()
+}
+
+// ConcaveHullWithGeom Create a new concave hull construction for the input geometry.
+func ConcaveHullWithGeom(geom matrix.Steric) *ConcaveHullComputer {
+	return &ConcaveHullComputer{geom: geom}
+}
+
+// SetMaximumEdgeLength sets the maximum length of the border edges of the hull.
+func (c *ConcaveHullComputer) SetMaximumEdgeLength(maxEdgeLength float64) {
+	c.maxEdgeLength, c.isRatio = maxEdgeLength, false
+}
+
+// SetMaximumEdgeLengthRatio sets the maximum length of the border edges of the hull as the ratio
+// in [0,1] between the shortest and the longest edges of the triangulation.
+func (c *ConcaveHullComputer) SetMaximumEdgeLengthRatio(lengthRatio float64) {
+	c.maxEdgeRatio, c.isRatio = lengthRatio, true
+}
+
+// SetHolesAllowed sets whether the hull may have holes, the holes are eroded from the interior triangles
+// with edges longer than the maximum edge length which do not touch the border.
+func (c *ConcaveHullComputer) SetHolesAllowed(isHolesAllowed bool) {
+	c.isHolesAllowed = isHolesAllowed
+}
+
+// ConcaveHull Returns a geometry that represents the concave hull of the input geometry.
+// In the general case it is a Polygon, if the vertices have no triangle it is the convex hull.
+func (c *ConcaveHullComputer) ConcaveHull() matrix.Steric {
+	c.createTriangles()
+	if len(c.triangles) == 0 {
+		return ConvexHull(c.geom)
+	}
+	maxEdgeLength := c.maxEdgeLength
+	if c.isRatio {
+		maxEdgeLength = c.edgeLengthOfRatio(c.maxEdgeRatio)
+	}
+	queue := &hullQueue{}
+	for i := range c.triangles {
+		queue.push(c, i)
+	}
+	c.erode(queue, maxEdgeLength)
+	if c.isHolesAllowed {
+		c.erodeHoles(maxEdgeLength)
+	}
+	return c.hullPolygon()
+}
+
+// createTriangles triangulates the vertices of geometry, constrained by the rings of its polygons.
+func (c *ConcaveHullComputer) createTriangles() {
+	sites, polygons := matrix.Collection{}, []matrix.PolygonMatrix{}
+	var add func(geom matrix.Steric)
+	add = func(geom matrix.Steric) {
+		switch st := geom.(type) {
+		case matrix.Matrix:
+			sites = append(sites, st)
+		case matrix.LineMatrix:
+			for _, v := range st {
+				sites = append(sites, matrix.Matrix(v))
+			}
+		case matrix.PolygonMatrix:
+			for _, ring := range st {
+				sites = append(sites, matrix.LineMatrix(ring))
+			}
+			if len(st) > 0 {
+				polygons = append(polygons, st)
+			}
+		case matrix.Collection:
+			for _, v := range st {
+				add(v)
+			}
+		}
+	}
+	add(c.geom)
+
+	index := map[[2]float64]int{}
+	vertexOf := func(p []float64) int {
+		key := [2]float64{p[0], p[1]}
+		if i, ok := index[key]; ok {
+			return i
+		}
+		index[key] = len(c.vertices)
+		c.vertices = append(c.vertices, p)
+		return index[key]
+	}
+	edges := map[[2]int]int{}
+	for _, v := range triangulate.ConstrainedDelaunayWithGeom(sites, 0).Triangles() {
+		ring := v.(matrix.PolygonMatrix)[0]
+		t := &hullTriangle{v: [3]int{vertexOf(ring[0]), vertexOf(ring[1]), vertexOf(ring[2])}, adj: [3]int{-1, -1, -1}}
+		centroid := matrix.Matrix{(ring[0][0] + ring[1][0] + ring[2][0]) / 3, (ring[0][1] + ring[1][1] + ring[2][1]) / 3}
+		t.fixed = isInPolygons(centroid, polygons)
+		for k := 0; k < 3; k++ {
+			edges[[2]int{t.v[k], t.v[(k+1)%3]}] = len(c.triangles)
+		}
+		c.triangles = append(c.triangles, t)
+	}
+	c.borderEdgeCount = make([]int, len(c.vertices))
+	for _, t := range c.triangles {
+		for k := 0; k < 3; k++ {
+			if j, ok := edges[[2]int{t.v[(k+1)%3], t.v[k]}]; ok {
+				t.adj[k] = j
+				continue
+			}
+			c.borderEdgeCount[t.v[k]]++
+			c.borderEdgeCount[t.v[(k+1)%3]]++
+		}
+	}
+}
+
+// isInPolygons returns true if the point is inside a polygon and not inside its holes.
+func isInPolygons(p matrix.Matrix, polygons []matrix.PolygonMatrix) bool {
+	for _, polygon := range polygons {
+		if !relate.InPolygon(p, polygon[0]) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if relate.InPolygon(p, hole) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// edgeLengthOfRatio returns the edge length of ratio between the shortest and the longest edges
+// of the triangles which are not fixed.
+func (c *ConcaveHullComputer) edgeLengthOfRatio(ratio float64) float64 {
+	minLength, maxLength := math.Inf(1), 0.0
+	for _, t := range c.triangles {
+		if t.fixed {
+			continue
+		}
+		for k := 0; k < 3; k++ {
+			length := c.edgeLength(t, k)
+			minLength, maxLength = math.Min(minLength, length), math.Max(maxLength, length)
+		}
+	}
+	if maxLength == 0 {
+		return 0
+	}
+	return minLength + ratio*(maxLength-minLength)
+}
+
+// edgeLength returns the length of the edge k of the triangle.
+func (c *ConcaveHullComputer) edgeLength(t *hullTriangle, k int) float64 {
+	p, q := c.vertices[t.v[k]], c.vertices[t.v[(k+1)%3]]
+	return math.Hypot(q[0]-p[0], q[1]-p[1])
+}
+
+// borderEdge returns the border edge of the triangle with one border edge and two adjacent triangles, or -1.
+func (c *ConcaveHullComputer) borderEdge(i int) int {
+	t, edge := c.triangles[i], -1
+	for k := 0; k < 3; k++ {
+		if t.adj[k] < 0 {
+			if edge >= 0 {
+				return -1
+			}
+			edge = k
+		}
+	}
+	return edge
+}
+
+// isRemovableBorder returns true if the triangle is not fixed and has one border edge,
+// and the vertex opposite the border edge is not on the border, so the hull stays connected and simple.
+func (c *ConcaveHullComputer) isRemovableBorder(i int) bool {
+	t := c.triangles[i]
+	if t.removed || t.fixed {
+		return false
+	}
+	k := c.borderEdge(i)
+	return k >= 0 && c.borderEdgeCount[t.v[(k+2)%3]] == 0
+}
+
+// remove removes the triangle, its edges shared with adjacent triangles become border edges.
+func (c *ConcaveHullComputer) remove(i int) {
+	t := c.triangles[i]
+	t.removed = true
+	for k := 0; k < 3; k++ {
+		a, b := t.v[k], t.v[(k+1)%3]
+		if t.adj[k] < 0 {
+			c.borderEdgeCount[a]--
+			c.borderEdgeCount[b]--
+			continue
+		}
+		c.borderEdgeCount[a]++
+		c.borderEdgeCount[b]++
+		adj := c.triangles[t.adj[k]]
+		for e := 0; e < 3; e++ {
+			if adj.adj[e] == i {
+				adj.adj[e] = -1
+			}
+		}
+	}
+}
+
+// erode removes the removable border triangles of the queue in order of decreasing border edge length,
+// while the border edge is longer than maxEdgeLength.
+func (c *ConcaveHullComputer) erode(queue *hullQueue, maxEdgeLength float64) {
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(hullItem)
+		k := c.borderEdge(item.triangle)
+		if k < 0 || c.edgeLength(c.triangles[item.triangle], k) != item.length {
+			continue
+		}
+		if item.length <= maxEdgeLength {
+			break
+		}
+		if !c.isRemovableBorder(item.triangle) {
+			continue
+		}
+		t := c.triangles[item.triangle]
+		c.remove(item.triangle)
+		for _, adj := range t.adj {
+			if adj >= 0 {
+				queue.push(c, adj)
+			}
+		}
+	}
+}
+
+// erodeHoles removes the interior triangles with an edge longer than maxEdgeLength in order of decreasing length,
+// which do not touch the border, then erodes the holes they leave.
+func (c *ConcaveHullComputer) erodeHoles(maxEdgeLength float64) {
+	candidates, longest := []int{}, make([]float64, len(c.triangles))
+	for i, t := range c.triangles {
+		for k := 0; k < 3; k++ {
+			longest[i] = math.Max(longest[i], c.edgeLength(t, k))
+		}
+		if !t.removed && !t.fixed && longest[i] > maxEdgeLength {
+			candidates = append(candidates, i)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return longest[candidates[i]] > longest[candidates[j]] })
+	for _, i := range candidates {
+		t := c.triangles[i]
+		if t.removed || t.adj[0] < 0 || t.adj[1] < 0 || t.adj[2] < 0 ||
+			c.borderEdgeCount[t.v[0]] > 0 || c.borderEdgeCount[t.v[1]] > 0 || c.borderEdgeCount[t.v[2]] > 0 {
+			continue
+		}
+		c.remove(i)
+		queue := &hullQueue{}
+		for _, adj := range t.adj {
+			queue.push(c, adj)
+		}
+		c.erode(queue, maxEdgeLength)
+	}
+}
+
+// hullPolygon returns the polygon of the border edges of the triangles left,
+// the counter-clockwise rings are the shells and the clockwise rings the holes.
+func (c *ConcaveHullComputer) hullPolygon() matrix.Steric {
+	next := map[int][]int{}
+	for _, t := range c.triangles {
+		if t.removed {
+			continue
+		}
+		for k := 0; k < 3; k++ {
+			if t.adj[k] < 0 {
+				next[t.v[k]] = append(next[t.v[k]], t.v[(k+1)%3])
+			}
+		}
+	}
+	starts := make([]int, 0, len(next))
+	for v := range next {
+		starts = append(starts, v)
+	}
+	sort.Ints(starts)
+
+	shells, holes := []matrix.PolygonMatrix{}, []matrix.LineMatrix{}
+	for _, start := range starts {
+		for len(next[start]) > 0 {
+			ring := matrix.LineMatrix{c.vertices[start]}
+			for v := start; ; {
+				w := next[v][0]
+				next[v] = next[v][1:]
+				ring = append(ring, c.vertices[w])
+				if v = w; v == start {
+					break
+				}
+			}
+			area := 0.0
+			for i := 1; i < len(ring); i++ {
+				area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+			}
+			if area > 0 {
+				shells = append(shells, matrix.PolygonMatrix{ring})
+			} else {
+				holes = append(holes, ring)
+			}
+		}
+	}
+	for _, hole := range holes {
+		for i, shell := range shells {
+			if relate.InPolygon(hole[0], shell[0]) || relate.InPolygon(hole[1], shell[0]) {
+				shells[i] = append(shell, hole)
+				break
+			}
+		}
+	}
+	if len(shells) == 1 {
+		return shells[0]
+	}
+	coll := matrix.Collection{}
+	for _, v := range shells {
+		coll = append(coll, v)
+	}
+	return coll
+}
+
+// hullItem a triangle in the queue with the length of its border edge.
+type hullItem struct {
+	triangle int
+	length   float64
+}
+
+// hullQueue a priority queue of triangles in order of decreasing border edge length.
+type hullQueue []hullItem
+
+func (q hullQueue) Len() int            { return len(q) }
+func (q hullQueue) Less(i, j int) bool  { return q[i].length > q[j].length }
+func (q hullQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *hullQueue) Push(x interface{}) { *q = append(*q, x.(hullItem)) }
+func (q *hullQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
+
+// push pushes the triangle if it has one border edge.
+func (q *hullQueue) push(c *ConcaveHullComputer, i int) {
+	if k := c.borderEdge(i); k >= 0 && !c.triangles[i].removed && !c.triangles[i].fixed {
+		heap.Push(q, hullItem{triangle: i, length: c.edgeLength(c.triangles[i], k)})
+	}
+}
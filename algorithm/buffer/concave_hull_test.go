@@ -0,0 +1,87 @@
+package buffer
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// gridPoints returns the points of the 11x11 grid out of the rectangle.
+func gridPoints(minX, minY, maxX, maxY int) matrix.LineMatrix {
+	points := matrix.LineMatrix{}
+	for i := 0; i <= 10; i++ {
+		for j := 0; j <= 10; j++ {
+			if i >= minX && i <= maxX && j >= minY && j <= maxY {
+				continue
+			}
+			points = append(points, matrix.Matrix{float64(i), float64(j)})
+		}
+	}
+	return points
+}
+
+func TestConcaveHullByLength(t *testing.T) {
+	polygons := matrix.Collection{
+		matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+		matrix.PolygonMatrix{{{6, 0}, {10, 0}, {10, 4}, {6, 4}, {6, 0}}},
+		matrix.PolygonMatrix{{{0, 6}, {4, 6}, {4, 10}, {0, 10}, {0, 6}}},
+	}
+	tests := []struct {
+		name          string
+		geom          matrix.Steric
+		maxEdgeLength float64
+		holes         bool
+		area          float64
+		rings         int
+	}{
+		{name: "C shape", geom: gridPoints(3, 3, 10, 7), maxEdgeLength: 1, area: 52, rings: 1},
+		{name: "C shape diagonal", geom: gridPoints(3, 3, 10, 7), maxEdgeLength: 1.5, area: 53, rings: 1},
+		{name: "C shape convex", geom: gridPoints(3, 3, 10, 7), maxEdgeLength: 100, area: 100, rings: 1},
+		{name: "no hole", geom: gridPoints(3, 3, 7, 7), maxEdgeLength: 1.5, area: 100, rings: 1},
+		{name: "hole", geom: gridPoints(3, 3, 7, 7), maxEdgeLength: 1.5, holes: true, area: 66, rings: 2},
+		{name: "polygons connected", geom: polygons, maxEdgeLength: 0, area: 64, rings: 1},
+		{name: "polygons", geom: polygons, maxEdgeLength: 10, area: 82, rings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ConcaveHullByLength(tt.geom, tt.maxEdgeLength, tt.holes).(matrix.PolygonMatrix)
+			if !ok || len(got) != tt.rings {
+				t.Fatalf("ConcaveHullByLength() = %v, want polygon of %v rings", got, tt.rings)
+			}
+			if area := measure.AreaOfPolygon(got); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("ConcaveHullByLength() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestConcaveHullByLengthRatio(t *testing.T) {
+	tests := []struct {
+		name  string
+		geom  matrix.Steric
+		ratio float64
+		want  matrix.Steric
+		area  float64
+	}{
+		{name: "most concave", geom: gridPoints(3, 3, 10, 7), ratio: 0, area: 52},
+		{name: "convex", geom: gridPoints(3, 3, 10, 7), ratio: 1, area: 100},
+		{name: "collinear", geom: matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}, ratio: 0, want: matrix.LineMatrix{{0, 0}, {2, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConcaveHullByLengthRatio(tt.geom, tt.ratio, false)
+			if tt.want != nil {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("ConcaveHullByLengthRatio() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if area := measure.AreaOfPolygon(got.(matrix.PolygonMatrix)); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("ConcaveHullByLengthRatio() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
@@ -89,9 +89,10 @@ func (c *ConvexHullComputer) reduce() []matrix.Matrix {
 	for _, v := range polyPts {
 		ls = append(ls, v)
 	}
+	// the points inside the octagon are not in the hull, the points of octagon are kept.
 	reducedSet := list.New()
 	for _, v := range c.inputPts {
-		if !relate.InLineMatrix(v, ls) {
+		if relate.InLineMatrix(v, ls) || !relate.InPolygon(v, ls) {
 			reducedSet.PushBack(v)
 		}
 	}
@@ -1,6 +1,7 @@
 package buffer
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -18,6 +19,9 @@ func TestConvexHull(t *testing.T) {
 	}{
 		{"convexHull", args{matrix.PolygonMatrix{{{1, 1}, {3, 1}, {2, 2}, {3, 3}, {1, 3}, {1, 1}}}},
 			matrix.PolygonMatrix{{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}}},
+		{"convexHull points", args{matrix.Collection{matrix.Matrix{1, 1}, matrix.Matrix{3, 1}, matrix.Matrix{2, 2},
+			matrix.Matrix{3, 3}, matrix.Matrix{1, 3}, matrix.Matrix{1, 1}}},
+			matrix.PolygonMatrix{{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -28,6 +32,42 @@ func TestConvexHull(t *testing.T) {
 	}
 }
 
+func TestConvexHull_Reduce(t *testing.T) {
+	// more than 50 points are reduced by the octagon of the extreme points before the scan.
+	grid, diamond := matrix.Collection{}, matrix.Collection{}
+	for i := -5; i <= 5; i++ {
+		for j := -5; j <= 5; j++ {
+			grid = append(grid, matrix.Matrix{float64(i), float64(j)})
+			if math.Abs(float64(i))+math.Abs(float64(j)) <= 5 {
+				diamond = append(diamond, matrix.Matrix{float64(i), float64(j)})
+			}
+		}
+	}
+	tests := []struct {
+		name string
+		geom matrix.Steric
+		area float64
+	}{
+		{"grid", grid, 100},
+		{"diamond", diamond, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hull, ok := ConvexHull(tt.geom).(matrix.PolygonMatrix)
+			if !ok {
+				t.Fatalf("ConvexHull() = %v, want a polygon", hull)
+			}
+			area := 0.0
+			for i := 1; i < len(hull[0]); i++ {
+				area += hull[0][i-1][0]*hull[0][i][1] - hull[0][i][0]*hull[0][i-1][1]
+			}
+			if math.Abs(math.Abs(area/2)-tt.area) > 1e-9 {
+				t.Errorf("ConvexHull() = %v, want area %v", hull, tt.area)
+			}
+		})
+	}
+}
+
 func TestConvexHullComputer_ConvexHull(t *testing.T) {
 	type fields struct {
 		inputPts []matrix.Matrix
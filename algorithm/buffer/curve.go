@@ -147,9 +147,7 @@ func (c *Curve) AddLineEndCap(p0, p1 matrix.Matrix, distance float64) {
 		c.Add(offsetR.P1)
 	case calc.CapSquare:
 		// add a square defined by extensions of the offset segment endpoints
-		squareCapSideOffset := matrix.Matrix{}
-		squareCapSideOffset[0] = math.Abs(distance) * math.Cos(angle)
-		squareCapSideOffset[1] = math.Abs(distance) * math.Sin(angle)
+		squareCapSideOffset := matrix.Matrix{math.Abs(distance) * math.Cos(angle), math.Abs(distance) * math.Sin(angle)}
 
 		squareCapLOffset := matrix.Matrix{
 			offsetL.P1[0] + squareCapSideOffset[0],
@@ -405,14 +403,14 @@ func (c *Curve) addMitreJoin(p matrix.Matrix,
 	// This computation is unstable if the offset segments are nearly collinear.
 	// However, this situation should have been eliminated earlier by the check
 	// for whether the offset segment endpoints are almost coincident
-	_, intPt := relate.Intersection(c.offset0.P0, c.offset0.P1, offset1.P0, offset1.P1)
-	if intPt != nil {
+	// the offset segments of an outside turn do not reach each other, so the lines of them are intersected.
+	if intPt, ok := lineIntersection(offset0, offset1); ok {
 		mitreRatio := 1.0
 		if distance > 0.0 {
-			mitreRatio = measure.PlanarDistance(intPt[0].Matrix, p) / math.Abs(distance)
+			mitreRatio = measure.PlanarDistance(intPt, p) / math.Abs(distance)
 		}
 		if mitreRatio <= c.parameters.MitreLimit {
-			c.Add(intPt[0].Matrix)
+			c.Add(intPt)
 			return
 		}
 	}
@@ -421,6 +419,19 @@ func (c *Curve) addMitreJoin(p matrix.Matrix,
 	//      addBevelJoin(offset0, offset1);
 }
 
+// lineIntersection returns the intersection point of the lines through the segments,
+// it returns false if the lines are parallel.
+func lineIntersection(l, o *matrix.LineSegment) (matrix.Matrix, bool) {
+	dx0, dy0 := l.P1[0]-l.P0[0], l.P1[1]-l.P0[1]
+	dx1, dy1 := o.P1[0]-o.P0[0], o.P1[1]-o.P0[1]
+	denom := dx0*dy1 - dy0*dx1
+	if denom == 0 || math.IsNaN(denom) {
+		return nil, false
+	}
+	t := ((o.P0[0]-l.P0[0])*dy1 - (o.P0[1]-l.P0[1])*dx1) / denom
+	return matrix.Matrix{l.P0[0] + t*dx0, l.P0[1] + t*dy0}, true
+}
+
 // Adds a limited mitre join connecting the two reflex offset segments.
 // A limited mitre is a mitre which is beveled at the distance
 // determined by the mitre ratio limit.
@@ -180,7 +180,12 @@ func (c *CurveBuilder) computeLineBufferCurve(pts matrix.LineMatrix) {
 }
 
 func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRightSide bool) {
-	distTol := c.distance * c.parameters.SimplifyFactor
+	// the side is given by the sign of distance,
+	// the offset is computed on the left of the direction of traversal at the positive distance.
+	c.Curve.distance = math.Abs(c.distance)
+	c.Curve.minimimVertexDistance = c.Curve.distance * calc.CurveVertexSnapDistanceFactor
+	distTol := c.Curve.distance * c.parameters.SimplifyFactor
+	simp := &LineSimplifier{inputLine: pts}
 
 	if isRightSide {
 		// add original line
@@ -188,32 +193,25 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 
 		//---------- compute points for right side of line
 		// Simplify the appropriate side of the line before generating
-		simp := &LineSimplifier{inputLine: pts}
 		simp2 := simp.Simplify(-distTol)
-		// MD - used for testing only (to eliminate simplification)
-		//    Coordinate[] simp2 = inputPts;
 		n2 := len(simp2) - 1
 
 		// since we are traversing line in opposite order, offset position is still LEFT
 		c.Curve.initSideSegments(simp2[n2], simp2[n2-1], calc.SideLeft)
-		c.Curve.Add(c.Curve.offset1.P1)
+		c.Curve.Add(c.Curve.offset1.P0)
 		for i := n2 - 2; i >= 0; i-- {
 			c.Curve.addNextSegment(simp2[i], true)
 		}
 	} else {
-		// add original line
-		c.Curve.AddLine(pts)
+		// add original line in reverse order, the offset follows it from the start of line
+		c.Curve.AddLine(reverseRing(pts))
 
 		//--------- compute points for left side of line
 		// Simplify the appropriate side of the line before generating
-		simp := &LineSimplifier{inputLine: pts}
 		simp1 := simp.Simplify(distTol)
-
-		// MD - used for testing only (to eliminate simplification)
-		//      Coordinate[] simp1 = inputPts;
 		n1 := len(simp1) - 1
 		c.Curve.initSideSegments(simp1[0], simp1[1], calc.SideLeft)
-		c.Curve.Add(c.Curve.offset1.P1)
+		c.Curve.Add(c.Curve.offset1.P0)
 		for i := 2; i <= n1; i++ {
 			c.Curve.addNextSegment(simp1[i], true)
 		}
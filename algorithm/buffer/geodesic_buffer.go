@@ -0,0 +1,331 @@
+package buffer
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// geodesicSegment a segment of the offset curve, the azimuths are at its start and end, unit degree.
+type geodesicSegment struct {
+	from, to     matrix.Matrix
+	length       float64
+	azi1, azi2   float64
+	interpolated int
+}
+
+// GeodesicBuffer computes the buffer of the geometry of lng lat on the ellipsoid of the geodesic,
+// it returns the raw offset curves as the planar Buffer, distance unit m.
+// Offset points are computed by geodesic direct from points of the geometry,
+// perpendicular to the geodesics of segments and around vertices in fillets of quadrantSegments facets per 90 degrees.
+// Longitudes of the curves are in [-180, 180], so a curve crossing the antimeridian or enclosing a pole
+// jumps at the antimeridian and needs to be cut.
+func GeodesicBuffer(geom matrix.Steric, distance float64, quadrantSegments int, g *measure.Geodesic) matrix.Steric {
+	if geom == nil || geom.IsEmpty() {
+		return nil
+	}
+	if quadrantSegments < 1 {
+		quadrantSegments = calc.QuadrantSegments
+	}
+	b := &geodesicBuffer{geodesic: g, distance: math.Abs(distance), angleInc: 90 / float64(quadrantSegments)}
+	// offset curves are densified so that they follow the parallel curves of geodesics.
+	b.maxSegment = measure.R * b.angleInc * math.Pi / 180 / 4
+	switch st := geom.(type) {
+	case matrix.Matrix:
+		if distance <= 0 {
+			return nil
+		}
+		return matrix.PolygonMatrix{b.circle(st)}
+	case matrix.LineMatrix:
+		if distance <= 0 {
+			return nil
+		}
+		return matrix.PolygonMatrix{b.lineCurve(st)}
+	case matrix.PolygonMatrix:
+		return b.polygonCurve(st, distance > 0)
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range st {
+			if result := GeodesicBuffer(v, distance, quadrantSegments, g); result != nil {
+				coll = append(coll, result)
+			}
+		}
+		if len(coll) == 0 {
+			return nil
+		}
+		return coll
+	}
+	return nil
+}
+
+// geodesicBuffer builds the offset curves on the left side of the direction of travel.
+type geodesicBuffer struct {
+	geodesic             *measure.Geodesic
+	distance             float64
+	angleInc, maxSegment float64
+}
+
+// offset returns the point at the buffer distance from p in the azimuth.
+func (b *geodesicBuffer) offset(p matrix.Matrix, azimuth float64) matrix.Matrix {
+	q, _ := b.geodesic.Direct(p, azimuth, b.distance)
+	return q
+}
+
+// fillet returns the offset points around p turning clockwise from the azimuth by the angle,
+// excluding the start and including the end.
+func (b *geodesicBuffer) fillet(p matrix.Matrix, azimuth, angle float64) matrix.LineMatrix {
+	n := int(math.Ceil(angle/b.angleInc - 1e-9))
+	points := make(matrix.LineMatrix, 0, n)
+	for i := 1; i <= n; i++ {
+		points = append(points, b.offset(p, azimuth+angle*float64(i)/float64(n)))
+	}
+	return points
+}
+
+// circle returns the offset curve of a point.
+func (b *geodesicBuffer) circle(p matrix.Matrix) matrix.LineMatrix {
+	ring := matrix.LineMatrix{b.offset(p, 0)}
+	ring = append(ring, b.fillet(p, 0, 360)...)
+	ring[len(ring)-1] = ring[0]
+	return ring
+}
+
+// segments returns the geodesic segments of the line, skipping repeated points.
+func (b *geodesicBuffer) segments(line matrix.LineMatrix) []geodesicSegment {
+	segments := []geodesicSegment{}
+	for i := 1; i < len(line); i++ {
+		s12, azi1, azi2 := b.geodesic.Inverse(line[i-1], line[i])
+		if s12 == 0 {
+			continue
+		}
+		segments = append(segments, geodesicSegment{from: line[i-1], to: line[i], length: s12, azi1: azi1, azi2: azi2,
+			interpolated: int(math.Ceil(s12 / b.maxSegment))})
+	}
+	return segments
+}
+
+// join returns the offset points at the vertex between the segments, including the offset start of next.
+// The outside turn is joined by a fillet, the inside turn by the point where the offset curves meet,
+// which replaces the offset end of prev, or through the vertex if the segments are too short to meet.
+func (b *geodesicBuffer) join(prev, next geodesicSegment) (points matrix.LineMatrix, replaceEnd bool) {
+	turn := math.Remainder(next.azi1-prev.azi2, 360)
+	if turn >= 0 {
+		return b.fillet(next.from, prev.azi2-90, turn), false
+	}
+	half := -turn / 2 * math.Pi / 180
+	if mitre := b.distance * math.Tan(half); half < math.Pi/2-1e-6 && mitre <= prev.length && mitre <= next.length {
+		q, _ := b.geodesic.Direct(next.from, prev.azi2-90+turn/2, b.distance/math.Cos(half))
+		return matrix.LineMatrix{q}, true
+	}
+	return matrix.LineMatrix{next.from, b.offset(next.from, next.azi1-90)}, false
+}
+
+// along returns the offset points along the segment excluding its start.
+func (b *geodesicBuffer) along(seg geodesicSegment) matrix.LineMatrix {
+	points := make(matrix.LineMatrix, 0, seg.interpolated)
+	for i := 1; i < seg.interpolated; i++ {
+		p, azi := b.geodesic.Direct(seg.from, seg.azi1, seg.length*float64(i)/float64(seg.interpolated))
+		points = append(points, b.offset(p, azi-90))
+	}
+	return append(points, b.offset(seg.to, seg.azi2-90))
+}
+
+// sideCurve returns the offset points of the left side of the segments, joined at their vertices.
+func (b *geodesicBuffer) sideCurve(segments []geodesicSegment) matrix.LineMatrix {
+	curve := matrix.LineMatrix{b.offset(segments[0].from, segments[0].azi1-90)}
+	for i, seg := range segments {
+		if i > 0 {
+			joint, replaceEnd := b.join(segments[i-1], seg)
+			if replaceEnd {
+				curve = curve[:len(curve)-1]
+			}
+			curve = append(curve, joint...)
+		}
+		curve = append(curve, b.along(seg)...)
+	}
+	return curve
+}
+
+// lineCurve returns the offset curve around the line with round caps.
+func (b *geodesicBuffer) lineCurve(line matrix.LineMatrix) matrix.LineMatrix {
+	forward := b.segments(line)
+	if len(forward) == 0 {
+		return b.circle(line[0])
+	}
+	backward := b.segments(reverseRing(line))
+	last, first := forward[len(forward)-1], backward[len(backward)-1]
+
+	curve := b.sideCurve(forward)
+	end := b.fillet(last.to, last.azi2-90, 180)
+	curve = append(curve, end[:len(end)-1]...)
+	curve = append(curve, b.sideCurve(backward)...)
+	end = b.fillet(first.to, first.azi2-90, 180)
+	curve = append(curve, end[:len(end)-1]...)
+	return append(curve, curve[0])
+}
+
+// ringCurve returns the offset curve of the left side of the closed ring.
+func (b *geodesicBuffer) ringCurve(ring matrix.LineMatrix) matrix.LineMatrix {
+	segments := b.segments(ring)
+	if len(segments) == 0 {
+		return b.circle(ring[0])
+	}
+	curve := b.sideCurve(segments)
+	// join the end of ring to its start, the offset start of ring is the last point of the joint.
+	joint, replaceEnd := b.join(segments[len(segments)-1], segments[0])
+	switch {
+	case replaceEnd:
+		curve = append(curve[1:len(curve)-1], joint...)
+	case len(joint) > 0:
+		curve = append(curve, joint[:len(joint)-1]...)
+	default:
+		curve = curve[:len(curve)-1]
+	}
+	return append(curve, curve[0])
+}
+
+// polygonCurve returns the offset curves of the shell outside and holes inside if outside, otherwise reversely.
+// Rings collapsed by shrinking are dropped, the polygon is empty if the shell collapsed.
+func (b *geodesicBuffer) polygonCurve(polygon matrix.PolygonMatrix, outside bool) matrix.Steric {
+	result := matrix.PolygonMatrix{}
+	for i, ring := range polygon {
+		ring = dropPoleSpikes(ring)
+		if len(ring) < 4 {
+			if i == 0 {
+				return nil
+			}
+			continue
+		}
+		// the left side is outside of a clockwise shell or inside of a counter-clockwise hole.
+		ccw := (i == 0) != outside
+		if b.isCCW(ring) != ccw {
+			ring = reverseRing(ring)
+		}
+		curve := b.ringCurve(ring)
+		// the counter-clockwise ring shrinks.
+		if ccw && b.isInverted(ring, curve) {
+			if i == 0 {
+				return nil
+			}
+			continue
+		}
+		result = append(result, curve)
+	}
+	return result
+}
+
+// isInverted returns true if no point of the offset curve of the shrinking ring is inside the ring
+// at the buffer distance, the curve is inverted since the ring collapsed.
+// A counter-clockwise ring enclosing a pole goes east around the north pole or west around the south pole,
+// it is closed along the pole to test the points inside.
+func (b *geodesicBuffer) isInverted(ring, curve matrix.LineMatrix) bool {
+	tolerance := b.distance * calc.NearnessFactor
+	unwrapped := unwrap(ring)
+	first, last := unwrapped[0], unwrapped[len(unwrapped)-1]
+	net := last[0] - first[0]
+	if math.Abs(net) > 180 {
+		pole := math.Copysign(90, net)
+		unwrapped = append(unwrapped, matrix.Matrix{last[0], pole}, matrix.Matrix{first[0], pole}, first)
+	}
+	for _, p := range curve {
+		// the longitude of p in the range of the ring.
+		lng := first[0] + math.Remainder(p[0]-first[0], 360)
+		if net > 180 {
+			lng = first[0] + positiveMod(p[0]-first[0], 360)
+		} else if net < -180 {
+			lng = first[0] - positiveMod(first[0]-p[0], 360)
+		}
+		if relate.InPolygon(matrix.Matrix{lng, p[1]}, unwrapped) && b.distanceToLine(ring, p) > tolerance {
+			return false
+		}
+	}
+	return true
+}
+
+// distanceToLine returns the distance from p to the line, the distance to segments is approximated on the sphere.
+func (b *geodesicBuffer) distanceToLine(line matrix.LineMatrix, p matrix.Matrix) float64 {
+	dist := math.Inf(1)
+	for i := 1; i < len(line); i++ {
+		s12, azi12, _ := b.geodesic.Inverse(line[i-1], line[i])
+		s13, azi13, _ := b.geodesic.Inverse(line[i-1], p)
+		delta, angle := s13/measure.R, (azi13-azi12)*math.Pi/180
+		// along track and cross track distances.
+		if along := math.Atan2(math.Sin(delta)*math.Cos(angle), math.Cos(delta)) * measure.R; along > 0 && along < s12 {
+			dist = math.Min(dist, math.Abs(math.Asin(math.Sin(delta)*math.Sin(angle)))*measure.R)
+		} else {
+			dist = math.Min(dist, math.Min(s13, b.geodesic.Distance(line[i], p)))
+		}
+	}
+	return dist
+}
+
+// isCCW returns true if the ring of lng lat is counter-clockwise, its signed geodesic area is positive,
+// so a ring enclosing a pole or crossing the antimeridian turns around the smaller area it encloses.
+func (b *geodesicBuffer) isCCW(ring matrix.LineMatrix) bool {
+	area, _ := b.geodesic.RingAreaPerimeter(ring)
+	return area > 0
+}
+
+// positiveMod returns x modulo y in [0, y).
+func positiveMod(x, y float64) float64 {
+	return math.Mod(math.Mod(x, y)+y, y)
+}
+
+// dropPoleSpikes returns the ring without the vertices at a pole between vertices on the same meridian,
+// as the edges closing RFC 7946 rings along a pole, which go to the pole and back along the antimeridian.
+func dropPoleSpikes(ring matrix.LineMatrix) matrix.LineMatrix {
+	n := len(ring) - 1
+	start := -1
+	for i := 0; i < n && start < 0; i++ {
+		if math.Abs(ring[i][1]) != 90 {
+			start = i
+		}
+	}
+	if start < 0 {
+		return ring
+	}
+	dropped := matrix.LineMatrix{}
+	for k := 0; k < n; {
+		if math.Abs(ring[(start+k)%n][1]) != 90 {
+			dropped = append(dropped, ring[(start+k)%n])
+			k++
+			continue
+		}
+		end := k
+		for end < n && math.Abs(ring[(start+end)%n][1]) == 90 {
+			end++
+		}
+		if prev, next := ring[(start+k-1)%n], ring[(start+end)%n]; math.Remainder(prev[0]-next[0], 360) != 0 {
+			for ; k < end; k++ {
+				dropped = append(dropped, ring[(start+k)%n])
+			}
+		}
+		k = end
+	}
+	return append(dropped, dropped[0])
+}
+
+// unwrap returns the line of lng lat with longitudes continuous across the antimeridian.
+func unwrap(line matrix.LineMatrix) matrix.LineMatrix {
+	unwrapped := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		p := matrix.Matrix{v[0], v[1]}
+		if i > 0 {
+			prev := unwrapped[i-1][0]
+			p[0] = prev + math.Remainder(p[0]-prev, 360)
+		}
+		unwrapped[i] = p
+	}
+	return unwrapped
+}
+
+func reverseRing(ring matrix.LineMatrix) matrix.LineMatrix {
+	reversed := make(matrix.LineMatrix, len(ring))
+	for i, v := range ring {
+		reversed[len(ring)-1-i] = v
+	}
+	return reversed
+}
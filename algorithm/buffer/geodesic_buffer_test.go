@@ -0,0 +1,130 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestGeodesicBuffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		geom     matrix.Steric
+		distance float64
+		quadsegs int
+		points   int
+	}{
+		{"point", matrix.Matrix{116, 40}, 1000, 8, 33},
+		{"point quadsegs", matrix.Matrix{116, 40}, 1000, 2, 9},
+		{"point high latitude", matrix.Matrix{30, 85}, 100000, 8, 33},
+		{"point across antimeridian", matrix.Matrix{179.9, -20}, 50000, 8, 33},
+		{"line", matrix.LineMatrix{{0, 0}, {1, 0}, {1, 1}}, 10000, 8, 0},
+		{"polygon", matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, 10000, 8, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GeodesicBuffer(tt.geom, tt.distance, tt.quadsegs, measure.WGS84Geodesic).(matrix.PolygonMatrix)
+			if !ok || len(got) != 1 {
+				t.Fatalf("GeodesicBuffer() = %v", got)
+			}
+			if tt.points > 0 && len(got[0]) != tt.points {
+				t.Errorf("GeodesicBuffer() got %v points, want %v", len(got[0]), tt.points)
+			}
+			for _, v := range got[0] {
+				if d := distanceTo(tt.geom, v); math.Abs(d-tt.distance) > tt.distance*1e-6 {
+					t.Errorf("GeodesicBuffer() point %v at distance %v, want %v", v, d, tt.distance)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestGeodesicBuffer_Polygon(t *testing.T) {
+	square := matrix.PolygonMatrix{
+		{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+		{{0.4, 0.4}, {0.6, 0.4}, {0.6, 0.6}, {0.4, 0.6}, {0.4, 0.4}},
+	}
+	if got := GeodesicBuffer(square, 10000, 8, measure.WGS84Geodesic).(matrix.PolygonMatrix); len(got) != 2 {
+		t.Errorf("GeodesicBuffer() got %v rings, want %v", len(got), 2)
+	}
+	if got := GeodesicBuffer(square, 100000, 8, measure.WGS84Geodesic).(matrix.PolygonMatrix); len(got) != 1 {
+		t.Errorf("GeodesicBuffer() got %v rings, want the hole collapsed", len(got))
+	}
+	if got := GeodesicBuffer(square, -100000, 8, measure.WGS84Geodesic); got != nil {
+		t.Errorf("GeodesicBuffer() = %v, want the polygon collapsed", got)
+	}
+	if got := GeodesicBuffer(matrix.Matrix{0, 0}, -100, 8, measure.WGS84Geodesic); got != nil {
+		t.Errorf("GeodesicBuffer() = %v, want nil", got)
+	}
+}
+
+func TestGeodesicBuffer_PoleAndAntimeridian(t *testing.T) {
+	tests := []struct {
+		name    string
+		polygon matrix.PolygonMatrix
+	}{
+		{"ring around north pole", matrix.PolygonMatrix{{{0, 80}, {90, 80}, {170, 80}, {-90, 80}, {0, 80}}}},
+		{"ring around south pole", matrix.PolygonMatrix{{{0, -80}, {-90, -80}, {-170, -80}, {90, -80}, {0, -80}}}},
+		{"ring closed along north pole",
+			matrix.PolygonMatrix{{{-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80}, {180, 90}, {-180, 90}, {-180, 80}}}},
+		{"ring closed along south pole",
+			matrix.PolygonMatrix{{{-180, -80}, {-90, -75}, {0, -70}, {90, -75}, {180, -80}, {180, -90}, {-180, -90}, {-180, -80}}}},
+		{"ring across antimeridian", matrix.PolygonMatrix{{{170, 0}, {-170, 0}, {-170, 10}, {170, 10}, {170, 0}}}},
+	}
+	g := measure.WGS84Geodesic
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area, _ := g.PolygonArea(tt.polygon)
+			_, perimeter := g.PolygonArea(matrix.PolygonMatrix{dropPoleSpikes(tt.polygon[0])})
+			for _, distance := range []float64{100000, -100000} {
+				got, ok := GeodesicBuffer(tt.polygon, distance, 8, measure.WGS84Geodesic).(matrix.PolygonMatrix)
+				if !ok || len(got) != 1 {
+					t.Fatalf("GeodesicBuffer(%v) = %v", distance, got)
+				}
+				// the area changes by about the perimeter times the distance.
+				gotArea, _ := g.PolygonArea(got)
+				if change := (gotArea - area) / (perimeter * distance); change < 0.8 || change > 1.1 {
+					t.Errorf("GeodesicBuffer(%v) area = %v, want about %v", distance, gotArea, area+perimeter*distance)
+				}
+			}
+		})
+	}
+}
+
+// distanceTo returns the minimum geodesic distance from p to the vertices and densified edges of geom.
+func distanceTo(geom matrix.Steric, p matrix.Matrix) float64 {
+	g := measure.WGS84Geodesic
+	dist := math.Inf(1)
+	for _, line := range lines(geom) {
+		if len(line) == 1 {
+			dist = math.Min(dist, g.Distance(line[0], p))
+		}
+		for i := 1; i < len(line); i++ {
+			s12, azi1, _ := g.Inverse(line[i-1], line[i])
+			for j := 0; j <= 2000; j++ {
+				q, _ := g.Direct(line[i-1], azi1, s12*float64(j)/2000)
+				dist = math.Min(dist, g.Distance(q, p))
+			}
+		}
+	}
+	return dist
+}
+
+func lines(geom matrix.Steric) []matrix.LineMatrix {
+	switch m := geom.(type) {
+	case matrix.Matrix:
+		return []matrix.LineMatrix{{m}}
+	case matrix.LineMatrix:
+		return []matrix.LineMatrix{m}
+	case matrix.PolygonMatrix:
+		rings := []matrix.LineMatrix{}
+		for _, v := range m {
+			rings = append(rings, v)
+		}
+		return rings
+	}
+	return nil
+}
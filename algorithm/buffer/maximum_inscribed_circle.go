@@ -0,0 +1,203 @@
+package buffer
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// defaultToleranceFactor the tolerance of a circle search relative to the larger extent of its envelope
+// if no tolerance is given.
+const defaultToleranceFactor = 1e-3
+
+// MaximumInscribedCircle returns the centre and radius of the largest circle inside the polygons of geometry,
+// its centre is the pole of inaccessibility, the interior point farthest from the boundary.
+// The centre is found within tolerance by the polylabel algorithm, a tolerance of 0 is a thousandth of
+// the larger extent of the geometry. It returns nil and 0 if the geometry has no polygon.
+func MaximumInscribedCircle(geom matrix.Steric, tolerance float64) (matrix.Matrix, float64) {
+	polygons := polygonsOf(geom)
+	env := envelope.Empty()
+	for _, polygon := range polygons {
+		for _, v := range polygon[0] {
+			env.ExpandToIncludeMatrix(v)
+		}
+	}
+	if env.IsNil() {
+		return nil, 0
+	}
+	distance := func(p matrix.Matrix) float64 {
+		d := distanceToRings(p, polygons)
+		if !isInPolygons(p, polygons) {
+			return -d
+		}
+		return d
+	}
+	return circleSearch(env, tolerance, distance)
+}
+
+// LargestEmptyCircle returns the centre and radius of the largest circle whose interior does not intersect
+// the obstacles, with its centre inside the polygons of boundary, or the convex hull of the obstacles if the boundary
+// has no polygon. The centre inside a polygon of obstacles has no empty circle.
+// The centre is found within tolerance as MaximumInscribedCircle. It returns nil and 0 if obstacles is empty,
+// or the first obstacle point and 0 if the boundary has no area.
+func LargestEmptyCircle(obstacles, boundary matrix.Steric, tolerance float64) (matrix.Matrix, float64) {
+	points := matrix.TransMatrixes(obstacles)
+	if len(points) == 0 {
+		return nil, 0
+	}
+	bounds := []matrix.PolygonMatrix{}
+	if boundary != nil {
+		bounds = polygonsOf(boundary)
+	}
+	if len(bounds) == 0 {
+		if hull, ok := ConvexHull(obstacles).(matrix.PolygonMatrix); ok {
+			bounds = append(bounds, hull)
+		}
+	}
+	env := envelope.Empty()
+	for _, polygon := range bounds {
+		for _, v := range polygon[0] {
+			env.ExpandToIncludeMatrix(v)
+		}
+	}
+	if env.IsNil() || env.Width() == 0 || env.Height() == 0 {
+		return points[0], 0
+	}
+	areas, lines := polygonsOf(obstacles), linesOf(obstacles)
+	distance := func(p matrix.Matrix) float64 {
+		if !isInPolygons(p, bounds) {
+			return -distanceToRings(p, bounds)
+		}
+		d := distanceToRings(p, areas)
+		if isInPolygons(p, areas) {
+			return -d
+		}
+		for _, line := range lines {
+			if len(line) == 1 {
+				d = math.Min(d, measure.PlanarDistance(p, line[0]))
+			}
+			for i := 1; i < len(line); i++ {
+				d = math.Min(d, measure.DistanceSegmentToPoint(p, line[i-1], line[i], measure.PlanarDistance))
+			}
+		}
+		return d
+	}
+	return circleSearch(env, tolerance, distance)
+}
+
+// circleSearch returns the point of the envelope of the largest distance and the distance.
+// The envelope is covered by square cells, the cell of the largest distance of its centre plus its half diagonal
+// is split into four until no cell can have a distance larger than the best found by more than tolerance.
+func circleSearch(env *envelope.Envelope, tolerance float64, distance func(p matrix.Matrix) float64) (matrix.Matrix, float64) {
+	size := math.Min(env.Width(), env.Height())
+	if size == 0 {
+		size = math.Max(env.Width(), env.Height())
+	}
+	if size == 0 {
+		centre := matrix.Matrix{env.MinX, env.MinY}
+		return centre, math.Max(distance(centre), 0)
+	}
+	if tolerance <= 0 {
+		tolerance = math.Max(env.Width(), env.Height()) * defaultToleranceFactor
+	}
+	newCell := func(x, y, h float64) *searchCell {
+		centre := matrix.Matrix{x, y}
+		d := distance(centre)
+		return &searchCell{centre: centre, h: h, distance: d, max: d + h*math.Sqrt2}
+	}
+	queue := &cellQueue{}
+	for x := env.MinX; x < env.MaxX; x += size {
+		for y := env.MinY; y < env.MaxY; y += size {
+			heap.Push(queue, newCell(x+size/2, y+size/2, size/2))
+		}
+	}
+	best := newCell(env.Centre()[0], env.Centre()[1], 0)
+	for queue.Len() > 0 {
+		cell := heap.Pop(queue).(*searchCell)
+		if cell.distance > best.distance {
+			best = cell
+		}
+		if cell.max-best.distance <= tolerance {
+			continue
+		}
+		h := cell.h / 2
+		for _, offset := range [][2]float64{{-h, -h}, {h, -h}, {-h, h}, {h, h}} {
+			heap.Push(queue, newCell(cell.centre[0]+offset[0], cell.centre[1]+offset[1], h))
+		}
+	}
+	return best.centre, math.Max(best.distance, 0)
+}
+
+// searchCell a square cell of half size h, max is the largest distance of the points in it.
+type searchCell struct {
+	centre   matrix.Matrix
+	h        float64
+	distance float64
+	max      float64
+}
+
+// cellQueue a priority queue of cells in order of decreasing max.
+type cellQueue []*searchCell
+
+func (q cellQueue) Len() int            { return len(q) }
+func (q cellQueue) Less(i, j int) bool  { return q[i].max > q[j].max }
+func (q cellQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *cellQueue) Push(x interface{}) { *q = append(*q, x.(*searchCell)) }
+func (q *cellQueue) Pop() interface{} {
+	old := *q
+	cell := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return cell
+}
+
+// polygonsOf returns the polygons of the geometry.
+func polygonsOf(geom matrix.Steric) []matrix.PolygonMatrix {
+	polygons := []matrix.PolygonMatrix{}
+	switch st := geom.(type) {
+	case matrix.PolygonMatrix:
+		if len(st) > 0 && len(st[0]) > 0 {
+			polygons = append(polygons, st)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range st {
+			polygons = append(polygons, polygonsOf(matrix.PolygonMatrix(v))...)
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			polygons = append(polygons, polygonsOf(v)...)
+		}
+	}
+	return polygons
+}
+
+// linesOf returns the points and lines of the geometry, a point is a line of one point.
+func linesOf(geom matrix.Steric) []matrix.LineMatrix {
+	lines := []matrix.LineMatrix{}
+	switch st := geom.(type) {
+	case matrix.Matrix:
+		lines = append(lines, matrix.LineMatrix{st})
+	case matrix.LineMatrix:
+		lines = append(lines, st)
+	case matrix.Collection:
+		for _, v := range st {
+			lines = append(lines, linesOf(v)...)
+		}
+	}
+	return lines
+}
+
+// distanceToRings returns the distance of the point to the rings of the polygons, +Inf if there is no polygon.
+func distanceToRings(p matrix.Matrix, polygons []matrix.PolygonMatrix) float64 {
+	d := math.Inf(1)
+	for _, polygon := range polygons {
+		for _, ring := range polygon {
+			for i := 1; i < len(ring); i++ {
+				d = math.Min(d, measure.DistanceSegmentToPoint(p, ring[i-1], ring[i], measure.PlanarDistance))
+			}
+		}
+	}
+	return d
+}
@@ -0,0 +1,63 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMaximumInscribedCircle(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name   string
+		geom   matrix.Steric
+		centre matrix.Matrix
+		radius float64
+	}{
+		{name: "square", geom: square, centre: matrix.Matrix{5, 5}, radius: 5},
+		{name: "L shape", geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 2}, {2, 2}, {2, 10}, {0, 10}, {0, 0}}},
+			centre: matrix.Matrix{2 * math.Sqrt2 / (1 + math.Sqrt2), 2 * math.Sqrt2 / (1 + math.Sqrt2)}, radius: 2 * math.Sqrt2 / (1 + math.Sqrt2)},
+		{name: "hole", geom: matrix.PolygonMatrix{square[0], {{3, 3}, {7, 3}, {7, 7}, {3, 7}, {3, 3}}},
+			radius: 3 * math.Sqrt2 / (1 + math.Sqrt2)},
+		{name: "multi polygon", geom: matrix.Collection{matrix.PolygonMatrix{{{0, 0}, {20, 0}, {20, 4}, {0, 4}, {0, 0}}},
+			matrix.PolygonMatrix{{{30, 0}, {40, 0}, {40, 10}, {30, 10}, {30, 0}}}}, centre: matrix.Matrix{35, 5}, radius: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centre, radius := MaximumInscribedCircle(tt.geom, 0.001)
+			if math.Abs(radius-tt.radius) > 0.001 || (tt.centre != nil && !centre.EqualsExact(tt.centre, 0.01)) {
+				t.Errorf("MaximumInscribedCircle() = %v %v, want %v %v", centre, radius, tt.centre, tt.radius)
+			}
+		})
+	}
+	if centre, _ := MaximumInscribedCircle(matrix.LineMatrix{{0, 0}, {1, 1}}, 0); centre != nil {
+		t.Errorf("MaximumInscribedCircle() = %v, want nil", centre)
+	}
+}
+
+func TestLargestEmptyCircle(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name      string
+		obstacles matrix.Steric
+		boundary  matrix.Steric
+		radius    float64
+	}{
+		{name: "line", obstacles: matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}}, radius: 5},
+		{name: "corners and centre", obstacles: matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{10, 0},
+			matrix.Matrix{10, 10}, matrix.Matrix{0, 10}, matrix.Matrix{5, 5}}, radius: 5},
+		{name: "point in boundary", obstacles: matrix.Matrix{5, 5}, boundary: square, radius: 5 * math.Sqrt2},
+		{name: "polygon in boundary", obstacles: matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			boundary: square, radius: 4 * math.Sqrt2},
+		{name: "point", obstacles: matrix.Matrix{5, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centre, radius := LargestEmptyCircle(tt.obstacles, tt.boundary, 0.001)
+			if centre == nil || math.Abs(radius-tt.radius) > 0.001 {
+				t.Errorf("LargestEmptyCircle() = %v %v, want radius %v", centre, radius, tt.radius)
+			}
+		})
+	}
+}
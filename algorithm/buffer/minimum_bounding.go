@@ -0,0 +1,210 @@
+package buffer
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// OrientedRectangle a rectangle rotated by Angle around its Centre.
+// Length is the length of the sides in the direction of Angle, Width the length of the other sides,
+// Width is not greater than Length.
+type OrientedRectangle struct {
+	Centre matrix.Matrix
+	Length float64
+	Width  float64
+	// Angle the angle in radians in [0, π) from the x axis to the sides of Length.
+	Angle float64
+}
+
+// ToMatrix returns the counter-clockwise ring of the rectangle as a PolygonMatrix,
+// the LineMatrix of the sides of Length if Width is zero, or Centre if Length is zero.
+func (r *OrientedRectangle) ToMatrix() matrix.Steric {
+	if r.Centre == nil {
+		return matrix.Collection{}
+	}
+	ring := r.Ring()
+	switch {
+	case r.Length == 0:
+		return r.Centre
+	case r.Width == 0:
+		return ring[:2]
+	}
+	return matrix.PolygonMatrix{ring}
+}
+
+// Ring returns the counter-clockwise closed ring of the corners of the rectangle,
+// collapsed on the sides of Length if Width is zero, on Centre if Length is zero too.
+func (r *OrientedRectangle) Ring() matrix.LineMatrix {
+	u := matrix.Matrix{math.Cos(r.Angle) * r.Length / 2, math.Sin(r.Angle) * r.Length / 2}
+	n := matrix.Matrix{-math.Sin(r.Angle) * r.Width / 2, math.Cos(r.Angle) * r.Width / 2}
+	corner := func(i, j float64) matrix.Matrix {
+		return matrix.Matrix{r.Centre[0] + i*u[0] + j*n[0], r.Centre[1] + i*u[1] + j*n[1]}
+	}
+	return matrix.LineMatrix{corner(-1, -1), corner(1, -1), corner(1, 1), corner(-1, 1), corner(-1, -1)}
+}
+
+// MinimumBoundingCircle returns the centre and radius of the smallest circle containing the geometry,
+// nil and 0 if the geometry is empty.
+// The circle is computed from the vertices of the convex hull by Welzl's incremental algorithm,
+// it is defined by two points of a diameter or by three points on it.
+// The vertices are shuffled first, in hull order the expected time would be quadratic or worse.
+func MinimumBoundingCircle(geom matrix.Steric) (matrix.Matrix, float64) {
+	points := hullPoints(geom)
+	if len(points) == 0 {
+		return nil, 0
+	}
+	r := rand.New(rand.NewSource(int64(len(points))))
+	r.Shuffle(len(points), func(i, j int) { points[i], points[j] = points[j], points[i] })
+	centre, radius := points[0], 0.0
+	inCircle := func(p matrix.Matrix) bool {
+		return math.Hypot(p[0]-centre[0], p[1]-centre[1]) <= radius*(1+calc.AccuracyFloat)
+	}
+	for i, p := range points {
+		if inCircle(p) {
+			continue
+		}
+		centre, radius = p, 0
+		for j, q := range points[:i] {
+			if inCircle(q) {
+				continue
+			}
+			centre = matrix.Matrix{(p[0] + q[0]) / 2, (p[1] + q[1]) / 2}
+			radius = math.Hypot(p[0]-q[0], p[1]-q[1]) / 2
+			for _, s := range points[:j] {
+				if inCircle(s) {
+					continue
+				}
+				if c, ok := circumcentre(p, q, s); ok {
+					centre, radius = c, math.Hypot(p[0]-c[0], p[1]-c[1])
+				}
+			}
+		}
+	}
+	return centre, radius
+}
+
+// circumcentre returns the centre of the circle through the three points, false if they are collinear.
+func circumcentre(a, b, c matrix.Matrix) (matrix.Matrix, bool) {
+	bx, by := b[0]-a[0], b[1]-a[1]
+	cx, cy := c[0]-a[0], c[1]-a[1]
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		return nil, false
+	}
+	b2, c2 := bx*bx+by*by, cx*cx+cy*cy
+	return matrix.Matrix{a[0] + (cy*b2-by*c2)/d, a[1] + (bx*c2-cx*b2)/d}, true
+}
+
+// MinimumAreaRectangle returns the rectangle of minimum area containing the geometry, nil if the geometry is empty.
+// A side of the rectangle is on an edge of the convex hull, the edges are tried by rotating calipers.
+func MinimumAreaRectangle(geom matrix.Steric) *OrientedRectangle {
+	return minimumRectangle(geom, func(length, width float64) float64 { return length * width })
+}
+
+// MinimumWidthRectangle returns the rectangle containing the geometry of minimum Width,
+// which is the minimum width of the geometry, the smallest distance between two parallel lines enclosing it.
+// It returns nil if the geometry is empty.
+func MinimumWidthRectangle(geom matrix.Steric) *OrientedRectangle {
+	return minimumRectangle(geom, func(length, width float64) float64 { return width })
+}
+
+// minimumRectangle returns the rectangle on an edge of the convex hull of the minimum size.
+// The vertices farthest along the edge, farthest from it and farthest back along it only move forward
+// around the hull as the edges are tried, so all the edges are tried in linear time.
+func minimumRectangle(geom matrix.Steric, size func(length, width float64) float64) *OrientedRectangle {
+	points := hullPoints(geom)
+	switch len(points) {
+	case 0:
+		return nil
+	case 1:
+		return &OrientedRectangle{Centre: points[0]}
+	case 2:
+		p, q := points[0], points[1]
+		return &OrientedRectangle{Centre: matrix.Matrix{(p[0] + q[0]) / 2, (p[1] + q[1]) / 2},
+			Length: math.Hypot(q[0]-p[0], q[1]-p[1]), Angle: normalizeAngle(math.Atan2(q[1]-p[1], q[0]-p[0]))}
+	}
+	n := len(points)
+	var rect *OrientedRectangle
+	minSize := math.Inf(1)
+	maxS, maxT, minS := 1, 1, 1
+	for i, p := range points {
+		q := points[(i+1)%n]
+		d := math.Hypot(q[0]-p[0], q[1]-p[1])
+		u := matrix.Matrix{(q[0] - p[0]) / d, (q[1] - p[1]) / d}
+		// the hull is on the left of the edge, the normal points inside.
+		nu := matrix.Matrix{-u[1], u[0]}
+		along := func(k int) float64 { return (points[k%n][0]-p[0])*u[0] + (points[k%n][1]-p[1])*u[1] }
+		across := func(k int) float64 { return (points[k%n][0]-p[0])*nu[0] + (points[k%n][1]-p[1])*nu[1] }
+		for k := 0; k < n && along(maxS+1) > along(maxS); k++ {
+			maxS++
+		}
+		if maxT < maxS {
+			maxT = maxS
+		}
+		for k := 0; k < n && across(maxT+1) > across(maxT); k++ {
+			maxT++
+		}
+		if minS < maxT {
+			minS = maxT
+		}
+		for k := 0; k < n && along(minS+1) < along(minS); k++ {
+			minS++
+		}
+		sMin, sMax, tMax := math.Min(0, along(minS)), math.Max(d, along(maxS)), across(maxT)
+		if rectSize := size(sMax-sMin, tMax); rectSize < minSize {
+			minSize = rectSize
+			centre := matrix.Matrix{p[0] + u[0]*(sMin+sMax)/2 + nu[0]*tMax/2, p[1] + u[1]*(sMin+sMax)/2 + nu[1]*tMax/2}
+			rect = &OrientedRectangle{Centre: centre, Length: sMax - sMin, Width: tMax, Angle: math.Atan2(u[1], u[0])}
+			if rect.Width > rect.Length {
+				rect.Length, rect.Width, rect.Angle = rect.Width, rect.Length, rect.Angle+math.Pi/2
+			}
+			rect.Angle = normalizeAngle(rect.Angle)
+		}
+	}
+	return rect
+}
+
+// normalizeAngle returns the angle of the same direction in [0, π).
+func normalizeAngle(angle float64) float64 {
+	angle = math.Mod(angle, math.Pi)
+	if angle < 0 {
+		angle += math.Pi
+	}
+	return angle
+}
+
+// hullPoints returns the vertices of the convex hull of geometry in counter-clockwise order without the closing point.
+func hullPoints(geom matrix.Steric) matrix.LineMatrix {
+	switch hull := ConvexHull(geom).(type) {
+	case matrix.Matrix:
+		return matrix.LineMatrix{hull}
+	case matrix.LineMatrix:
+		return hull
+	case matrix.PolygonMatrix:
+		ring := openRing(hull[0])
+		area := 0.0
+		for i := range ring {
+			p, q := ring[i], ring[(i+1)%len(ring)]
+			area += p[0]*q[1] - q[0]*p[1]
+		}
+		if area < 0 {
+			for i, j := 0, len(ring)-1; i < j; i, j = i+1, j-1 {
+				ring[i], ring[j] = ring[j], ring[i]
+			}
+		}
+		return ring
+	}
+	return matrix.LineMatrix{}
+}
+
+// openRing returns a copy of the ring without the closing point.
+func openRing(ring matrix.LineMatrix) matrix.LineMatrix {
+	points := append(matrix.LineMatrix{}, ring...)
+	if len(points) > 1 && matrix.Matrix(points[0]).Equals(matrix.Matrix(points[len(points)-1])) {
+		points = points[:len(points)-1]
+	}
+	return points
+}
@@ -0,0 +1,187 @@
+package buffer
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMinimumBoundingCircle(t *testing.T) {
+	points := matrix.LineMatrix{}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		points = append(points, matrix.Matrix{r.NormFloat64(), r.NormFloat64()})
+	}
+	tests := []struct {
+		name   string
+		geom   matrix.Steric
+		centre matrix.Matrix
+		radius float64
+	}{
+		{name: "rectangle", geom: matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}}, centre: matrix.Matrix{2, 1}, radius: math.Sqrt(5)},
+		{name: "obtuse triangle", geom: matrix.LineMatrix{{0, 0}, {2, 0}, {1, 0.5}}, centre: matrix.Matrix{1, 0}, radius: 1},
+		{name: "acute triangle", geom: matrix.LineMatrix{{0, 0}, {2, 0}, {1, 3}}, centre: matrix.Matrix{1, 4.0 / 3}, radius: 5.0 / 3},
+		{name: "point", geom: matrix.Matrix{1, 2}, centre: matrix.Matrix{1, 2}},
+		{name: "empty", geom: matrix.Collection{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			centre, radius := MinimumBoundingCircle(tt.geom)
+			if tt.centre == nil {
+				if centre != nil {
+					t.Errorf("MinimumBoundingCircle() = %v, want nil", centre)
+				}
+				return
+			}
+			if !centre.EqualsExact(tt.centre, 1e-9) || math.Abs(radius-tt.radius) > 1e-9 {
+				t.Errorf("MinimumBoundingCircle() = %v %v, want %v %v", centre, radius, tt.centre, tt.radius)
+			}
+		})
+	}
+	centre, radius := MinimumBoundingCircle(points)
+	on := 0
+	for _, p := range points {
+		d := math.Hypot(p[0]-centre[0], p[1]-centre[1])
+		if d > radius*(1+1e-9) {
+			t.Fatalf("MinimumBoundingCircle() = %v %v, not contains %v", centre, radius, p)
+		}
+		if d > radius*(1-1e-9) {
+			on++
+		}
+	}
+	if on < 2 {
+		t.Errorf("MinimumBoundingCircle() = %v %v, %v points on circle", centre, radius, on)
+	}
+}
+
+func TestMinimumRectangle(t *testing.T) {
+	angle := math.Pi / 6
+	rotate := func(x, y float64) matrix.Matrix {
+		return matrix.Matrix{x*math.Cos(angle) - y*math.Sin(angle) + 10, x*math.Sin(angle) + y*math.Cos(angle) + 5}
+	}
+	house := matrix.PolygonMatrix{{rotate(0, 0), rotate(4, 0), rotate(4, 2), rotate(2, 3), rotate(0, 2), rotate(0, 0)}}
+	triangle := matrix.LineMatrix{{0, 0}, {10, 0}, {5, 1}}
+	tests := []struct {
+		name string
+		geom matrix.Steric
+		area bool
+		want OrientedRectangle
+	}{
+		{name: "area house", geom: house, area: true, want: OrientedRectangle{Centre: rotate(2, 1.5), Length: 4, Width: 3, Angle: angle}},
+		{name: "width house", geom: house, want: OrientedRectangle{Centre: rotate(2, 1.5), Length: 4, Width: 3, Angle: angle}},
+		{name: "area triangle", geom: triangle, area: true, want: OrientedRectangle{Centre: matrix.Matrix{5, 0.5}, Length: 10, Width: 1}},
+		{name: "collinear", geom: matrix.LineMatrix{{0, 0}, {1, 1}, {3, 3}}, area: true,
+			want: OrientedRectangle{Centre: matrix.Matrix{1.5, 1.5}, Length: 3 * math.Sqrt2, Angle: math.Pi / 4}},
+		{name: "point", geom: matrix.Matrix{1, 2}, want: OrientedRectangle{Centre: matrix.Matrix{1, 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MinimumWidthRectangle(tt.geom)
+			if tt.area {
+				got = MinimumAreaRectangle(tt.geom)
+			}
+			if !got.Centre.EqualsExact(tt.want.Centre, 1e-9) || math.Abs(got.Length-tt.want.Length) > 1e-9 ||
+				math.Abs(got.Width-tt.want.Width) > 1e-9 || math.Abs(got.Angle-tt.want.Angle) > 1e-9 {
+				t.Errorf("MinimumRectangle() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := MinimumAreaRectangle(matrix.Collection{}); got != nil {
+		t.Errorf("MinimumAreaRectangle() = %v, want nil", got)
+	}
+}
+
+func TestMinimumRectangle_RotatingCalipers(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{3, 10, 1000} {
+		// the points of an ellipse are all on its hull.
+		points := matrix.LineMatrix{}
+		for i := 0; i < n; i++ {
+			a := r.Float64() * 2 * math.Pi
+			points = append(points, matrix.Matrix{3*math.Cos(a) + r.Float64()*1e-3, math.Sin(a)})
+		}
+		hull := hullPoints(points)
+		for _, area := range []bool{true, false} {
+			size := func(length, width float64) float64 { return width }
+			got := MinimumWidthRectangle(points)
+			if area {
+				size = func(length, width float64) float64 { return length * width }
+				got = MinimumAreaRectangle(points)
+			}
+			// each edge of the hull with all the points.
+			want := math.Inf(1)
+			for i, p := range hull {
+				q := hull[(i+1)%len(hull)]
+				d := math.Hypot(q[0]-p[0], q[1]-p[1])
+				minS, maxS, maxT := 0.0, 0.0, 0.0
+				for _, v := range hull {
+					s := ((v[0]-p[0])*(q[0]-p[0]) + (v[1]-p[1])*(q[1]-p[1])) / d
+					t := ((q[0]-p[0])*(v[1]-p[1]) - (q[1]-p[1])*(v[0]-p[0])) / d
+					minS, maxS, maxT = math.Min(minS, s), math.Max(maxS, s), math.Max(maxT, t)
+				}
+				want = math.Min(want, size(maxS-minS, maxT))
+			}
+			if got := size(got.Length, got.Width); math.Abs(got-want) > 1e-9*want {
+				t.Errorf("MinimumRectangle() %v points area %v size = %v, want %v", n, area, got, want)
+			}
+		}
+	}
+}
+
+func TestMinimumBoundingCircle_HullOrder(t *testing.T) {
+	// the points of a circle are all on its hull, given in hull order.
+	points := matrix.LineMatrix{}
+	for i := 0; i < 5000; i++ {
+		a := float64(i) * 2 * math.Pi / 5000
+		points = append(points, matrix.Matrix{3 * math.Cos(a), 3 * math.Sin(a)})
+	}
+	if centre, radius := MinimumBoundingCircle(points); !centre.EqualsExact(matrix.Matrix{0, 0}, 1e-9) || math.Abs(radius-3) > 1e-9 {
+		t.Errorf("MinimumBoundingCircle() = %v %v, want %v %v", centre, radius, matrix.Matrix{0, 0}, 3)
+	}
+}
+
+func TestOrientedRectangle_ToMatrix(t *testing.T) {
+	tests := []struct {
+		name string
+		rect OrientedRectangle
+		want matrix.Steric
+	}{
+		{name: "rectangle", rect: OrientedRectangle{Centre: matrix.Matrix{2, 1}, Length: 4, Width: 2},
+			want: matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}}},
+		{name: "rotated", rect: OrientedRectangle{Centre: matrix.Matrix{0, 0}, Length: 4, Width: 2, Angle: math.Pi / 2},
+			want: matrix.PolygonMatrix{{{1, -2}, {1, 2}, {-1, 2}, {-1, -2}, {1, -2}}}},
+		{name: "line", rect: OrientedRectangle{Centre: matrix.Matrix{2, 0}, Length: 4}, want: matrix.LineMatrix{{0, 0}, {4, 0}}},
+		{name: "point", rect: OrientedRectangle{Centre: matrix.Matrix{2, 0}}, want: matrix.Matrix{2, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rect.ToMatrix(); !got.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("OrientedRectangle.ToMatrix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrientedRectangle_Ring(t *testing.T) {
+	tests := []struct {
+		name string
+		rect OrientedRectangle
+		want matrix.LineMatrix
+	}{
+		{name: "rectangle", rect: OrientedRectangle{Centre: matrix.Matrix{2, 1}, Length: 4, Width: 2},
+			want: matrix.LineMatrix{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}},
+		{name: "line", rect: OrientedRectangle{Centre: matrix.Matrix{2, 0}, Length: 4},
+			want: matrix.LineMatrix{{0, 0}, {4, 0}, {4, 0}, {0, 0}, {0, 0}}},
+		{name: "point", rect: OrientedRectangle{Centre: matrix.Matrix{2, 0}},
+			want: matrix.LineMatrix{{2, 0}, {2, 0}, {2, 0}, {2, 0}, {2, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rect.Ring(); !got.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("OrientedRectangle.Ring() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package buffer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/overlay"
+	"github.com/spatial-go/geoos/index/quadtree"
+)
+
+// OffsetCurve returns the lines parallel to the line at distance, on the left of the line for a positive distance
+// and on the right for a negative one, with the joins of the join style, mitre limit and quadrant segments of the parameters.
+// The parts of the raw offset curve closer to the line than distance, the loops of the sharp inside turns, are removed,
+// the lines remaining are returned in order along the line. A closed line is offset as a ring.
+// The vertices where the line goes straight on are dropped.
+// Nil or empty parameters are the default parameters.
+func OffsetCurve(line matrix.LineMatrix, distance float64, param *CurveParameters) matrix.Collection {
+	pts := matrix.LineMatrix{}
+	for _, v := range line {
+		if n := len(pts); n > 1 && isStraight(pts[n-2], pts[n-1], v) {
+			pts[n-1] = v
+		} else if n == 0 || !matrix.Matrix(pts[n-1]).Equals(matrix.Matrix(v)) {
+			pts = append(pts, v)
+		}
+	}
+	if len(pts) < 2 {
+		return matrix.Collection{}
+	}
+	if distance == 0 {
+		return matrix.Collection{pts}
+	}
+	if param == nil || param.IsEmpty() {
+		param = DefaultCurveParameters()
+	}
+	side := calc.SideLeft
+	if distance < 0 {
+		side = calc.SideRight
+	}
+	closed := len(pts) > 3 && matrix.Matrix(pts[0]).Equals(matrix.Matrix(pts[len(pts)-1]))
+	if closed && len(pts) > 4 && isStraight(pts[len(pts)-2], pts[0], pts[1]) {
+		pts = pts[1:]
+		pts[len(pts)-1] = pts[0]
+	}
+
+	c := CurveWithParameters(param, math.Abs(distance))
+	if closed {
+		n := len(pts) - 1
+		c.initSideSegments(pts[n-1], pts[0], side)
+		for i := 1; i <= n; i++ {
+			c.addNextSegment(pts[i], i != 1)
+		}
+		c.CloseRing()
+	} else {
+		c.initSideSegments(pts[0], pts[1], side)
+		c.Add(c.offset1.P0)
+		for i := 2; i < len(pts); i++ {
+			c.addNextSegment(pts[i], true)
+		}
+		c.Add(c.offset1.P1)
+	}
+	return clipOffsetCurve(c.Line, pts, math.Abs(distance), closed)
+}
+
+// isStraight returns true if the line goes straight on at v from p to q,
+// the raw offset curve would turn around v as for a line going back.
+func isStraight(p, v, q matrix.Matrix) bool {
+	return OrientationIndex(p, v, q) == 0 && (v[0]-p[0])*(q[0]-v[0])+(v[1]-p[1])*(q[1]-v[1]) > 0
+}
+
+// clipOffsetCurve cuts the raw offset curve where its distance to the line crosses distance,
+// removes the parts closer to the line, and merges the parts remaining which meet end to end.
+// Near an outside turn the distance is compared to the distance of the bevel of the turn,
+// so the joins are kept. Only the segments of the line within distance of a segment of the curve are tried.
+func clipOffsetCurve(raw, line matrix.LineMatrix, distance float64, closed bool) matrix.Collection {
+	radius := vertexRadius(line, distance, closed)
+	tolerance := distance * calc.CurveVertexSnapDistanceFactor
+	segments := newSegmentIndex(line, distance)
+	isNear := func(p matrix.Matrix) bool {
+		for _, i := range segments.query(p, p) {
+			a, b := matrix.Matrix(line[i-1]), matrix.Matrix(line[i])
+			switch f := measure.ProjectionFactor(p, a, b); {
+			case f <= 0:
+				if measure.PlanarDistance(p, a) < radius[i-1]-tolerance {
+					return true
+				}
+			case f >= 1:
+				if measure.PlanarDistance(p, b) < radius[i]-tolerance {
+					return true
+				}
+			default:
+				if measure.PlanarDistance(p, pointAt(a, b, f)) < distance-tolerance {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	result := matrix.Collection{}
+	var part matrix.LineMatrix
+	for i := 1; i < len(raw); i++ {
+		a, b := matrix.Matrix(raw[i-1]), matrix.Matrix(raw[i])
+		fractions := append([]float64{0, 1}, crossFractions(a, b, line, segments.query(a, b), radius, distance)...)
+		sort.Float64s(fractions)
+		for k := 1; k < len(fractions); k++ {
+			if fractions[k] == fractions[k-1] {
+				continue
+			}
+			if isNear(pointAt(a, b, (fractions[k-1]+fractions[k])/2)) {
+				if part != nil {
+					part = append(part, pointAt(a, b, fractions[k-1]))
+					result = append(result, part)
+					part = nil
+				}
+			} else if part == nil {
+				part = matrix.LineMatrix{pointAt(a, b, fractions[k-1])}
+			}
+		}
+		if part != nil && !matrix.Matrix(part[len(part)-1]).Equals(b) {
+			part = append(part, b)
+		}
+	}
+	if part != nil {
+		result = append(result, part)
+	}
+	if closed && len(result) > 1 {
+		first, last := result[0].(matrix.LineMatrix), result[len(result)-1].(matrix.LineMatrix)
+		if matrix.Matrix(last[len(last)-1]).Equals(matrix.Matrix(first[0])) {
+			result[0] = append(last, first[1:]...)
+			result = result[:len(result)-1]
+		}
+	}
+	return mergeParts(result)
+}
+
+// mergeParts line-merges the parts which meet end to end, the merged lines are kept in order along the line.
+func mergeParts(parts matrix.Collection) matrix.Collection {
+	if len(parts) < 2 {
+		return parts
+	}
+	order := func(line matrix.Steric) int {
+		start := matrix.Matrix(line.(matrix.LineMatrix)[0])
+		for i, v := range parts {
+			if start.Equals(matrix.Matrix(v.(matrix.LineMatrix)[0])) {
+				return i
+			}
+		}
+		return len(parts)
+	}
+	merged := overlay.LineMerge(append(matrix.Collection{}, parts...))
+	sort.SliceStable(merged, func(i, j int) bool {
+		return order(merged[i]) < order(merged[j])
+	})
+	return merged
+}
+
+// vertexRadius returns for each vertex of the line the distance of the bevel of its turn,
+// distance for the ends of a line which is not closed.
+func vertexRadius(line matrix.LineMatrix, distance float64, closed bool) []float64 {
+	n := len(line)
+	radius := make([]float64, n)
+	for i := range line {
+		prev, next := i-1, i+1
+		if closed {
+			if i == 0 {
+				prev = n - 2
+			}
+			if i == n-1 {
+				next = 1
+			}
+		}
+		if prev < 0 || next >= n {
+			radius[i] = distance
+			continue
+		}
+		p, v, q := matrix.Matrix(line[prev]), matrix.Matrix(line[i]), matrix.Matrix(line[next])
+		cos := ((v[0]-p[0])*(q[0]-v[0]) + (v[1]-p[1])*(q[1]-v[1])) /
+			(measure.PlanarDistance(p, v) * measure.PlanarDistance(v, q))
+		radius[i] = distance * math.Sqrt(math.Max(0, (1+cos)/2))
+	}
+	return radius
+}
+
+// segmentIndex is a quadtree of the segments of a line by their envelopes expanded by a distance.
+// The envelopes are moved by the corner of the envelope of the line, as the quadtree keeps at its root
+// the items crossing the axes.
+type segmentIndex struct {
+	tree           *quadtree.Quadtree
+	envs           []*envelope.Envelope
+	transX, transY float64
+}
+
+// newSegmentIndex returns the index of the segments of the line, the segment i is from the vertex i-1 to the vertex i.
+func newSegmentIndex(line matrix.LineMatrix, distance float64) *segmentIndex {
+	bound := envelope.Empty()
+	for _, v := range line {
+		bound.ExpandToIncludeMatrix(v)
+	}
+	bound.ExpandBy(distance)
+	s := &segmentIndex{tree: quadtree.NewQuadtree(), envs: make([]*envelope.Envelope, len(line)),
+		transX: -bound.MinX, transY: -bound.MinY}
+	for i := 1; i < len(line); i++ {
+		env := envelope.TwoMatrix(line[i-1], line[i])
+		env.ExpandBy(distance)
+		env.Translate(s.transX, s.transY)
+		s.envs[i] = env
+		_ = s.tree.Insert(env, i)
+	}
+	return s
+}
+
+// envelope returns the envelope of the segment a-b moved as the index.
+func (s *segmentIndex) envelope(a, b matrix.Matrix) *envelope.Envelope {
+	env := envelope.TwoMatrix(a, b)
+	env.Translate(s.transX, s.transY)
+	return env
+}
+
+// query returns in order the segments whose expanded envelopes intersect the envelope of the segment a-b.
+func (s *segmentIndex) query(a, b matrix.Matrix) []int {
+	env := s.envelope(a, b)
+	segments := []int{}
+	for _, v := range s.tree.Query(env).([]interface{}) {
+		if i := v.(int); s.envs[i].IsIntersects(env) {
+			segments = append(segments, i)
+		}
+	}
+	sort.Ints(segments)
+	return segments
+}
+
+// crossFractions returns the fractions along the segment a-b where it crosses the lines parallel to
+// the segments of the line at distance, and the circles of the radius of the vertices of these segments.
+func crossFractions(a, b matrix.Matrix, line matrix.LineMatrix, segments []int, radius []float64, distance float64) []float64 {
+	fractions := []float64{}
+	add := func(f float64) {
+		if f > 0 && f < 1 {
+			fractions = append(fractions, f)
+		}
+	}
+	for _, i := range segments {
+		p, q := matrix.Matrix(line[i-1]), matrix.Matrix(line[i])
+		length := measure.PlanarDistance(p, q)
+		nx, ny := -(q[1]-p[1])/length*distance, (q[0]-p[0])/length*distance
+		for _, side := range []float64{1, -1} {
+			if f, ok := segmentFraction(a, b,
+				matrix.Matrix{p[0] + side*nx, p[1] + side*ny}, matrix.Matrix{q[0] + side*nx, q[1] + side*ny}); ok {
+				add(f)
+			}
+		}
+	}
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	for k, i := range segments {
+		for _, j := range []int{i - 1, i} {
+			// the vertex shared with the previous segment is already crossed.
+			if j == i-1 && k > 0 && segments[k-1] == i-1 {
+				continue
+			}
+			v := line[j]
+			ex, ey := a[0]-v[0], a[1]-v[1]
+			qa, qb, qc := dx*dx+dy*dy, 2*(ex*dx+ey*dy), ex*ex+ey*ey-radius[j]*radius[j]
+			if disc := qb*qb - 4*qa*qc; disc >= 0 {
+				add((-qb - math.Sqrt(disc)) / (2 * qa))
+				add((-qb + math.Sqrt(disc)) / (2 * qa))
+			}
+		}
+	}
+	return fractions
+}
+
+// segmentFraction returns the fraction along the segment a-b of its intersection with the segment p-q,
+// false if they do not intersect at a single point.
+func segmentFraction(a, b, p, q matrix.Matrix) (float64, bool) {
+	dx0, dy0 := b[0]-a[0], b[1]-a[1]
+	dx1, dy1 := q[0]-p[0], q[1]-p[1]
+	denom := dx0*dy1 - dy0*dx1
+	if denom == 0 {
+		return 0, false
+	}
+	t := ((p[0]-a[0])*dy1 - (p[1]-a[1])*dx1) / denom
+	u := ((p[0]-a[0])*dy0 - (p[1]-a[1])*dx0) / denom
+	if t < 0 || t > 1 || u < 0 || u > 1 {
+		return 0, false
+	}
+	return t, true
+}
+
+// pointAt returns the point at fraction of the segment a-b.
+func pointAt(a, b matrix.Matrix, fraction float64) matrix.Matrix {
+	switch fraction {
+	case 0:
+		return a
+	case 1:
+		return b
+	}
+	return matrix.Matrix{a[0] + fraction*(b[0]-a[0]), a[1] + fraction*(b[1]-a[1])}
+}
@@ -0,0 +1,80 @@
+package buffer
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestOffsetCurve(t *testing.T) {
+	mitre := DefaultCurveParameters()
+	mitre.JoinStyle = calc.JoinMitre
+	bevel := DefaultCurveParameters()
+	bevel.JoinStyle = calc.JoinBevel
+	corner := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	long := matrix.LineMatrix{}
+	for i := 0; i <= 3000; i++ {
+		long = append(long, []float64{float64(i), 0})
+	}
+	tests := []struct {
+		name     string
+		line     matrix.LineMatrix
+		distance float64
+		param    *CurveParameters
+		want     matrix.Collection
+	}{
+		{"left", matrix.LineMatrix{{0, 0}, {10, 0}}, 1, nil, matrix.Collection{matrix.LineMatrix{{0, 1}, {10, 1}}}},
+		{"right", matrix.LineMatrix{{0, 0}, {10, 0}}, -1, nil, matrix.Collection{matrix.LineMatrix{{0, -1}, {10, -1}}}},
+		{"inside turn", corner, 1, mitre, matrix.Collection{matrix.LineMatrix{{0, 1}, {9, 1}, {9, 10}}}},
+		{"mitre join", corner, -1, mitre, matrix.Collection{matrix.LineMatrix{{0, -1}, {11, -1}, {11, 10}}}},
+		{"bevel join", corner, -1, bevel, matrix.Collection{matrix.LineMatrix{{0, -1}, {10, -1}, {11, 0}, {11, 10}}}},
+		{"ring inside", square, 1, mitre, matrix.Collection{matrix.LineMatrix{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}}},
+		{"ring outside", square, -1, mitre, matrix.Collection{matrix.LineMatrix{{-1, -1}, {11, -1}, {11, 11}, {-1, 11}, {-1, -1}}}},
+		{"ring collapsed", square, 6, mitre, matrix.Collection{}},
+		{"u turn", matrix.LineMatrix{{0, 0}, {10, 0}, {10, 3}, {0, 3}}, 1, mitre,
+			matrix.Collection{matrix.LineMatrix{{0, 1}, {9, 1}, {9, 2}, {0, 2}}}},
+		{"u turn overlap", matrix.LineMatrix{{0, 0}, {10, 0}, {10, 3}, {5, 3}}, 2, mitre,
+			matrix.Collection{matrix.LineMatrix{{0, 2}, {3.267949192431123, 2}}}},
+		{"u turn collapsed", matrix.LineMatrix{{0, 0}, {10, 0}, {10, 1}, {0, 1}}, 2, mitre, matrix.Collection{}},
+		{"zero", corner, 0, nil, matrix.Collection{corner}},
+		{"straight on", matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}, {2, 2}}, 1, mitre,
+			matrix.Collection{matrix.LineMatrix{{0, 1}, {1, 1}, {1, 2}}}},
+		{"ring straight on at the start", matrix.LineMatrix{{5, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}, {5, 0}}, 1, mitre,
+			matrix.Collection{matrix.LineMatrix{{9, 1}, {9, 9}, {1, 9}, {1, 1}, {9, 1}}}},
+		{"long line", long, 1, nil, matrix.Collection{matrix.LineMatrix{{0, 1}, {3000, 1}}}},
+		{"pieces merged", matrix.LineMatrix{{0, 0}, {10, 0}, {10, 0.5}, {9, 0.5}, {9, 3}, {20, 3}}, 1, mitre,
+			matrix.Collection{matrix.LineMatrix{{0, 1}, {8, 1}, {8, 4}, {20, 4}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OffsetCurve(tt.line, tt.distance, tt.param); !got.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("OffsetCurve() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentIndex_Query(t *testing.T) {
+	line := matrix.LineMatrix{{-10, 0}, {0, 0}, {10, 0}, {10, 10}, {-10, 10}}
+	segments := newSegmentIndex(line, 1)
+	tests := []struct {
+		name string
+		a, b matrix.Matrix
+		want []int
+	}{
+		{"point near the origin", matrix.Matrix{0, 0.5}, matrix.Matrix{0, 0.5}, []int{1, 2}},
+		{"point near a corner", matrix.Matrix{10.5, 10.5}, matrix.Matrix{10.5, 10.5}, []int{3, 4}},
+		{"segment across", matrix.Matrix{-5, -1}, matrix.Matrix{-5, 11}, []int{1, 4}},
+		{"segment far", matrix.Matrix{0, 5}, matrix.Matrix{1, 5}, []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := segments.query(tt.a, tt.b); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("segmentIndex.query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package simplify
+
+import (
+	"fmt"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/index/quadtree"
+)
+
+// CoverageSimplify Simplifies the polygons of a coverage, polygons which do not overlap and
+// have the same points along their common boundaries, preserving the topology of the coverage.
+// The rings are cut into edges at the points where more than two of them meet,
+// each edge is simplified once with the topology preserving Douglas-Peucker algorithm
+// and the same simplified edge is used by all the rings which share it, so no gaps or overlaps appear.
+func CoverageSimplify(polygons []matrix.PolygonMatrix, distanceTolerance float64) []matrix.PolygonMatrix {
+	c := &coverageEdges{index: map[string]int{}}
+	c.findNodes(polygons)
+	rings := make([][][]coverageEdgeUse, len(polygons))
+	for i, poly := range polygons {
+		for _, ring := range poly {
+			rings[i] = append(rings[i], c.addRing(ring))
+		}
+	}
+
+	lines := make([]*TaggedLineString, len(c.edges))
+	for i, edge := range c.edges {
+		lines[i] = &TaggedLineString{ParentLine: edge, MinimumSize: c.minimumSize[i]}
+		lines[i].initTaggedLine()
+	}
+	(&TaggedLinesSimplifier{
+		&LineSegmentIndex{quadtree.NewQuadtree()},
+		&LineSegmentIndex{quadtree.NewQuadtree()},
+		distanceTolerance,
+	}).Simplify(lines)
+
+	result := make([]matrix.PolygonMatrix, len(polygons))
+	for i, poly := range rings {
+		result[i] = matrix.PolygonMatrix{}
+		for _, ring := range poly {
+			line := matrix.LineMatrix{}
+			for _, use := range ring {
+				pts := lines[use.edge].GetResultMatrixes()
+				for k := range pts {
+					p := pts[k]
+					if use.reversed {
+						p = pts[len(pts)-1-k]
+					}
+					if len(line) == 0 || !matrix.Matrix(line[len(line)-1]).Equals(p) {
+						line = append(line, p)
+					}
+				}
+			}
+			result[i] = append(result[i], line)
+		}
+	}
+	return result
+}
+
+// coverageEdgeUse an edge of a ring, reversed if the ring follows it backwards.
+type coverageEdgeUse struct {
+	edge     int
+	reversed bool
+}
+
+// coverageEdges the edges of the rings of a coverage.
+type coverageEdges struct {
+	nodes       map[[2]float64]bool
+	edges       []matrix.LineMatrix
+	minimumSize []int
+	index       map[string]int
+}
+
+// findNodes finds the points of the rings which have other than two neighbours.
+func (c *coverageEdges) findNodes(polygons []matrix.PolygonMatrix) {
+	neighbours := map[[2]float64]map[[2]float64]bool{}
+	link := func(a, b [2]float64) {
+		if neighbours[a] == nil {
+			neighbours[a] = map[[2]float64]bool{}
+		}
+		neighbours[a][b] = true
+	}
+	for _, poly := range polygons {
+		for _, ring := range poly {
+			for i := 1; i < len(ring); i++ {
+				a, b := pointKey(ring[i-1]), pointKey(ring[i])
+				link(a, b)
+				link(b, a)
+			}
+		}
+	}
+	c.nodes = map[[2]float64]bool{}
+	for p, v := range neighbours {
+		if len(v) != 2 {
+			c.nodes[p] = true
+		}
+	}
+}
+
+// addRing cuts the ring into edges at its nodes and returns the edges it uses.
+func (c *coverageEdges) addRing(ring matrix.LineMatrix) []coverageEdgeUse {
+	n := len(ring) - 1
+	if n < 3 {
+		return nil
+	}
+	start := -1
+	for i := 0; i < n; i++ {
+		if c.nodes[pointKey(ring[i])] {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		// a ring without nodes is a single closed edge, starting at its smallest point
+		start = 0
+		for i := 1; i < n; i++ {
+			if lessPoint(ring[i], ring[start]) {
+				start = i
+			}
+		}
+		edge := matrix.LineMatrix{}
+		for k := 0; k <= n; k++ {
+			edge = append(edge, ring[(start+k)%n])
+		}
+		return []coverageEdgeUse{c.addEdge(edge, 4)}
+	}
+	uses := []coverageEdgeUse{}
+	edge := matrix.LineMatrix{ring[start]}
+	for k := 1; k <= n; k++ {
+		p := ring[(start+k)%n]
+		edge = append(edge, p)
+		if c.nodes[pointKey(p)] {
+			uses = append(uses, c.addEdge(edge, 2))
+			edge = matrix.LineMatrix{p}
+		}
+	}
+	// a ring of one edge keeps 4 points, a ring of two edges keeps a point between its nodes
+	// on its longest edge, so it does not collapse
+	switch len(uses) {
+	case 1:
+		c.keepMinimumSize(uses[0].edge, 4)
+	case 2:
+		longest := uses[0].edge
+		if len(c.edges[uses[1].edge]) > len(c.edges[longest]) {
+			longest = uses[1].edge
+		}
+		c.keepMinimumSize(longest, 3)
+	}
+	return uses
+}
+
+// keepMinimumSize raises the minimum number of points of the simplified edge to size.
+func (c *coverageEdges) keepMinimumSize(edge, size int) {
+	if c.minimumSize[edge] < size {
+		c.minimumSize[edge] = size
+	}
+}
+
+// addEdge adds the edge if it is new and returns its use by the ring.
+func (c *coverageEdges) addEdge(edge matrix.LineMatrix, minimumSize int) coverageEdgeUse {
+	reversed := make(matrix.LineMatrix, len(edge))
+	for k, v := range edge {
+		reversed[len(edge)-1-k] = v
+	}
+	canonical, isReversed := edge, false
+	for k := range edge {
+		if !matrix.Matrix(edge[k]).Equals(matrix.Matrix(reversed[k])) {
+			if lessPoint(reversed[k], edge[k]) {
+				canonical, isReversed = reversed, true
+			}
+			break
+		}
+	}
+	key := fmt.Sprint(canonical)
+	if i, ok := c.index[key]; ok {
+		return coverageEdgeUse{i, isReversed}
+	}
+	c.index[key] = len(c.edges)
+	c.edges = append(c.edges, canonical)
+	c.minimumSize = append(c.minimumSize, minimumSize)
+	return coverageEdgeUse{len(c.edges) - 1, isReversed}
+}
+
+// pointKey returns the key of the point in the maps of points.
+func pointKey(p []float64) [2]float64 {
+	return [2]float64{p[0], p[1]}
+}
+
+// lessPoint returns true if the point a is before b, by x then y.
+func lessPoint(a, b []float64) bool {
+	if a[0] != b[0] {
+		return a[0] < b[0]
+	}
+	return a[1] < b[1]
+}
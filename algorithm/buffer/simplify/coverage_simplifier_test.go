@@ -0,0 +1,47 @@
+package simplify
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestCoverageSimplify(t *testing.T) {
+	left := matrix.PolygonMatrix{{{0, 0}, {5, 0.1}, {10, 0}, {10.1, 5}, {10, 10}, {5, 10.1}, {0, 10}, {0, 0}}}
+	right := matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10.1, 5}, {10, 0}}}
+	shell := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {5, 3.9}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	island := matrix.PolygonMatrix{{{6, 4}, {6, 6}, {4, 6}, {4, 4}, {5, 3.9}, {6, 4}}}
+	lower := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10.1, 5}, {10, 10}, {0, 0}}}
+	upper := matrix.PolygonMatrix{{{10, 0}, {20, 10}, {10, 10}, {10.1, 5}, {10, 0}}}
+	tests := []struct {
+		name     string
+		polygons []matrix.PolygonMatrix
+		want     []matrix.PolygonMatrix
+	}{
+		{"shared edge", []matrix.PolygonMatrix{left, right}, []matrix.PolygonMatrix{
+			{{{10, 0}, {10, 10}, {0, 10}, {0, 0}, {10, 0}}},
+			{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+		}},
+		{"hole filled", []matrix.PolygonMatrix{shell, island}, []matrix.PolygonMatrix{
+			{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+		}},
+		{"triangles not collapsed", []matrix.PolygonMatrix{lower, upper}, []matrix.PolygonMatrix{
+			{{{10, 0}, {10.1, 5}, {10, 10}, {0, 0}, {10, 0}}},
+			{{{10, 0}, {20, 10}, {10, 10}, {10.1, 5}, {10, 0}}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CoverageSimplify(tt.polygons, 1)
+			if len(got) != len(tt.want) {
+				t.Fatalf("CoverageSimplify() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equals(tt.want[i]) {
+					t.Errorf("CoverageSimplify() polygon %v = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
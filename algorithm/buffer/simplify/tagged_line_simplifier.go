@@ -11,6 +11,7 @@ import (
 type TaggedLineStringSimplifier struct {
 	inputIndex, outputIndex *LineSegmentIndex
 	line                    *TaggedLineString
+	lines                   []*TaggedLineString
 	linePts                 []matrix.Matrix
 	distanceTolerance       float64
 }
@@ -104,6 +105,37 @@ func (t *TaggedLineStringSimplifier) hasBadIntersection(parentLine *TaggedLineSt
 	if t.hasBadInputIntersection(parentLine, sectionIndex, candidateSeg) {
 		return true
 	}
+	if t.hasJumpedLine(sectionIndex) {
+		return true
+	}
+	return false
+}
+
+// hasJumpedLine tests whether flattening the section would move another line to the other side of this one,
+// which happens when the other line is inside the area between the section and the flattened segment.
+func (t *TaggedLineStringSimplifier) hasJumpedLine(sectionIndex []int) bool {
+	if sectionIndex[1]-sectionIndex[0] < 2 {
+		return false
+	}
+	area := matrix.LineMatrix{}
+	for k := sectionIndex[0]; k <= sectionIndex[1]; k++ {
+		area = append(area, t.linePts[k])
+	}
+	area = append(area, t.linePts[sectionIndex[0]])
+	for _, v := range t.lines {
+		if v == t.line || len(v.ParentLine) == 0 {
+			continue
+		}
+		for _, p := range v.ParentLine {
+			if matrix.Matrix(p).Equals(t.linePts[sectionIndex[0]]) || matrix.Matrix(p).Equals(t.linePts[sectionIndex[1]]) {
+				continue
+			}
+			if relate.InPolygon(p, area) {
+				return true
+			}
+			break
+		}
+	}
 	return false
 }
 
@@ -148,9 +180,20 @@ func IsInLineSection(
 	return false
 }
 
-// HasInteriorIntersection ..
+// HasInteriorIntersection tests whether the segments intersect at a point which is not an end of both of them.
 func HasInteriorIntersection(seg0, seg1 *matrix.LineSegment) bool {
-	return relate.IsIntersectionLineSegment(seg0, seg1)
+	mark, ips := relate.IntersectionLineSegment(seg0, seg1)
+	if !mark {
+		return false
+	}
+	for _, ip := range ips {
+		isEnd0 := ip.Matrix.Equals(seg0.P0) || ip.Matrix.Equals(seg0.P1)
+		isEnd1 := ip.Matrix.Equals(seg1.P0) || ip.Matrix.Equals(seg1.P1)
+		if !isEnd0 || !isEnd1 {
+			return true
+		}
+	}
+	return false
 }
 
 // Remove Remove the segs in the section of the line
@@ -175,11 +218,14 @@ type TaggedLinesSimplifier struct {
 // Simplify Simplify a collection of TaggedLineStrings
 func (t *TaggedLinesSimplifier) Simplify(taggedLines []*TaggedLineString) {
 	for _, v := range taggedLines {
-		t.inputIndex.Add(v.ParentLine)
+		for _, seg := range v.Segs {
+			t.inputIndex.AddSegment(seg.LineSegment)
+		}
 	}
 	for _, v := range taggedLines {
 		tlss := &TaggedLineStringSimplifier{inputIndex: t.inputIndex,
 			outputIndex:       t.outputIndex,
+			lines:             taggedLines,
 			distanceTolerance: t.distanceTolerance,
 		}
 		tlss.Simplify(v)
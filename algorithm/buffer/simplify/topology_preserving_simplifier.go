@@ -74,20 +74,21 @@ func (l *LineStringMapBuilderFilter) Matrixes() []matrix.Matrix {
 
 // FilterMatrixes Performs an operation with the provided .
 func (l *LineStringMapBuilderFilter) FilterMatrixes(matrixes []matrix.Matrix) {
-	if line, ok := l.tps.InputGeom.(matrix.LineMatrix); ok {
-		// skip empty geometries
-		if line.IsEmpty() {
-			return
-		}
-
-		minSize := 2
-		if line.IsClosed() {
-			minSize = 4
-		}
-		taggedLine := &TaggedLineString{ParentLine: line, MinimumSize: minSize}
-		taggedLine.initTaggedLine()
-		l.tps.linestrings = append(l.tps.linestrings, taggedLine)
+	line := matrix.LineMatrix{}
+	for _, v := range matrixes {
+		line = append(line, v)
 	}
+	// skip empty geometries
+	if len(line) < 2 {
+		return
+	}
+	minSize := 2
+	if line.IsClosed() {
+		minSize = 4
+	}
+	taggedLine := &TaggedLineString{ParentLine: line, MinimumSize: minSize}
+	taggedLine.initTaggedLine()
+	l.tps.linestrings = append(l.tps.linestrings, taggedLine)
 }
 
 // Clear  clear Matrixes.
@@ -25,6 +25,16 @@ func TestTopologyPreservingSimplifier_Simplify(t *testing.T) {
 		want   matrix.Steric
 	}{
 		{"topolog Preserving simplifier", fields{InputGeom: geom}, args{geom, 1.0}, matrix.LineMatrix{{0, 0}, {1, 5}}},
+		{"polygon", fields{}, args{matrix.PolygonMatrix{{{0, 0}, {5, 0.5}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, 1.0},
+			matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}},
+		{"hole not jumped", fields{}, args{matrix.PolygonMatrix{{{0, 0}, {5, 2}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{4, 0.5}, {6, 0.5}, {6, 1}, {4, 1}, {4, 0.5}}}, 3.0},
+			matrix.PolygonMatrix{{{0, 0}, {5, 2}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 0.5}, {6, 0.5}, {6, 1}, {4, 1}, {4, 0.5}}}},
+		{"ring not collapsed", fields{}, args{matrix.PolygonMatrix{{{0, 0}, {5, 1}, {10, 0}, {5, 0.5}, {0, 0}}}, 2.0},
+			matrix.PolygonMatrix{{{0, 0}, {5, 1}, {10, 0}, {5, 0.5}, {0, 0}}}},
+		{"line not crossed", fields{}, args{matrix.Collection{matrix.LineMatrix{{0, 0}, {5, 1}, {10, 0}},
+			matrix.LineMatrix{{4, 0.5}, {6, 0.5}}}, 2.0},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {5, 1}, {10, 0}}, matrix.LineMatrix{{4, 0.5}, {6, 0.5}}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -133,6 +133,12 @@ func (l *LineStringTransformer) Transform(inputGeom matrix.Steric) (matrix.Steri
 		return l.transformLine(m, inputGeom), nil
 	case matrix.PolygonMatrix:
 		return l.transformPolygon(m, inputGeom), nil
+	case matrix.MultiPolygonMatrix:
+		result := matrix.MultiPolygonMatrix{}
+		for _, v := range m {
+			result = append(result, l.transformPolygon(v, inputGeom).(matrix.PolygonMatrix))
+		}
+		return result, nil
 	case matrix.Collection:
 		return l.transformCollection(m, inputGeom), nil
 	default:
@@ -140,8 +146,27 @@ func (l *LineStringTransformer) Transform(inputGeom matrix.Steric) (matrix.Steri
 	}
 }
 
+// transformPolygon simplifies the rings of the polygon.
+func (l *LineStringTransformer) transformPolygon(geom matrix.PolygonMatrix, parent matrix.Steric) matrix.Steric {
+	result := matrix.PolygonMatrix{}
+	for _, v := range geom {
+		result = append(result, l.transformLine(v, nil).(matrix.LineMatrix))
+	}
+	return result
+}
+
+// transformCollection simplifies the elements of the collection.
+func (l *LineStringTransformer) transformCollection(geom matrix.Collection, parent matrix.Steric) matrix.Steric {
+	result := matrix.Collection{}
+	for _, v := range geom {
+		transformGeom, _ := l.Transform(v)
+		result = append(result, transformGeom)
+	}
+	return result
+}
+
 func (l *LineStringTransformer) transformLine(geom matrix.LineMatrix, parent matrix.Steric) matrix.Steric {
-	pts := l.transformCoordinates(matrix.TransMatrixes(geom), parent)
+	pts := l.transformCoordinates(matrix.TransMatrixes(geom), geom)
 	ml := matrix.LineMatrix{}
 	for _, v := range pts {
 		ml = append(ml, v)
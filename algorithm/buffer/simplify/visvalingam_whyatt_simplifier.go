@@ -0,0 +1,125 @@
+package simplify
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// VisvalingamWhyatt Simplifies a geometry using the Visvalingam-Whyatt algorithm.
+// The points of the lines and rings are removed by increasing effective area,
+// the area of the triangle a point forms with its neighbours, while it is less than the area tolerance.
+// The ends of the lines are kept and the rings keep at least 4 points.
+// Empty and point geometries are returned unchanged.
+func VisvalingamWhyatt(geom matrix.Steric, areaTolerance float64) matrix.Steric {
+	switch m := geom.(type) {
+	case matrix.LineMatrix:
+		return (&VWLineSimplifier{pts: m, areaTolerance: areaTolerance, minimumSize: 2}).Simplify()
+	case matrix.PolygonMatrix:
+		poly := matrix.PolygonMatrix{}
+		for _, v := range m {
+			poly = append(poly, (&VWLineSimplifier{pts: v, areaTolerance: areaTolerance, minimumSize: 4}).Simplify())
+		}
+		return poly
+	case matrix.MultiPolygonMatrix:
+		multi := matrix.MultiPolygonMatrix{}
+		for _, v := range m {
+			multi = append(multi, VisvalingamWhyatt(matrix.PolygonMatrix(v), areaTolerance).(matrix.PolygonMatrix))
+		}
+		return multi
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range m {
+			coll = append(coll, VisvalingamWhyatt(v, areaTolerance))
+		}
+		return coll
+	}
+	return geom
+}
+
+// VWLineSimplifier Simplifies a linestring (sequence of points) using
+// the Visvalingam-Whyatt algorithm.
+type VWLineSimplifier struct {
+	pts           matrix.LineMatrix
+	areaTolerance float64
+	minimumSize   int
+	prev, next    []int
+	area          []float64
+}
+
+// Simplify Simplifies a linestring (sequence of points) using
+// the Visvalingam-Whyatt algorithm, keeping its ends and at least its minimum size of points.
+func (v *VWLineSimplifier) Simplify() matrix.LineMatrix {
+	n := len(v.pts)
+	if n <= v.minimumSize || n < 3 {
+		return v.pts
+	}
+	v.prev, v.next, v.area = make([]int, n), make([]int, n), make([]float64, n)
+	queue := &vwQueue{}
+	for i := range v.pts {
+		v.prev[i], v.next[i] = i-1, i+1
+		if i > 0 && i < n-1 {
+			v.area[i] = v.effectiveArea(i)
+			heap.Push(queue, vwItem{i, v.area[i]})
+		}
+	}
+	size := n
+	for queue.Len() > 0 && size > v.minimumSize {
+		item := heap.Pop(queue).(vwItem)
+		if v.prev[item.index] < -1 || item.area != v.area[item.index] {
+			// removed or stale
+			continue
+		}
+		if item.area >= v.areaTolerance {
+			break
+		}
+		p, q := v.prev[item.index], v.next[item.index]
+		v.next[p], v.prev[q] = q, p
+		v.prev[item.index] = -2
+		size--
+		for _, k := range []int{p, q} {
+			if k > 0 && k < n-1 {
+				// the area of a point is never less than the area of the points removed before it
+				v.area[k] = math.Max(v.effectiveArea(k), item.area)
+				heap.Push(queue, vwItem{k, v.area[k]})
+			}
+		}
+	}
+	line := matrix.LineMatrix{}
+	for i := 0; i < n; i = v.next[i] {
+		line = append(line, v.pts[i])
+	}
+	return line
+}
+
+// effectiveArea returns the area of the triangle of the point at index i and its neighbours.
+func (v *VWLineSimplifier) effectiveArea(i int) float64 {
+	a, b, c := v.pts[v.prev[i]], v.pts[i], v.pts[v.next[i]]
+	return math.Abs((b[0]-a[0])*(c[1]-a[1])-(c[0]-a[0])*(b[1]-a[1])) / 2
+}
+
+// vwItem a point of the line and its effective area in the queue.
+type vwItem struct {
+	index int
+	area  float64
+}
+
+// vwQueue a priority queue of the points by effective area.
+type vwQueue []vwItem
+
+func (q vwQueue) Len() int { return len(q) }
+func (q vwQueue) Less(i, j int) bool {
+	if q[i].area != q[j].area {
+		return q[i].area < q[j].area
+	}
+	return q[i].index < q[j].index
+}
+func (q vwQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *vwQueue) Push(x interface{}) { *q = append(*q, x.(vwItem)) }
+func (q *vwQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
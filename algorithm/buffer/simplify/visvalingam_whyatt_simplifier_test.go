@@ -0,0 +1,34 @@
+package simplify
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestVisvalingamWhyatt(t *testing.T) {
+	tests := []struct {
+		name          string
+		geom          matrix.Steric
+		areaTolerance float64
+		want          matrix.Steric
+	}{
+		{"small triangle", matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}, {3, 5}, {4, 0}}, 1,
+			matrix.LineMatrix{{0, 0}, {2, 0}, {3, 5}, {4, 0}}},
+		{"all removed", matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}, {3, 5}, {4, 0}}, 100,
+			matrix.LineMatrix{{0, 0}, {4, 0}}},
+		{"nothing removed", matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}}, 0.01,
+			matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}}},
+		{"ring minimum size", matrix.PolygonMatrix{{{0, 0}, {1, 0.1}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, 10,
+			matrix.PolygonMatrix{{{0, 0}, {2, 2}, {0, 2}, {0, 0}}}},
+		{"collection", matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}}}, 1,
+			matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {2, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VisvalingamWhyatt(tt.geom, tt.areaTolerance); !got.Equals(tt.want) {
+				t.Errorf("VisvalingamWhyatt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
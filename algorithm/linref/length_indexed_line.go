@@ -0,0 +1,221 @@
+// Package linref provides linear referencing along lines, locating points by their length along the line.
+package linref
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// LengthIndexedLine supports linear referencing along a line or the lines of a multi line by length.
+// The index of a point is its length along the line from the start, the lines of a multi line are
+// indexed one after the other. A negative index is the length back from the end of the line,
+// an index out of the line is clamped to its start or end.
+type LengthIndexedLine struct {
+	lines  []matrix.LineMatrix
+	length float64
+}
+
+// linearLocation the location of a point on a segment of a line, at fraction of the segment.
+type linearLocation struct {
+	line, segment int
+	fraction      float64
+}
+
+// NewLengthIndexedLine returns a LengthIndexedLine of a LineMatrix or a Collection of LineMatrix.
+func NewLengthIndexedLine(geom matrix.Steric) (*LengthIndexedLine, error) {
+	l := &LengthIndexedLine{}
+	switch st := geom.(type) {
+	case matrix.LineMatrix:
+		l.lines = append(l.lines, st)
+	case matrix.Collection:
+		for _, v := range st {
+			line, ok := v.(matrix.LineMatrix)
+			if !ok {
+				return nil, algorithm.ErrNotMatchType
+			}
+			l.lines = append(l.lines, line)
+		}
+	default:
+		return nil, algorithm.ErrNotMatchType
+	}
+	points := 0
+	for _, line := range l.lines {
+		points += len(line)
+		for i := 1; i < len(line); i++ {
+			l.length += measure.PlanarDistance(line[i-1], line[i])
+		}
+	}
+	if points == 0 {
+		return nil, algorithm.ErrNilSteric
+	}
+	return l, nil
+}
+
+// Length returns the length of the line.
+func (l *LengthIndexedLine) Length() float64 {
+	return l.length
+}
+
+// ClampIndex returns the index in the range of the line, a negative index is counted back from the end.
+func (l *LengthIndexedLine) ClampIndex(index float64) float64 {
+	if index < 0 {
+		index += l.length
+	}
+	return math.Max(0, math.Min(index, l.length))
+}
+
+// ExtractPoint returns the point of the line at index.
+func (l *LengthIndexedLine) ExtractPoint(index float64) matrix.Matrix {
+	return l.pointAt(l.locate(index))
+}
+
+// ExtractPointOffset returns the point at offset from the point of the line at index,
+// perpendicular to the segment of the point. A positive offset is on the left of the line,
+// a negative offset on the right.
+func (l *LengthIndexedLine) ExtractPointOffset(index, offset float64) (matrix.Matrix, error) {
+	loc := l.locate(index)
+	a, b := l.segment(loc)
+	length := measure.PlanarDistance(a, b)
+	if length == 0 {
+		return nil, algorithm.ErrComputeOffsetZero
+	}
+	p := l.pointAt(loc)
+	dx, dy := (b[0]-a[0])/length, (b[1]-a[1])/length
+	return matrix.Matrix{p[0] - offset*dy, p[1] + offset*dx}, nil
+}
+
+// Project returns the index of the point of the line closest to p.
+// If several points are at the same distance, the one of the lowest index is returned.
+func (l *LengthIndexedLine) Project(p matrix.Matrix) float64 {
+	index, minDist, cumulative := 0.0, math.Inf(1), 0.0
+	for _, line := range l.lines {
+		if len(line) == 1 {
+			if dist := measure.PlanarDistance(p, line[0]); dist < minDist {
+				index, minDist = cumulative, dist
+			}
+			continue
+		}
+		for i := 1; i < len(line); i++ {
+			closest := measure.ClosestPoint(p, line[i-1], line[i])
+			if dist := measure.PlanarDistance(p, closest); dist < minDist {
+				index, minDist = cumulative+measure.PlanarDistance(line[i-1], closest), dist
+			}
+			cumulative += measure.PlanarDistance(line[i-1], line[i])
+		}
+	}
+	return index
+}
+
+// ExtractLine returns the part of the line between the start and end indexes,
+// a LineMatrix or a Collection of LineMatrix if it covers several lines of a multi line.
+// If start is greater than end the part is reversed. If they are at the same point,
+// the part is a LineMatrix of the point repeated.
+func (l *LengthIndexedLine) ExtractLine(start, end float64) matrix.Steric {
+	start, end = l.ClampIndex(start), l.ClampIndex(end)
+	if start > end {
+		lines := l.extractLines(end, start)
+		reversed := matrix.Collection{}
+		for i := len(lines) - 1; i >= 0; i-- {
+			line := matrix.LineMatrix{}
+			for j := len(lines[i]) - 1; j >= 0; j-- {
+				line = append(line, lines[i][j])
+			}
+			reversed = append(reversed, line)
+		}
+		if len(reversed) == 1 {
+			return reversed[0]
+		}
+		return reversed
+	}
+	lines := l.extractLines(start, end)
+	if len(lines) == 1 {
+		return lines[0]
+	}
+	coll := matrix.Collection{}
+	for _, v := range lines {
+		coll = append(coll, v)
+	}
+	return coll
+}
+
+// extractLines returns the parts of the lines between the start and end indexes, start not greater than end.
+func (l *LengthIndexedLine) extractLines(start, end float64) []matrix.LineMatrix {
+	from, to := l.locate(start), l.locate(end)
+	lines := []matrix.LineMatrix{}
+	for i := from.line; i <= to.line; i++ {
+		line := l.lines[i]
+		points := matrix.LineMatrix{}
+		first, last := 0, len(line)-1
+		if i == from.line {
+			points = append(points, l.pointAt(from))
+			first = from.segment + 1
+		}
+		if i == to.line {
+			last = to.segment
+		}
+		for j := first; j <= last; j++ {
+			points = appendPoint(points, line[j])
+		}
+		if i == to.line {
+			points = appendPoint(points, l.pointAt(to))
+		}
+		if len(points) > 1 {
+			lines = append(lines, points)
+		}
+	}
+	if len(lines) == 0 {
+		p := l.pointAt(from)
+		lines = append(lines, matrix.LineMatrix{p, p})
+	}
+	return lines
+}
+
+// locate returns the location of the point at index, on a segment of non zero length if there is one.
+func (l *LengthIndexedLine) locate(index float64) linearLocation {
+	index = l.ClampIndex(index)
+	cumulative := 0.0
+	last := linearLocation{}
+	for i, line := range l.lines {
+		if len(l.lines[last.line]) == 0 {
+			last.line = i
+		}
+		for j := 1; j < len(line); j++ {
+			length := measure.PlanarDistance(line[j-1], line[j])
+			if length == 0 {
+				continue
+			}
+			last = linearLocation{i, j - 1, 1}
+			if cumulative+length >= index {
+				return linearLocation{i, j - 1, math.Min(1, (index-cumulative)/length)}
+			}
+			cumulative += length
+		}
+	}
+	return last
+}
+
+// segment returns the segment of the location.
+func (l *LengthIndexedLine) segment(loc linearLocation) (matrix.Matrix, matrix.Matrix) {
+	line := l.lines[loc.line]
+	if len(line) == 1 {
+		return line[0], line[0]
+	}
+	return line[loc.segment], line[loc.segment+1]
+}
+
+// pointAt returns the point at the location, its Z and M are interpolated.
+func (l *LengthIndexedLine) pointAt(loc linearLocation) matrix.Matrix {
+	a, b := l.segment(loc)
+	return interpolate(a, b, loc.fraction)
+}
+
+// appendPoint appends the point to the line if it is not the last point of the line.
+func appendPoint(line matrix.LineMatrix, p matrix.Matrix) matrix.LineMatrix {
+	if len(line) > 0 && matrix.Matrix(line[len(line)-1]).Equals(p) {
+		return line
+	}
+	return append(line, p)
+}
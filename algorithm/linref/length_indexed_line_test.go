@@ -0,0 +1,153 @@
+package linref
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var (
+	line      = matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	multiLine = matrix.Collection{matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{20, 0}, {20, 10}, {20, 10}, {30, 10}}}
+)
+
+func TestNewLengthIndexedLine(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    matrix.Steric
+		length  float64
+		wantErr error
+	}{
+		{"line", line, 20, nil},
+		{"multi line", multiLine, 30, nil},
+		{"point", matrix.Matrix{1, 1}, 0, algorithm.ErrNotMatchType},
+		{"empty", matrix.LineMatrix{}, 0, algorithm.ErrNilSteric},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewLengthIndexedLine(tt.geom)
+			if err != tt.wantErr {
+				t.Errorf("NewLengthIndexedLine() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && got.Length() != tt.length {
+				t.Errorf("Length() = %v, want %v", got.Length(), tt.length)
+			}
+		})
+	}
+}
+
+func TestLengthIndexedLine_ExtractPoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		geom  matrix.Steric
+		index float64
+		want  matrix.Matrix
+	}{
+		{"start", line, 0, matrix.Matrix{0, 0}},
+		{"middle", line, 5, matrix.Matrix{5, 0}},
+		{"vertex", line, 10, matrix.Matrix{10, 0}},
+		{"negative", line, -5, matrix.Matrix{10, 5}},
+		{"after end", line, 30, matrix.Matrix{10, 10}},
+		{"before start", line, -30, matrix.Matrix{0, 0}},
+		{"multi line", multiLine, 15, matrix.Matrix{20, 5}},
+		{"multi line junction", multiLine, 10, matrix.Matrix{10, 0}},
+		{"multi line end", multiLine, 30, matrix.Matrix{30, 10}},
+		{"z and m", matrix.LineMatrix{{0, 0, 0, 0}, {10, 0, 10, 100}}, 2.5, matrix.Matrix{2.5, 0, 2.5, 25}},
+		{"m without z", matrix.LineMatrix{{0, 0, math.NaN(), 0}, {10, 0, math.NaN(), 100}}, 5, matrix.Matrix{5, 0, math.NaN(), 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _ := NewLengthIndexedLine(tt.geom)
+			if got := l.ExtractPoint(tt.index); !got.Equals(tt.want) {
+				t.Errorf("ExtractPoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLengthIndexedLine_ExtractPointOffset(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    matrix.Steric
+		index   float64
+		offset  float64
+		want    matrix.Matrix
+		wantErr error
+	}{
+		{"left", line, 5, 2, matrix.Matrix{5, 2}, nil},
+		{"right", line, 5, -2, matrix.Matrix{5, -2}, nil},
+		{"vertex", line, 10, 2, matrix.Matrix{10, 2}, nil},
+		{"second segment", line, 15, 2, matrix.Matrix{8, 5}, nil},
+		{"repeated point", multiLine, 20, 2, matrix.Matrix{18, 10}, nil},
+		{"zero length", matrix.LineMatrix{{1, 1}, {1, 1}}, 0, 2, nil, algorithm.ErrComputeOffsetZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _ := NewLengthIndexedLine(tt.geom)
+			got, err := l.ExtractPointOffset(tt.index, tt.offset)
+			if err != tt.wantErr {
+				t.Errorf("ExtractPointOffset() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.Equals(tt.want) {
+				t.Errorf("ExtractPointOffset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLengthIndexedLine_Project(t *testing.T) {
+	tests := []struct {
+		name  string
+		geom  matrix.Steric
+		point matrix.Matrix
+		want  float64
+	}{
+		{"on line", line, matrix.Matrix{5, 0}, 5},
+		{"off line", line, matrix.Matrix{12, 4}, 14},
+		{"before start", line, matrix.Matrix{-5, -5}, 0},
+		{"after end", line, matrix.Matrix{10, 20}, 20},
+		{"corner", line, matrix.Matrix{11, -1}, 10},
+		{"multi line", multiLine, matrix.Matrix{25, 12}, 25},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _ := NewLengthIndexedLine(tt.geom)
+			if got := l.Project(tt.point); got != tt.want {
+				t.Errorf("Project() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLengthIndexedLine_ExtractLine(t *testing.T) {
+	tests := []struct {
+		name       string
+		geom       matrix.Steric
+		start, end float64
+		want       matrix.Steric
+	}{
+		{"whole", line, 0, 20, line},
+		{"inside segment", line, 2, 8, matrix.LineMatrix{{2, 0}, {8, 0}}},
+		{"across vertex", line, 5, 15, matrix.LineMatrix{{5, 0}, {10, 0}, {10, 5}}},
+		{"from vertex", line, 10, 20, matrix.LineMatrix{{10, 0}, {10, 10}}},
+		{"reversed", line, 15, 5, matrix.LineMatrix{{10, 5}, {10, 0}, {5, 0}}},
+		{"negative", line, -5, -1, matrix.LineMatrix{{10, 5}, {10, 9}}},
+		{"point", line, 5, 5, matrix.LineMatrix{{5, 0}, {5, 0}}},
+		{"multi line", multiLine, 5, 15, matrix.Collection{matrix.LineMatrix{{5, 0}, {10, 0}}, matrix.LineMatrix{{20, 0}, {20, 5}}}},
+		{"multi line reversed", multiLine, 15, 5,
+			matrix.Collection{matrix.LineMatrix{{20, 5}, {20, 0}}, matrix.LineMatrix{{10, 0}, {5, 0}}}},
+		{"second line", multiLine, 10, 25, matrix.LineMatrix{{20, 0}, {20, 10}, {25, 10}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _ := NewLengthIndexedLine(tt.geom)
+			if got := l.ExtractLine(tt.start, tt.end); !got.Equals(tt.want) {
+				t.Errorf("ExtractLine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
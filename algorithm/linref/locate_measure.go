@@ -0,0 +1,172 @@
+package linref
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// measureIndex the index of the measure in the ordinates of a point x, y, z, m.
+const measureIndex = 3
+
+// LocateAlong returns the points of a LineMatrix or a Collection of LineMatrix whose measure is m,
+// the measure is the fourth ordinate of the points. The points are at offset from the line,
+// perpendicular to it, a positive offset is on the left of the line.
+// The other ordinates of the points are interpolated along the segments.
+func LocateAlong(geom matrix.Steric, m, offset float64) (matrix.Collection, error) {
+	lines, err := measuredLines(geom)
+	if err != nil {
+		return nil, err
+	}
+	points := matrix.Collection{}
+	for _, line := range lines {
+		var last matrix.Matrix
+		add := func(p, a, b matrix.Matrix) {
+			if last != nil && last.Equals(p) {
+				return
+			}
+			last = p
+			points = append(points, offsetPoint(p, a, b, offset))
+		}
+		if len(line) == 1 && line[0][measureIndex] == m {
+			add(line[0], line[0], line[0])
+		}
+		for i := 1; i < len(line); i++ {
+			a, b := matrix.Matrix(line[i-1]), matrix.Matrix(line[i])
+			ma, mb := a[measureIndex], b[measureIndex]
+			switch {
+			case ma == m && mb == m:
+				add(a, a, b)
+				add(b, a, b)
+			case ma == m:
+				add(a, a, b)
+			case mb == m:
+				add(b, a, b)
+			case math.Min(ma, mb) < m && m < math.Max(ma, mb):
+				p := interpolate(a, b, (m-ma)/(mb-ma))
+				p[measureIndex] = m
+				add(p, a, b)
+			}
+		}
+	}
+	return points, nil
+}
+
+// LocateBetween returns the parts of a LineMatrix or a Collection of LineMatrix whose measures are
+// between from and to inclusive, the measure is the fourth ordinate of the points.
+// The parts are LineMatrix, or Matrix where a line only touches the range at a point.
+func LocateBetween(geom matrix.Steric, from, to float64) (matrix.Collection, error) {
+	lines, err := measuredLines(geom)
+	if err != nil {
+		return nil, err
+	}
+	if from > to {
+		from, to = to, from
+	}
+	parts := matrix.Collection{}
+	for _, line := range lines {
+		part := matrix.LineMatrix{}
+		flush := func() {
+			switch {
+			case len(part) == 1:
+				parts = append(parts, matrix.Matrix(part[0]))
+			case len(part) > 1:
+				parts = append(parts, part)
+			}
+			part = matrix.LineMatrix{}
+		}
+		if len(line) == 1 && line[0][measureIndex] >= from && line[0][measureIndex] <= to {
+			part = append(part, line[0])
+		}
+		for i := 1; i < len(line); i++ {
+			a, b := matrix.Matrix(line[i-1]), matrix.Matrix(line[i])
+			t0, t1, ok := clipMeasure(a[measureIndex], b[measureIndex], from, to)
+			if !ok {
+				flush()
+				continue
+			}
+			start, end := interpolate(a, b, t0), interpolate(a, b, t1)
+			if len(part) > 0 && !matrix.Matrix(part[len(part)-1]).Equals(start) {
+				flush()
+			}
+			part = appendPoint(part, start)
+			part = appendPoint(part, end)
+		}
+		flush()
+	}
+	return parts, nil
+}
+
+// measuredLines returns the lines of a LineMatrix or a Collection of LineMatrix whose points have measures.
+func measuredLines(geom matrix.Steric) ([]matrix.LineMatrix, error) {
+	lines := []matrix.LineMatrix{}
+	switch st := geom.(type) {
+	case matrix.LineMatrix:
+		lines = append(lines, st)
+	case matrix.Collection:
+		for _, v := range st {
+			line, ok := v.(matrix.LineMatrix)
+			if !ok {
+				return nil, algorithm.ErrNotMatchType
+			}
+			lines = append(lines, line)
+		}
+	default:
+		return nil, algorithm.ErrNotMatchType
+	}
+	for _, line := range lines {
+		for _, v := range line {
+			if len(v) <= measureIndex {
+				return nil, algorithm.ErrNoMeasure
+			}
+		}
+	}
+	return lines, nil
+}
+
+// clipMeasure returns the range of the fractions of a segment of measures ma to mb whose measures
+// are between from and to, false if there is none.
+func clipMeasure(ma, mb, from, to float64) (float64, float64, bool) {
+	if ma == mb {
+		return 0, 1, ma >= from && ma <= to
+	}
+	t0, t1 := (from-ma)/(mb-ma), (to-ma)/(mb-ma)
+	if t0 > t1 {
+		t0, t1 = t1, t0
+	}
+	t0, t1 = math.Max(0, t0), math.Min(1, t1)
+	return t0, t1, t0 <= t1
+}
+
+// interpolate returns the point at fraction of the segment a-b, with the ordinates of both points interpolated.
+func interpolate(a, b matrix.Matrix, fraction float64) matrix.Matrix {
+	switch fraction {
+	case 0:
+		return append(matrix.Matrix{}, a...)
+	case 1:
+		return append(matrix.Matrix{}, b...)
+	}
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	p := make(matrix.Matrix, n)
+	for i := range p {
+		p[i] = a[i] + fraction*(b[i]-a[i])
+	}
+	return p
+}
+
+// offsetPoint returns the point at offset from p, perpendicular to the segment a-b.
+func offsetPoint(p, a, b matrix.Matrix, offset float64) matrix.Matrix {
+	length := measure.PlanarDistance(a, b)
+	if offset == 0 || length == 0 {
+		return p
+	}
+	q := append(matrix.Matrix{}, p...)
+	q[0] -= offset * (b[1] - a[1]) / length
+	q[1] += offset * (b[0] - a[0]) / length
+	return q
+}
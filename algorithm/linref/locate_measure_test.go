@@ -0,0 +1,77 @@
+package linref
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var (
+	nan           = math.NaN()
+	measuredLine  = matrix.LineMatrix{{0, 0, nan, 0}, {10, 0, nan, 10}, {10, 10, nan, 20}, {0, 10, nan, 10}}
+	measuredLineZ = matrix.LineMatrix{{0, 0, 0, 100}, {10, 0, 5, 200}}
+)
+
+func TestLocateAlong(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    matrix.Steric
+		m       float64
+		offset  float64
+		want    matrix.Collection
+		wantErr error
+	}{
+		{"inside segment", measuredLine, 5, 0, matrix.Collection{matrix.Matrix{5, 0, nan, 5}}, nil},
+		{"vertex", measuredLine, 20, 0, matrix.Collection{matrix.Matrix{10, 10, nan, 20}}, nil},
+		{"measure repeated", measuredLine, 15, 0,
+			matrix.Collection{matrix.Matrix{10, 5, nan, 15}, matrix.Matrix{5, 10, nan, 15}}, nil},
+		{"twice at vertex", measuredLine, 10, 0,
+			matrix.Collection{matrix.Matrix{10, 0, nan, 10}, matrix.Matrix{0, 10, nan, 10}}, nil},
+		{"offset", measuredLine, 5, 2, matrix.Collection{matrix.Matrix{5, 2, nan, 5}}, nil},
+		{"z", measuredLineZ, 150, 0, matrix.Collection{matrix.Matrix{5, 0, 2.5, 150}}, nil},
+		{"out of range", measuredLine, 30, 0, matrix.Collection{}, nil},
+		{"multi line", matrix.Collection{measuredLine, measuredLineZ}, 100, 0, matrix.Collection{matrix.Matrix{0, 0, 0, 100}}, nil},
+		{"no measure", matrix.LineMatrix{{0, 0}, {1, 1}}, 0, 0, nil, algorithm.ErrNoMeasure},
+		{"polygon", matrix.PolygonMatrix{measuredLine}, 0, 0, nil, algorithm.ErrNotMatchType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LocateAlong(tt.geom, tt.m, tt.offset)
+			if err != tt.wantErr {
+				t.Errorf("LocateAlong() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.Equals(tt.want) {
+				t.Errorf("LocateAlong() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocateBetween(t *testing.T) {
+	tests := []struct {
+		name     string
+		geom     matrix.Steric
+		from, to float64
+		want     matrix.Collection
+	}{
+		{"inside segment", measuredLine, 2, 8, matrix.Collection{matrix.LineMatrix{{2, 0, nan, 2}, {8, 0, nan, 8}}}},
+		{"across vertex", measuredLine, 5, 15,
+			matrix.Collection{matrix.LineMatrix{{5, 0, nan, 5}, {10, 0, nan, 10}, {10, 5, nan, 15}},
+				matrix.LineMatrix{{5, 10, nan, 15}, {0, 10, nan, 10}}}},
+		{"reversed range", measuredLine, 8, 2, matrix.Collection{matrix.LineMatrix{{2, 0, nan, 2}, {8, 0, nan, 8}}}},
+		{"point", measuredLine, 20, 25, matrix.Collection{matrix.Matrix{10, 10, nan, 20}}},
+		{"z", measuredLineZ, 150, 300, matrix.Collection{matrix.LineMatrix{{5, 0, 2.5, 150}, {10, 0, 5, 200}}}},
+		{"out of range", measuredLine, 30, 40, matrix.Collection{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LocateBetween(tt.geom, tt.from, tt.to)
+			if err != nil || !got.Equals(tt.want) {
+				t.Errorf("LocateBetween() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
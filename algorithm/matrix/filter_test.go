@@ -30,3 +30,24 @@ func TestUniqueArrayFilter_FilterSteric(t *testing.T) {
 		})
 	}
 }
+
+func TestSteric_Filter(t *testing.T) {
+	tests := []struct {
+		name string
+		matr Steric
+		want []Matrix
+	}{
+		{name: "point", matr: Matrix{1, 2}, want: []Matrix{{1, 2}}},
+		{name: "points", matr: Collection{Matrix{1, 2}, Matrix{3, 4}, Matrix{1, 2}}, want: []Matrix{{1, 2}, {3, 4}}},
+		{name: "line", matr: LineMatrix{{0, 0}, {1, 1}, {0, 0}}, want: []Matrix{{0, 0}, {1, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &UniqueArrayFilter{IsNotChange: true}
+			_ = tt.matr.Filter(u)
+			if got := u.Matrixes(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
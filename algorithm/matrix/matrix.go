@@ -87,7 +87,7 @@ func (m Matrix) Compare(m1 Matrix) (int, error) {
 	return 0, nil
 }
 
-// Equals returns  true if the two Matrix are equal
+// Equals returns  true if the two Matrix are equal, a NaN Z, the missing Z of a measured point, equals a NaN Z.
 func (m Matrix) Equals(ms Steric) bool {
 	if mm, ok := ms.(Matrix); ok {
 		// If one is nil, the other must also be nil.
@@ -100,7 +100,7 @@ func (m Matrix) Equals(ms Steric) bool {
 		}
 
 		for i := range mm {
-			if mm[i] != m[i] {
+			if mm[i] != m[i] && !(i == 2 && math.IsNaN(mm[i]) && math.IsNaN(m[i])) {
 				return false
 			}
 		}
@@ -132,6 +132,7 @@ func (m Matrix) EqualsExact(ms Steric, tolerance float64) bool {
 
 // Filter Performs an operation with the provided .
 func (m Matrix) Filter(f Filter) Steric {
+	f.Filter(m)
 	return m
 }
 
@@ -167,6 +168,40 @@ func TransMatrixes(inputGeom Steric) []Matrix {
 	}
 }
 
+// Clone returns a deep copy of the steric.
+func Clone(inputGeom Steric) Steric {
+	switch m := inputGeom.(type) {
+	case Matrix:
+		return append(Matrix{}, m...)
+	case LineMatrix:
+		line := make(LineMatrix, len(m))
+		for i, v := range m {
+			line[i] = append([]float64{}, v...)
+		}
+		return line
+	case PolygonMatrix:
+		poly := make(PolygonMatrix, len(m))
+		for i, v := range m {
+			poly[i] = Clone(LineMatrix(v)).(LineMatrix)
+		}
+		return poly
+	case MultiPolygonMatrix:
+		multi := make(MultiPolygonMatrix, len(m))
+		for i, v := range m {
+			multi[i] = Clone(PolygonMatrix(v)).(PolygonMatrix)
+		}
+		return multi
+	case Collection:
+		coll := make(Collection, len(m))
+		for i, v := range m {
+			coll[i] = Clone(v)
+		}
+		return coll
+	default:
+		return inputGeom
+	}
+}
+
 // LineArray returns the LineArray
 func LineArray(l LineMatrix) (lines []*LineSegment) {
 	for i := 0; i < len(l)-1; i++ {
@@ -0,0 +1,161 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Bearings, destinations and intermediate points on the sphere of radius R,
+// coordinates are lng lat, bearings are clockwise from north, unit degree, and distances unit m.
+// For the ellipsoid use the Inverse and Direct of Geodesic.
+
+// angularDistance returns the central angle between two points by the haversine formula, unit radian.
+func angularDistance(lng1, lat1, lng2, lat2 float64) float64 {
+	sinLat, sinLng := math.Sin((lat2-lat1)/2), math.Sin((lng2-lng1)/2)
+	h := sinLat*sinLat + math.Cos(lat1)*math.Cos(lat2)*sinLng*sinLng
+	return 2 * math.Atan2(math.Sqrt(h), math.Sqrt(1-h))
+}
+
+// toRadians returns lng lat of the point, unit radian.
+func toRadians(p matrix.Matrix) (lng, lat float64) {
+	return p[0] * math.Pi / 180, p[1] * math.Pi / 180
+}
+
+// toDegrees returns the point of lng lat in radian, its longitude is normalized to [-180, 180].
+func toDegrees(lng, lat float64) matrix.Matrix {
+	return matrix.Matrix{angNormalize(lng * 180 / math.Pi), lat * 180 / math.Pi}
+}
+
+// normalizeBearing returns the bearing in [0, 360).
+func normalizeBearing(bearing float64) float64 {
+	bearing = math.Mod(bearing, 360)
+	if bearing < 0 {
+		bearing += 360
+	}
+	return bearing
+}
+
+// Bearing returns the initial bearing of the great circle from point from to point to, in [0, 360).
+func Bearing(from, to matrix.Matrix) float64 {
+	lng1, lat1 := toRadians(from)
+	lng2, lat2 := toRadians(to)
+	y := math.Sin(lng2-lng1) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(lng2-lng1)
+	return normalizeBearing(math.Atan2(y, x) * 180 / math.Pi)
+}
+
+// FinalBearing returns the bearing of the great circle arriving at point to from point from, in [0, 360).
+func FinalBearing(from, to matrix.Matrix) float64 {
+	return normalizeBearing(Bearing(to, from) + 180)
+}
+
+// Destination returns the point at distance along the great circle from point from with the initial bearing.
+func Destination(from matrix.Matrix, bearing, distance float64) matrix.Matrix {
+	lng1, lat1 := toRadians(from)
+	theta, delta := bearing*math.Pi/180, distance/R
+	sinLat2 := math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta)
+	lat2 := math.Asin(math.Max(-1, math.Min(1, sinLat2)))
+	lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(delta)*math.Cos(lat1), math.Cos(delta)-math.Sin(lat1)*sinLat2)
+	return toDegrees(lng2, lat2)
+}
+
+// IntermediatePoint returns the point at the fraction of the great circle from point from to point to,
+// fraction 0 is from and 1 is to. The great circle of antipodal points is undefined, it returns from.
+func IntermediatePoint(from, to matrix.Matrix, fraction float64) matrix.Matrix {
+	lng1, lat1 := toRadians(from)
+	lng2, lat2 := toRadians(to)
+	delta := angularDistance(lng1, lat1, lng2, lat2)
+	sinDelta := math.Sin(delta)
+	if sinDelta < 1e-15 {
+		return toDegrees(lng1, lat1)
+	}
+	a, b := math.Sin((1-fraction)*delta)/sinDelta, math.Sin(fraction*delta)/sinDelta
+	x := a*math.Cos(lat1)*math.Cos(lng1) + b*math.Cos(lat2)*math.Cos(lng2)
+	y := a*math.Cos(lat1)*math.Sin(lng1) + b*math.Cos(lat2)*math.Sin(lng2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+	return toDegrees(math.Atan2(y, x), math.Atan2(z, math.Hypot(x, y)))
+}
+
+// GreatCirclePoints returns the points dividing the great circle from point from to point to into n equal arcs,
+// including from and to.
+func GreatCirclePoints(from, to matrix.Matrix, n int) matrix.LineMatrix {
+	if n < 1 {
+		n = 1
+	}
+	points := make(matrix.LineMatrix, 0, n+1)
+	points = append(points, from)
+	for i := 1; i < n; i++ {
+		points = append(points, IntermediatePoint(from, to, float64(i)/float64(n)))
+	}
+	return append(points, to)
+}
+
+// DensifyGreatCircle returns the line with points inserted along the great circles of its segments,
+// so that no segment is longer than maxLength, unit m.
+// Inserted longitudes are in [-180, 180], a line crossing the antimeridian may be cut by SplitAntimeridian of space.
+func DensifyGreatCircle(line matrix.LineMatrix, maxLength float64) matrix.LineMatrix {
+	if len(line) < 2 || maxLength <= 0 {
+		return line
+	}
+	densified := matrix.LineMatrix{line[0]}
+	for i := 1; i < len(line); i++ {
+		lng1, lat1 := toRadians(line[i-1])
+		lng2, lat2 := toRadians(line[i])
+		n := int(math.Ceil(angularDistance(lng1, lat1, lng2, lat2) * R / maxLength))
+		densified = append(densified, GreatCirclePoints(line[i-1], line[i], n)[1:]...)
+	}
+	return densified
+}
+
+// rhumbStretch returns the difference of the Mercator projected latitudes
+// and the ratio of latitude difference to it, which is the cosine of latitude on an east west line.
+func rhumbStretch(lat1, lat2 float64) (dPsi, q float64) {
+	dPsi = math.Log(math.Tan(math.Pi/4+lat2/2) / math.Tan(math.Pi/4+lat1/2))
+	if math.Abs(dPsi) > 1e-12 {
+		return dPsi, (lat2 - lat1) / dPsi
+	}
+	return dPsi, math.Cos(lat1)
+}
+
+// rhumbDelta returns the differences of lng lat of the shorter rhumb line from point from to point to, unit radian.
+func rhumbDelta(from, to matrix.Matrix) (float64, float64, float64) {
+	lng1, lat1 := toRadians(from)
+	lng2, lat2 := toRadians(to)
+	return math.Remainder(lng2-lng1, 2*math.Pi), lat1, lat2
+}
+
+// RhumbBearing returns the constant bearing of the rhumb line from point from to point to, in [0, 360).
+func RhumbBearing(from, to matrix.Matrix) float64 {
+	dLng, lat1, lat2 := rhumbDelta(from, to)
+	dPsi, _ := rhumbStretch(lat1, lat2)
+	return normalizeBearing(math.Atan2(dLng, dPsi) * 180 / math.Pi)
+}
+
+// RhumbDistance returns the length of the rhumb line from point from to point to, unit m.
+func RhumbDistance(from, to matrix.Matrix) float64 {
+	dLng, lat1, lat2 := rhumbDelta(from, to)
+	_, q := rhumbStretch(lat1, lat2)
+	return math.Hypot(lat2-lat1, q*dLng) * R
+}
+
+// RhumbDestination returns the point at distance along the rhumb line from point from with the constant bearing.
+func RhumbDestination(from matrix.Matrix, bearing, distance float64) matrix.Matrix {
+	lng1, lat1 := toRadians(from)
+	theta, delta := bearing*math.Pi/180, distance/R
+	lat2 := lat1 + delta*math.Cos(theta)
+	// the rhumb line passing over a pole continues on the other side.
+	if lat2 > math.Pi/2 {
+		lat2 = math.Pi - lat2
+	} else if lat2 < -math.Pi/2 {
+		lat2 = -math.Pi - lat2
+	}
+	_, q := rhumbStretch(lat1, lat2)
+	return toDegrees(lng1+delta*math.Sin(theta)/q, lat2)
+}
+
+// RhumbIntermediatePoint returns the point at the fraction of the rhumb line from point from to point to,
+// fraction 0 is from and 1 is to.
+func RhumbIntermediatePoint(from, to matrix.Matrix, fraction float64) matrix.Matrix {
+	return RhumbDestination(from, RhumbBearing(from, to), fraction*RhumbDistance(from, to))
+}
@@ -0,0 +1,99 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestBearing(t *testing.T) {
+	tests := []struct {
+		name          string
+		from, to      matrix.Matrix
+		bearing       float64
+		finalBearing  float64
+		rhumbBearing  float64
+		rhumbDistance float64
+	}{
+		{"lands end to john o'groats", matrix.Matrix{-5.7147, 50.0664}, matrix.Matrix{-3.0700, 58.6439},
+			9.1197, 11.2751, 10.1406, 968910},
+		{"plymouth to boston", matrix.Matrix{-4.1339, 50.3664}, matrix.Matrix{-71.0408, 42.3511},
+			286.8952, 235.6768, 260.1272, 5197999},
+		{"east", matrix.Matrix{0, 0}, matrix.Matrix{10, 0}, 90, 90, 90, 1111949},
+		{"across antimeridian", matrix.Matrix{170, 10}, matrix.Matrix{-170, 10}, 88.2462, 91.7538, 90, 2190113},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Bearing(tt.from, tt.to); math.Abs(got-tt.bearing) > 1e-3 {
+				t.Errorf("Bearing() = %v, want %v", got, tt.bearing)
+			}
+			if got := FinalBearing(tt.from, tt.to); math.Abs(got-tt.finalBearing) > 1e-3 {
+				t.Errorf("FinalBearing() = %v, want %v", got, tt.finalBearing)
+			}
+			if got := RhumbBearing(tt.from, tt.to); math.Abs(got-tt.rhumbBearing) > 1e-3 {
+				t.Errorf("RhumbBearing() = %v, want %v", got, tt.rhumbBearing)
+			}
+			if got := RhumbDistance(tt.from, tt.to); math.Abs(got-tt.rhumbDistance) > 1 {
+				t.Errorf("RhumbDistance() = %v, want %v", got, tt.rhumbDistance)
+			}
+		})
+	}
+}
+
+func TestDestination(t *testing.T) {
+	from := matrix.Matrix{-2.0331, 53.3206}
+	if got, want := Destination(from, 96.0217, 124800), (matrix.Matrix{-0.1701, 53.1883}); !got.EqualsExact(want, 1e-3) {
+		t.Errorf("Destination() = %v, want %v", got, want)
+	}
+	if got, want := Destination(matrix.Matrix{179, 0}, 90, 2*111195), (matrix.Matrix{-179, 0}); !got.EqualsExact(want, 1e-3) {
+		t.Errorf("Destination() = %v, want %v", got, want)
+	}
+	from = matrix.Matrix{-4.1339, 51.1270}
+	if got, want := RhumbDestination(from, 116.6361, 40230), (matrix.Matrix{-3.6195, 50.9648}); !got.EqualsExact(want, 1e-3) {
+		t.Errorf("RhumbDestination() = %v, want %v", got, want)
+	}
+}
+
+func TestIntermediatePoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to matrix.Matrix
+		fraction float64
+		want     matrix.Matrix
+		rhumb    matrix.Matrix
+	}{
+		{"equator", matrix.Matrix{0, 0}, matrix.Matrix{90, 0}, 0.5, matrix.Matrix{45, 0}, matrix.Matrix{45, 0}},
+		{"meridian", matrix.Matrix{10, 0}, matrix.Matrix{10, 60}, 0.25, matrix.Matrix{10, 15}, matrix.Matrix{10, 15}},
+		{"north of rhumb", matrix.Matrix{-30, 50}, matrix.Matrix{30, 50}, 0.5, matrix.Matrix{0, 53.9948}, matrix.Matrix{0, 50}},
+		{"end", matrix.Matrix{-30, 50}, matrix.Matrix{30, 50}, 1, matrix.Matrix{30, 50}, matrix.Matrix{30, 50}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IntermediatePoint(tt.from, tt.to, tt.fraction); !got.EqualsExact(tt.want, 1e-4) {
+				t.Errorf("IntermediatePoint() = %v, want %v", got, tt.want)
+			}
+			if got := RhumbIntermediatePoint(tt.from, tt.to, tt.fraction); !got.EqualsExact(tt.rhumb, 1e-4) {
+				t.Errorf("RhumbIntermediatePoint() = %v, want %v", got, tt.rhumb)
+			}
+		})
+	}
+}
+
+func TestDensifyGreatCircle(t *testing.T) {
+	line := matrix.LineMatrix{{-73.8, 40.6}, {-0.5, 51.6}, {2.5, 49}}
+	got := DensifyGreatCircle(line, 500000)
+	if len(got) != 14 {
+		t.Errorf("DensifyGreatCircle() got %v points, want %v", len(got), 14)
+	}
+	for i := 1; i < len(got); i++ {
+		lng1, lat1 := toRadians(got[i-1])
+		lng2, lat2 := toRadians(got[i])
+		if d := angularDistance(lng1, lat1, lng2, lat2) * R; d > 500000+1e-6 {
+			t.Errorf("DensifyGreatCircle() segment %v length %v", i, d)
+		}
+	}
+	if !matrix.Matrix(got[12]).Equals(matrix.Matrix(line[1])) || !matrix.Matrix(got[13]).Equals(matrix.Matrix(line[2])) {
+		t.Errorf("DensifyGreatCircle() = %v, vertices missing", got)
+	}
+}
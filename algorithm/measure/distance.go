@@ -89,36 +89,75 @@ func DistanceSegmentToPoint(p, a, b matrix.Matrix, f Distance) float64 {
 	return math.Abs(s) * math.Sqrt(len2)
 }
 
+// SegmentDistance is a func of measure distance of a point to a segment.
+type SegmentDistance func(p, a, b matrix.Matrix) float64
+
 // DistanceLineToPoint Returns Distance of p,line
-func DistanceLineToPoint(line matrix.LineMatrix, pt matrix.Matrix, f Distance) (dist float64) {
-	for i, v := range line {
-		tmpDist := 0.0
-		if i < len(line)-1 {
-			tmpDist = DistanceSegmentToPoint(pt, v, line[i+1], f)
-		}
-		if dist > tmpDist {
-			dist = tmpDist
-		}
+func DistanceLineToPoint(line matrix.LineMatrix, pt matrix.Matrix, f Distance) float64 {
+	return distanceLineToPoint(line, pt, segmentDistance(f))
+}
+
+// DistancePolygonToPoint Returns Distance of p,polygon, it is 0 for a point inside the polygon.
+func DistancePolygonToPoint(poly matrix.PolygonMatrix, pt matrix.Matrix, f Distance) float64 {
+	return distancePolygonToPoint(poly, pt, segmentDistance(f))
+}
+
+// segmentDistance returns the distance of a point to a segment measured by f from the projection of the point.
+func segmentDistance(f Distance) SegmentDistance {
+	return func(p, a, b matrix.Matrix) float64 {
+		return DistanceSegmentToPoint(p, a, b, f)
 	}
-	return
 }
 
-// DistancePolygonToPoint Returns Distance of p,polygon
-func DistancePolygonToPoint(poly matrix.PolygonMatrix, pt matrix.Matrix, f Distance) (dist float64) {
+// distanceLineToPoint returns the minimum distance of p to the segments of the line.
+func distanceLineToPoint(line matrix.LineMatrix, pt matrix.Matrix, seg SegmentDistance) float64 {
+	if len(line) == 1 {
+		return seg(pt, line[0], line[0])
+	}
+	dist := math.Inf(1)
+	for i := 1; i < len(line); i++ {
+		dist = math.Min(dist, seg(pt, line[i-1], line[i]))
+	}
+	return dist
+}
 
-	for _, v := range poly {
-		tmpDist := DistanceLineToPoint(v, pt, f)
-		if dist > tmpDist {
-			dist = tmpDist
+// distancePolygonToPoint returns 0 for a point inside the polygon, the minimum distance of p to the rings otherwise.
+func distancePolygonToPoint(poly matrix.PolygonMatrix, pt matrix.Matrix, seg SegmentDistance) float64 {
+	inside := relate.InPolygon(pt, poly[0])
+	for _, v := range poly[1:] {
+		if relate.InPolygon(pt, v) {
+			inside = false
 		}
 	}
-	return
+	if inside {
+		return 0
+	}
+	dist := math.Inf(1)
+	for _, v := range poly {
+		dist = math.Min(dist, distanceLineToPoint(v, pt, seg))
+	}
+	return dist
 }
 
 // ElementDistance describes a geographic ElementDistance
 type ElementDistance struct {
 	From, To matrix.Steric
 	F        Distance
+	// Segment is the distance of a point to a segment, if nil it is the distance F of the point to its projection.
+	Segment SegmentDistance
+}
+
+// segment returns the distance of a point to a segment.
+func (el *ElementDistance) segment() SegmentDistance {
+	if el.Segment != nil {
+		return el.Segment
+	}
+	return segmentDistance(el.F)
+}
+
+// element returns the element distance of from and to with the same distances.
+func (el *ElementDistance) element(from, to matrix.Steric) *ElementDistance {
+	return &ElementDistance{From: from, To: to, F: el.F, Segment: el.Segment}
 }
 
 // Distance returns distance Between the two Geometry.
@@ -134,41 +173,44 @@ func (el *ElementDistance) Distance() (float64, error) {
 		if from, ok := el.From.(matrix.Matrix); ok {
 			return el.F(to, from), nil
 		}
-		elem := &ElementDistance{el.To, el.From, el.F}
-		return elem.Distance()
+		return el.element(el.To, el.From).Distance()
 	case matrix.LineMatrix:
 		if from, ok := el.From.(matrix.Matrix); ok {
-			return DistanceLineToPoint(to, from, el.F), nil
+			return distanceLineToPoint(to, from, el.segment()), nil
 		} else if _, ok := el.From.(matrix.LineMatrix); ok {
 			return el.distanceLineAndLine()
 		}
-		elem := &ElementDistance{el.To, el.From, el.F}
-		return elem.Distance()
+		return el.element(el.To, el.From).Distance()
 	case matrix.PolygonMatrix:
 		if from, ok := el.From.(matrix.Matrix); ok {
-			return DistancePolygonToPoint(to, from, el.F), nil
+			return distancePolygonToPoint(to, from, el.segment()), nil
 		} else if _, ok := el.From.(matrix.LineMatrix); ok {
 			return el.distancePolygonAndLine()
 		} else if from, ok := el.From.(matrix.PolygonMatrix); ok {
-			var dist float64
+			// a polygon inside the other one
+			if distancePolygonToPoint(to, from[0][0], el.segment()) == 0 ||
+				distancePolygonToPoint(from, to[0][0], el.segment()) == 0 {
+				return 0, nil
+			}
+			dist := math.Inf(1)
 			for _, v := range from {
-				elem := &ElementDistance{matrix.LineMatrix(v), el.To, el.F}
-				if distP, _ := elem.Distance(); dist > distP {
+				if distP, _ := el.element(matrix.LineMatrix(v), el.To).Distance(); distP < dist {
 					dist = distP
 				}
 			}
 			return dist, nil
 		}
-		elem := &ElementDistance{el.To, el.From, el.F}
-		return elem.Distance()
+		return el.element(el.To, el.From).Distance()
 	case matrix.Collection:
-		var dist float64
+		dist := math.Inf(1)
 		for _, v := range to {
-			elem := &ElementDistance{v, el.From, el.F}
-			if distP, err := elem.Distance(); err == nil && dist > distP {
+			if distP, err := el.element(el.From, v).Distance(); err == nil && distP < dist {
 				dist = distP
 			}
 		}
+		if math.IsInf(dist, 1) {
+			return 0, nil
+		}
 		return dist, nil
 	default:
 		return 0, nil
@@ -177,25 +219,30 @@ func (el *ElementDistance) Distance() (float64, error) {
 
 // distanceLineAndLine returns distance Between the two Geometry.
 func (el *ElementDistance) distanceLineAndLine() (float64, error) {
-	var dist float64
-	if mark := relate.IsIntersectionEdge(el.From.(matrix.LineMatrix), el.To.(matrix.LineMatrix)); mark {
+	from, to := el.From.(matrix.LineMatrix), el.To.(matrix.LineMatrix)
+	if mark := relate.IsIntersectionEdge(from, to); mark {
 		return 0, nil
 	}
-	for _, v := range el.From.(matrix.LineMatrix) {
-		elem := &ElementDistance{matrix.Matrix(v), el.To, el.F}
-		if distP, _ := elem.Distance(); dist > distP {
-			dist = distP
-		}
+	// lines which do not cross are nearest at a vertex of one of them
+	dist := math.Inf(1)
+	for _, v := range from {
+		dist = math.Min(dist, distanceLineToPoint(to, v, el.segment()))
+	}
+	for _, v := range to {
+		dist = math.Min(dist, distanceLineToPoint(from, v, el.segment()))
 	}
 	return dist, nil
 }
 
 // distancePolygonAndLine returns distance Between the two Geometry.
 func (el *ElementDistance) distancePolygonAndLine() (float64, error) {
-	var dist float64
-	for _, v := range el.To.(matrix.PolygonMatrix) {
-		elem := &ElementDistance{matrix.LineMatrix(v), el.From, el.F}
-		if distP, _ := elem.Distance(); dist > distP {
+	poly := el.To.(matrix.PolygonMatrix)
+	if distancePolygonToPoint(poly, el.From.(matrix.LineMatrix)[0], el.segment()) == 0 {
+		return 0, nil
+	}
+	dist := math.Inf(1)
+	for _, v := range poly {
+		if distP, _ := el.element(el.From, matrix.LineMatrix(v)).Distance(); distP < dist {
 			dist = distP
 		}
 	}
@@ -53,3 +53,29 @@ func TestMercatorDistance(t *testing.T) {
 		})
 	}
 }
+
+func TestElementDistance_Distance(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	tests := []struct {
+		name     string
+		from, to matrix.Steric
+		want     float64
+	}{
+		{name: "point line", from: matrix.Matrix{0, 0}, to: matrix.LineMatrix{{-1, 1}, {1, 1}}, want: 1},
+		{name: "line line", from: matrix.LineMatrix{{0, 0}, {1, 0}}, to: matrix.LineMatrix{{-1, 3}, {2, 3}}, want: 3},
+		{name: "line line crossing", from: matrix.LineMatrix{{0, 0}, {2, 2}}, to: matrix.LineMatrix{{0, 2}, {2, 0}}, want: 0},
+		{name: "point polygon inside", from: matrix.Matrix{2, 2}, to: square, want: 0},
+		{name: "point polygon hole", from: matrix.Matrix{5, 5.5}, to: square, want: 0.5},
+		{name: "point polygon outside", from: matrix.Matrix{12, 5}, to: square, want: 2},
+		{name: "polygon polygon", from: matrix.PolygonMatrix{{{13, 0}, {14, 0}, {14, 1}, {13, 0}}}, to: square, want: 3},
+		{name: "collection", from: matrix.Matrix{0, 0}, to: matrix.Collection{matrix.Matrix{5, 0}, matrix.LineMatrix{{0, 2}, {2, 2}}}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			elem := &ElementDistance{From: tt.from, To: tt.to, F: PlanarDistance}
+			if got, err := elem.Distance(); err != nil || got != tt.want {
+				t.Errorf("Distance() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
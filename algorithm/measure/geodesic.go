@@ -0,0 +1,959 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// order of the series expansions of the geodesic, accurate to round-off for |f| < 0.01.
+const (
+	geodesicOrder = 6
+	nA1           = geodesicOrder
+	nC1           = geodesicOrder
+	nC1p          = geodesicOrder
+	nA2           = geodesicOrder
+	nC2           = geodesicOrder
+	nA3           = geodesicOrder
+	nA3x          = nA3
+	nC3           = geodesicOrder
+	nC3x          = (nC3 * (nC3 - 1)) / 2
+	nC4           = geodesicOrder
+	nC4x          = (nC4 * (nC4 + 1)) / 2
+
+	geodesicMaxit1 = 20
+	geodesicMaxit2 = geodesicMaxit1 + 53 + 10
+)
+
+var (
+	geodesicTiny    = math.Sqrt(math.SmallestNonzeroFloat64 * (1 << 52))
+	geodesicTol0    = math.Nextafter(1, 2) - 1
+	geodesicTol1    = 200 * geodesicTol0
+	geodesicTol2    = math.Sqrt(geodesicTol0)
+	geodesicTolb    = geodesicTol0 * geodesicTol2
+	geodesicXthresh = 1000 * geodesicTol2
+)
+
+// Geodesic solves the geodesic problems on an ellipsoid of revolution,
+// by the method of C. F. F. Karney, Algorithms for geodesics, J. Geodesy 87, 43-55 (2013).
+// The solutions are accurate to round-off, about 15 nanometers for the earth.
+// Points are matrix.Matrix{lng, lat}, unit degree, distances unit m and areas unit m².
+type Geodesic struct {
+	a, f float64
+
+	f1, e2, ep2, n, b, c2, etol2 float64
+
+	a3x [nA3x]float64
+	c3x [nC3x]float64
+	c4x [nC4x]float64
+}
+
+// Defined geodesics of the earth ellipsoids.
+var (
+	// WGS84Geodesic geodesic on the WGS84 ellipsoid.
+	WGS84Geodesic = NewGeodesic(6378137.0, 1/298.257223563)
+	// CGCS2000Geodesic geodesic on the CGCS2000 ellipsoid.
+	CGCS2000Geodesic = NewGeodesic(6378137.0, 1/298.257222101)
+	// SphereGeodesic great circle on the sphere of radius R.
+	SphereGeodesic = NewGeodesic(R, 0)
+)
+
+// NewGeodesic Creates a Geodesic of the ellipsoid with semi-major axis a (unit m) and flattening f.
+func NewGeodesic(a, f float64) *Geodesic {
+	g := &Geodesic{a: a, f: f}
+	g.f1 = 1 - f
+	g.e2 = f * (2 - f)
+	g.ep2 = g.e2 / (g.f1 * g.f1)
+	g.n = f / (2 - f)
+	g.b = a * g.f1
+	switch {
+	case g.e2 == 0:
+		g.c2 = a * a
+	case g.e2 > 0:
+		g.c2 = (a*a + g.b*g.b*math.Atanh(math.Sqrt(g.e2))/math.Sqrt(g.e2)) / 2
+	default:
+		g.c2 = (a*a + g.b*g.b*math.Atan(math.Sqrt(-g.e2))/math.Sqrt(-g.e2)) / 2
+	}
+	g.etol2 = 0.1 * geodesicTol2 / math.Sqrt(math.Max(0.001, math.Abs(f))*math.Min(1, 1-f/2)/2)
+	g.a3coeff()
+	g.c3coeff()
+	g.c4coeff()
+	return g
+}
+
+// A returns the semi-major axis of the ellipsoid, unit m.
+func (g *Geodesic) A() float64 {
+	return g.a
+}
+
+// F returns the flattening of the ellipsoid.
+func (g *Geodesic) F() float64 {
+	return g.f
+}
+
+// EllipsoidArea returns the total area of the ellipsoid, unit m².
+func (g *Geodesic) EllipsoidArea() float64 {
+	return 4 * math.Pi * g.c2
+}
+
+// Inverse solves the inverse geodesic problem, it returns the distance from point from to point to,
+// the azimuth at from and the azimuth at to, azimuths are clockwise from north, unit degree.
+func (g *Geodesic) Inverse(from, to matrix.Matrix) (s12, azi1, azi2 float64) {
+	r := g.genInverse(from[1], from[0], to[1], to[0], false)
+	return r.s12, atan2d(r.salp1, r.calp1), atan2d(r.salp2, r.calp2)
+}
+
+// Distance returns the geodesic distance of two points, unit m.
+func (g *Geodesic) Distance(from, to matrix.Matrix) float64 {
+	return g.genInverse(from[1], from[0], to[1], to[0], false).s12
+}
+
+// Direct solves the direct geodesic problem, it returns the point at distance s12 (unit m)
+// from point from along the geodesic with azimuth azi1 (unit degree), and the azimuth at that point.
+func (g *Geodesic) Direct(from matrix.Matrix, azi1, s12 float64) (matrix.Matrix, float64) {
+	l := g.newLine(from[1], from[0], azi1)
+	lat2, lon2, azi2 := l.position(s12)
+	return matrix.Matrix{lon2, lat2}, azi2
+}
+
+// SegmentDistance returns the geodesic distance of the point p to the geodesic segment a-b, unit m.
+// The nearest point of the segment is found by a golden section search along the segment.
+func (g *Geodesic) SegmentDistance(p, a, b matrix.Matrix) float64 {
+	s12, azi1, _ := g.Inverse(a, b)
+	if s12 == 0 {
+		return g.Distance(p, a)
+	}
+	l := g.newLine(a[1], a[0], azi1)
+	distance := func(s float64) float64 {
+		lat, lon, _ := l.position(s)
+		return g.Distance(p, matrix.Matrix{lon, lat})
+	}
+	ratio := (math.Sqrt(5) - 1) / 2
+	lo, hi := 0.0, s12
+	x1, x2 := hi-ratio*(hi-lo), lo+ratio*(hi-lo)
+	d1, d2 := distance(x1), distance(x2)
+	for hi-lo > 1e-3 {
+		if d1 < d2 {
+			hi, x2, d2 = x2, x1, d1
+			x1 = hi - ratio*(hi-lo)
+			d1 = distance(x1)
+		} else {
+			lo, x1, d1 = x1, x2, d2
+			x2 = lo + ratio*(hi-lo)
+			d2 = distance(x2)
+		}
+	}
+	return math.Min(math.Min(d1, d2), math.Min(g.Distance(p, a), g.Distance(p, b)))
+}
+
+// GeodesicDistance returns the geodesic distance of two points on the WGS84 ellipsoid, unit m.
+func GeodesicDistance(from, to matrix.Matrix) float64 {
+	return WGS84Geodesic.Distance(from, to)
+}
+
+// inverseResult results of the inverse problem.
+type inverseResult struct {
+	s12, s12Area               float64
+	salp1, calp1, salp2, calp2 float64
+}
+
+func (g *Geodesic) genInverse(lat1, lon1, lat2, lon2 float64, area bool) inverseResult {
+	var r inverseResult
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := math.Copysign(1, lon12)
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := lon12 * math.Pi / 180
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	lat1, lat2 = angRound(latFix(lat1)), angRound(latFix(lat2))
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) || math.IsNaN(lat2) {
+		swapp = -1
+		lonsign *= -1
+		lat1, lat2 = lat2, lat1
+	}
+	latsign := math.Copysign(1, -lat1)
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1, cbet1 = norm(g.f1*sbet1, cbet1)
+	cbet1 = math.Max(geodesicTiny, cbet1)
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2, cbet2 = norm(g.f1*sbet2, cbet2)
+	cbet2 = math.Max(geodesicTiny, cbet2)
+
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+	dn1 := math.Sqrt(1 + g.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + g.ep2*sbet2*sbet2)
+
+	var c1a [nC1 + 1]float64
+	var c2a [nC2 + 1]float64
+	var c3a [nC3]float64
+
+	var salp1, calp1, salp2, calp2, sig12, s12x, m12x, omg12 float64
+	somg12, comg12 := math.NaN(), math.NaN()
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		calp1, salp1 = clam12, slam12
+		calp2, salp2 = 1, 0
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+		sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+		s12x, m12x, _ = g.lengths(g.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+		if sig12 < 1 || m12x >= 0 {
+			if sig12 < 3*geodesicTiny || (sig12 < geodesicTol0 && (s12x < 0 || m12x < 0)) {
+				sig12, m12x, s12x = 0, 0, 0
+			}
+			m12x *= g.b
+			s12x *= g.b
+		} else {
+			meridian = false
+		}
+	}
+
+	if !meridian && sbet1 == 0 && (g.f <= 0 || lon12s >= g.f*180) {
+		// geodesic runs along equator.
+		calp1, calp2 = 0, 0
+		salp1, salp2 = 1, 1
+		s12x = g.a * lam12
+		sig12 = lam12 / g.f1
+		omg12 = sig12
+		m12x = g.b * math.Sin(sig12)
+	} else if !meridian {
+		var dnm float64
+		sig12, salp1, calp1, salp2, calp2, dnm = g.inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2,
+			lam12, slam12, clam12, c1a[:], c2a[:])
+		if sig12 >= 0 {
+			s12x = sig12 * g.b * dnm
+			m12x = dnm * dnm * g.b * math.Sin(sig12/dnm)
+			omg12 = lam12 / (g.f1 * dnm)
+		} else {
+			var ssig1, csig1, ssig2, csig2, eps, domg12 float64
+			numit := 0
+			tripn, tripb := false, false
+			salp1a, calp1a := geodesicTiny, 1.0
+			salp1b, calp1b := geodesicTiny, -1.0
+			for ; numit < geodesicMaxit2; numit++ {
+				var v, dv float64
+				v, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dv = g.lambda12(
+					sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam12, clam12,
+					numit < geodesicMaxit1, c1a[:], c2a[:], c3a[:])
+				tol := geodesicTol0
+				if tripn {
+					tol *= 8
+				}
+				if tripb || !(math.Abs(v) >= tol) {
+					break
+				}
+				if v > 0 && (numit > geodesicMaxit1 || calp1/salp1 > calp1b/salp1b) {
+					salp1b, calp1b = salp1, calp1
+				} else if v < 0 && (numit > geodesicMaxit1 || calp1/salp1 < calp1a/salp1a) {
+					salp1a, calp1a = salp1, calp1
+				}
+				if numit+1 < geodesicMaxit1 && dv > 0 {
+					dalp1 := -v / dv
+					if math.Abs(dalp1) < math.Pi {
+						sdalp1, cdalp1 := math.Sincos(dalp1)
+						nsalp1 := salp1*cdalp1 + calp1*sdalp1
+						if nsalp1 > 0 {
+							calp1 = calp1*cdalp1 - salp1*sdalp1
+							salp1 = nsalp1
+							salp1, calp1 = norm(salp1, calp1)
+							tripn = math.Abs(v) <= 16*geodesicTol0
+							continue
+						}
+					}
+				}
+				// bisection when newton's method fails.
+				salp1, calp1 = norm((salp1a+salp1b)/2, (calp1a+calp1b)/2)
+				tripn = false
+				tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < geodesicTolb ||
+					math.Abs(salp1-salp1b)+(calp1-calp1b) < geodesicTolb
+			}
+			s12x, m12x, _ = g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a[:], c2a[:])
+			m12x *= g.b
+			s12x *= g.b
+			if area {
+				sdomg12, cdomg12 := math.Sincos(domg12)
+				somg12 = slam12*cdomg12 - clam12*sdomg12
+				comg12 = clam12*cdomg12 + slam12*sdomg12
+			}
+		}
+	}
+	r.s12 = 0 + s12x
+
+	if area {
+		salp0 := salp1 * cbet1
+		calp0 := math.Hypot(calp1, salp1*sbet1)
+		if calp0 != 0 && salp0 != 0 {
+			ssig1, csig1 := norm(sbet1, calp1*cbet1)
+			ssig2, csig2 := norm(sbet2, calp2*cbet2)
+			k2 := calp0 * calp0 * g.ep2
+			eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			a4 := g.a * g.a * calp0 * salp0 * g.e2
+			var c4a [nC4]float64
+			g.c4f(eps, c4a[:])
+			b41 := sinCosSeries(false, ssig1, csig1, c4a[:])
+			b42 := sinCosSeries(false, ssig2, csig2, c4a[:])
+			r.s12Area = a4 * (b42 - b41)
+		}
+		if !meridian && math.IsNaN(somg12) {
+			somg12, comg12 = math.Sincos(omg12)
+		}
+		var alp12 float64
+		if !meridian && comg12 > -0.7071 && sbet2-sbet1 < 1.75 {
+			domg12 := 1 + comg12
+			dbet1, dbet2 := 1+cbet1, 1+cbet2
+			alp12 = 2 * math.Atan2(somg12*(sbet1*dbet2+sbet2*dbet1), domg12*(sbet1*sbet2+dbet1*dbet2))
+		} else {
+			salp12 := salp2*calp1 - calp2*salp1
+			calp12 := calp2*calp1 + salp2*salp1
+			if salp12 == 0 && calp12 < 0 {
+				salp12 = geodesicTiny * calp1
+				calp12 = -1
+			}
+			alp12 = math.Atan2(salp12, calp12)
+		}
+		r.s12Area += g.c2 * alp12
+		r.s12Area *= swapp * lonsign * latsign
+		r.s12Area += 0
+	}
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+	r.salp1, r.calp1 = salp1*swapp*lonsign, calp1*swapp*latsign
+	r.salp2, r.calp2 = salp2*swapp*lonsign, calp2*swapp*latsign
+	return r
+}
+
+// lengths returns the distance and reduced length scaled by b, and m0.
+func (g *Geodesic) lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2 float64,
+	c1a, c2a []float64) (s12b, m12b, m0 float64) {
+	a1 := a1m1f(eps)
+	c1f(eps, c1a)
+	a2 := a2m1f(eps)
+	c2f(eps, c2a)
+	m0 = a1 - a2
+	a1, a2 = 1+a1, 1+a2
+	b1 := sinCosSeries(true, ssig2, csig2, c1a) - sinCosSeries(true, ssig1, csig1, c1a)
+	s12b = a1 * (sig12 + b1)
+	b2 := sinCosSeries(true, ssig2, csig2, c2a) - sinCosSeries(true, ssig1, csig1, c2a)
+	j12 := m0*sig12 + (a1*b1 - a2*b2)
+	m12b = dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+	return s12b, m12b, m0
+}
+
+// inverseStart returns a starting point for newton's method, sig12 >= 0 means the short line solution is used.
+func (g *Geodesic) inverseStart(sbet1, cbet1, dn1, sbet2, cbet2, dn2, lam12, slam12, clam12 float64,
+	c1a, c2a []float64) (sig12, salp1, calp1, salp2, calp2, dnm float64) {
+	sig12 = -1
+	salp2, calp2, dnm = math.NaN(), math.NaN(), math.NaN()
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		dnm = math.Sqrt(1 + g.ep2*sbetm2)
+		omg12 := lam12 / (g.f1 * dnm)
+		somg12, comg12 = math.Sincos(omg12)
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	if shortline && ssig12 < g.etol2 {
+		salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			calp2 = sbet12 - cbet1*sbet2*(somg12*somg12/(1+comg12))
+		} else {
+			calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		salp2, calp2 = norm(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	} else if math.Abs(g.n) >= 0.1 || csig12 >= 0 || ssig12 >= 6*math.Abs(g.n)*math.Pi*cbet1*cbet1 {
+		// nothing to do, zeroth order spherical approximation is OK.
+	} else {
+		// nearly antipodal points, scale to the astroid problem.
+		lam12x := math.Atan2(-slam12, -clam12)
+		var x, y, lamscale, betscale float64
+		if g.f >= 0 {
+			k2 := sbet1 * sbet1 * g.ep2
+			eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+			lamscale = g.f * cbet1 * g.a3f(eps) * math.Pi
+			betscale = lamscale * cbet1
+			x = lam12x / lamscale
+			y = sbet12a / betscale
+		} else {
+			cbet12a := cbet2*cbet1 - sbet2*sbet1
+			bet12a := math.Atan2(sbet12a, cbet12a)
+			_, m12b, m0 := g.lengths(g.n, math.Pi+bet12a, sbet1, -cbet1, dn1, sbet2, cbet2, dn2, c1a, c2a)
+			x = -1 + m12b/(cbet1*cbet2*m0*math.Pi)
+			if x < -0.01 {
+				betscale = sbet12a / x
+			} else {
+				betscale = -g.f * cbet1 * cbet1 * math.Pi
+			}
+			lamscale = betscale / cbet1
+			y = lam12x / lamscale
+		}
+		if y > -geodesicTol1 && x > -1-geodesicXthresh {
+			if g.f >= 0 {
+				salp1 = math.Min(1, -x)
+				calp1 = -math.Sqrt(1 - salp1*salp1)
+			} else {
+				if x > -geodesicTol1 {
+					calp1 = math.Max(0, x)
+				} else {
+					calp1 = math.Max(-1, x)
+				}
+				salp1 = math.Sqrt(1 - calp1*calp1)
+			}
+		} else {
+			k := astroid(x, y)
+			var omg12a float64
+			if g.f >= 0 {
+				omg12a = lamscale * (-x * k / (1 + k))
+			} else {
+				omg12a = lamscale * (-y * (1 + k) / k)
+			}
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+	if !(salp1 <= 0) {
+		salp1, calp1 = norm(salp1, calp1)
+	} else {
+		salp1, calp1 = 1, 0
+	}
+	return
+}
+
+// lambda12 solves the hybrid problem, it returns the longitude difference minus the expected one
+// and its derivative with respect to alp1 if diffp.
+func (g *Geodesic) lambda12(sbet1, cbet1, dn1, sbet2, cbet2, dn2, salp1, calp1, slam120, clam120 float64,
+	diffp bool, c1a, c2a, c3a []float64) (lam12, salp2, calp2, sig12, ssig1, csig1, ssig2, csig2, eps, domg12, dlam12 float64) {
+	if sbet1 == 0 && calp1 == 0 {
+		calp1 = -geodesicTiny
+	}
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	ssig1 = sbet1
+	somg1 := salp0 * sbet1
+	csig1 = calp1 * cbet1
+	comg1 := csig1
+	ssig1, csig1 = norm(ssig1, csig1)
+
+	if cbet2 != cbet1 {
+		salp2 = salp0 / cbet2
+	} else {
+		salp2 = salp1
+	}
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		var t float64
+		if cbet1 < -sbet1 {
+			t = (cbet2 - cbet1) * (cbet1 + cbet2)
+		} else {
+			t = (sbet1 - sbet2) * (sbet1 + sbet2)
+		}
+		calp2 = math.Sqrt(calp1*cbet1*calp1*cbet1+t) / cbet2
+	} else {
+		calp2 = math.Abs(calp1)
+	}
+	ssig2 = sbet2
+	somg2 := salp0 * sbet2
+	csig2 = calp2 * cbet2
+	comg2 := csig2
+	ssig2, csig2 = norm(ssig2, csig2)
+
+	sig12 = math.Atan2(math.Max(0, csig1*ssig2-ssig1*csig2), csig1*csig2+ssig1*ssig2)
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+	k2 := calp0 * calp0 * g.ep2
+	eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+	g.c3f(eps, c3a)
+	b312 := sinCosSeries(true, ssig2, csig2, c3a) - sinCosSeries(true, ssig1, csig1, c3a)
+	domg12 = -g.f * g.a3f(eps) * salp0 * (sig12 + b312)
+	lam12 = eta + domg12
+	if diffp {
+		if calp2 == 0 {
+			dlam12 = -2 * g.f1 * dn1 / sbet1
+		} else {
+			_, dlam12, _ = g.lengths(eps, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a, c2a)
+			dlam12 *= g.f1 / (calp2 * cbet2)
+		}
+	} else {
+		dlam12 = math.NaN()
+	}
+	return
+}
+
+// geodesicLine a geodesic from a point with an azimuth, used by the direct problem.
+type geodesicLine struct {
+	g                                 *Geodesic
+	lat1, lon1                        float64
+	salp0, calp0, k2                  float64
+	ssig1, csig1, somg1, comg1        float64
+	a1m1, b11, stau1, ctau1, a3c, b31 float64
+	c1a                               [nC1 + 1]float64
+	c1pa                              [nC1p + 1]float64
+	c3a                               [nC3]float64
+}
+
+func (g *Geodesic) newLine(lat1, lon1, azi1 float64) *geodesicLine {
+	l := &geodesicLine{g: g, lat1: latFix(lat1), lon1: lon1}
+	salp1, calp1 := sincosd(angRound(azi1))
+	sbet1, cbet1 := sincosd(angRound(l.lat1))
+	sbet1, cbet1 = norm(g.f1*sbet1, cbet1)
+	cbet1 = math.Max(geodesicTiny, cbet1)
+	l.salp0 = salp1 * cbet1
+	l.calp0 = math.Hypot(calp1, salp1*sbet1)
+	l.ssig1 = sbet1
+	l.somg1 = l.salp0 * sbet1
+	if sbet1 != 0 || calp1 != 0 {
+		l.csig1 = cbet1 * calp1
+	} else {
+		l.csig1 = 1
+	}
+	l.comg1 = l.csig1
+	l.ssig1, l.csig1 = norm(l.ssig1, l.csig1)
+	l.k2 = l.calp0 * l.calp0 * g.ep2
+	eps := l.k2 / (2*(1+math.Sqrt(1+l.k2)) + l.k2)
+
+	l.a1m1 = a1m1f(eps)
+	c1f(eps, l.c1a[:])
+	l.b11 = sinCosSeries(true, l.ssig1, l.csig1, l.c1a[:])
+	s, c := math.Sincos(l.b11)
+	l.stau1 = l.ssig1*c + l.csig1*s
+	l.ctau1 = l.csig1*c - l.ssig1*s
+	c1pf(eps, l.c1pa[:])
+
+	l.a3c = -g.f * l.salp0 * g.a3f(eps)
+	g.c3f(eps, l.c3a[:])
+	l.b31 = sinCosSeries(true, l.ssig1, l.csig1, l.c3a[:])
+	return l
+}
+
+// position returns the point at distance s12 along the line.
+func (l *geodesicLine) position(s12 float64) (lat2, lon2, azi2 float64) {
+	g := l.g
+	tau12 := s12 / (g.b * (1 + l.a1m1))
+	s, c := math.Sincos(tau12)
+	b12 := -sinCosSeries(true, l.stau1*c+l.ctau1*s, l.ctau1*c-l.stau1*s, l.c1pa[:])
+	sig12 := tau12 - (b12 - l.b11)
+	ssig12, csig12 := math.Sincos(sig12)
+	if math.Abs(g.f) > 0.01 {
+		ssig2 := l.ssig1*csig12 + l.csig1*ssig12
+		csig2 := l.csig1*csig12 - l.ssig1*ssig12
+		b12 = sinCosSeries(true, ssig2, csig2, l.c1a[:])
+		serr := (1+l.a1m1)*(sig12+(b12-l.b11)) - s12/g.b
+		sig12 = sig12 - serr/math.Sqrt(1+l.k2*ssig2*ssig2)
+		ssig12, csig12 = math.Sincos(sig12)
+	}
+	ssig2 := l.ssig1*csig12 + l.csig1*ssig12
+	csig2 := l.csig1*csig12 - l.ssig1*ssig12
+	sbet2 := l.calp0 * ssig2
+	cbet2 := math.Hypot(l.salp0, l.calp0*csig2)
+	if cbet2 == 0 {
+		cbet2, csig2 = geodesicTiny, geodesicTiny
+	}
+	salp2, calp2 := l.salp0, l.calp0*csig2
+	somg2, comg2 := l.salp0*ssig2, csig2
+	omg12 := math.Atan2(somg2*l.comg1-comg2*l.somg1, comg2*l.comg1+somg2*l.somg1)
+	lam12 := omg12 + l.a3c*(sig12+(sinCosSeries(true, ssig2, csig2, l.c3a[:])-l.b31))
+	lon12 := lam12 * 180 / math.Pi
+	lon2 = angNormalize(angNormalize(l.lon1) + angNormalize(lon12))
+	lat2 = atan2d(sbet2, g.f1*cbet2)
+	azi2 = atan2d(salp2, calp2)
+	return
+}
+
+func (g *Geodesic) a3f(eps float64) float64 {
+	return polyval(nA3x-1, g.a3x[:], 0, eps)
+}
+
+func (g *Geodesic) c3f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 1; l < nC3; l++ {
+		m := nC3 - l - 1
+		mult *= eps
+		c[l] = mult * polyval(m, g.c3x[:], o, eps)
+		o += m + 1
+	}
+}
+
+func (g *Geodesic) c4f(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 0; l < nC4; l++ {
+		m := nC4 - l - 1
+		c[l] = mult * polyval(m, g.c4x[:], o, eps)
+		o += m + 1
+		mult *= eps
+	}
+}
+
+func (g *Geodesic) a3coeff() {
+	coeff := []float64{
+		-3, 128,
+		-2, -3, 64,
+		-1, -3, -1, 16,
+		3, -1, -2, 8,
+		1, -1, 2,
+		1, 1,
+	}
+	o, k := 0, 0
+	for j := nA3 - 1; j >= 0; j-- {
+		m := nA3 - j - 1
+		if j < m {
+			m = j
+		}
+		g.a3x[k] = polyval(m, coeff, o, g.n) / coeff[o+m+1]
+		k++
+		o += m + 2
+	}
+}
+
+func (g *Geodesic) c3coeff() {
+	coeff := []float64{
+		3, 128,
+		2, 5, 128,
+		-1, 3, 3, 64,
+		-1, 0, 1, 8,
+		-1, 1, 4,
+		5, 256,
+		1, 3, 128,
+		-3, -2, 3, 64,
+		1, -3, 2, 32,
+		7, 512,
+		-10, 9, 384,
+		5, -9, 5, 192,
+		7, 512,
+		-14, 7, 512,
+		21, 2560,
+	}
+	o, k := 0, 0
+	for l := 1; l < nC3; l++ {
+		for j := nC3 - 1; j >= l; j-- {
+			m := nC3 - j - 1
+			if j < m {
+				m = j
+			}
+			g.c3x[k] = polyval(m, coeff, o, g.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+func (g *Geodesic) c4coeff() {
+	coeff := []float64{
+		97, 15015,
+		1088, 156, 45045,
+		-224, -4784, 1573, 45045,
+		-10656, 14144, -4576, -858, 45045,
+		64, 624, -4576, 6864, -3003, 15015,
+		100, 208, 572, 3432, -12012, 30030, 45045,
+		1, 9009,
+		-2944, 468, 135135,
+		5792, 1040, -1287, 135135,
+		5952, -11648, 9152, -2574, 135135,
+		-64, -624, 4576, -6864, 3003, 135135,
+		8, 10725,
+		1856, -936, 225225,
+		-8448, 4992, -1144, 225225,
+		-1440, 4160, -4576, 1716, 225225,
+		-136, 63063,
+		1024, -208, 105105,
+		3584, -3328, 1144, 315315,
+		-128, 135135,
+		-2560, 832, 405405,
+		128, 99099,
+	}
+	o, k := 0, 0
+	for l := 0; l < nC4; l++ {
+		for j := nC4 - 1; j >= l; j-- {
+			m := nC4 - j - 1
+			g.c4x[k] = polyval(m, coeff, o, g.n) / coeff[o+m+1]
+			k++
+			o += m + 2
+		}
+	}
+}
+
+func a1m1f(eps float64) float64 {
+	coeff := []float64{1, 4, 64, 0, 256}
+	m := nA1 / 2
+	t := polyval(m, coeff, 0, eps*eps) / coeff[m+1]
+	return (t + eps) / (1 - eps)
+}
+
+func c1f(eps float64, c []float64) {
+	coeff := []float64{
+		-1, 6, -16, 32,
+		-9, 64, -128, 2048,
+		9, -16, 768,
+		3, -5, 512,
+		-7, 1280,
+		-7, 2048,
+	}
+	seriesCoeff(eps, nC1, coeff, c)
+}
+
+func c1pf(eps float64, c []float64) {
+	coeff := []float64{
+		205, -432, 768, 1536,
+		4005, -4736, 3840, 12288,
+		-225, 116, 384,
+		-7173, 2695, 7680,
+		3467, 7680,
+		38081, 61440,
+	}
+	seriesCoeff(eps, nC1p, coeff, c)
+}
+
+func a2m1f(eps float64) float64 {
+	coeff := []float64{-11, -28, -192, 0, 256}
+	m := nA2 / 2
+	t := polyval(m, coeff, 0, eps*eps) / coeff[m+1]
+	return (t - eps) / (1 + eps)
+}
+
+func c2f(eps float64, c []float64) {
+	coeff := []float64{
+		1, 2, 16, 32,
+		35, 64, 384, 2048,
+		15, 80, 768,
+		7, 35, 512,
+		63, 1280,
+		77, 2048,
+	}
+	seriesCoeff(eps, nC2, coeff, c)
+}
+
+// seriesCoeff evaluates the coefficients c[1..n] of the series in eps.
+func seriesCoeff(eps float64, n int, coeff, c []float64) {
+	eps2 := eps * eps
+	d := eps
+	o := 0
+	for l := 1; l <= n; l++ {
+		m := (n - l) / 2
+		c[l] = d * polyval(m, coeff, o, eps2) / coeff[o+m+1]
+		o += m + 2
+		d *= eps
+	}
+}
+
+// sinCosSeries evaluates the sine (sinp) or cosine series by Clenshaw summation.
+func sinCosSeries(sinp bool, sinx, cosx float64, c []float64) float64 {
+	k := len(c)
+	n := k
+	if sinp {
+		n--
+	}
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+	y0, y1 := 0.0, 0.0
+	if n&1 != 0 {
+		k--
+		y0 = c[k]
+	}
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+	if sinp {
+		return 2 * sinx * cosx * y0
+	}
+	return cosx * (y0 - y1)
+}
+
+// astroid solves k^4+2*k^3-(x^2+y^2-1)*k^2-2*y^2*k-y^2 = 0 for the positive root k.
+func astroid(x, y float64) float64 {
+	p, q := x*x, y*y
+	r := (p + q - 1) / 6
+	if q == 0 && r <= 0 {
+		return 0
+	}
+	s := p * q / 4
+	r2 := r * r
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+		t := math.Cbrt(t3)
+		if t != 0 {
+			u += t + r2/t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+	v := math.Sqrt(u*u + q)
+	var uv float64
+	if u < 0 {
+		uv = q / (v - u)
+	} else {
+		uv = u + v
+	}
+	w := (uv - q) / (2 * v)
+	return uv / (math.Sqrt(uv+w*w) + w)
+}
+
+func polyval(n int, p []float64, s int, x float64) float64 {
+	if n < 0 {
+		return 0
+	}
+	y := p[s]
+	for ; n > 0; n-- {
+		s++
+		y = y*x + p[s]
+	}
+	return y
+}
+
+func norm(x, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
+
+// sumError returns the sum of u and v and its round-off error.
+func sumError(u, v float64) (float64, float64) {
+	s := u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+	if s == 0 {
+		return s, s
+	}
+	return s, -(up + vpp)
+}
+
+// angRound rounds tiny angles so that the results are symmetric.
+func angRound(x float64) float64 {
+	z := 1.0 / 16
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+	if x == 0 {
+		return 0
+	}
+	return math.Copysign(y, x)
+}
+
+func latFix(x float64) float64 {
+	if math.Abs(x) > 90 {
+		return math.NaN()
+	}
+	return x
+}
+
+// angNormalize reduces angle to [-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if math.Abs(y) == 180 {
+		return math.Copysign(180, x)
+	}
+	return y
+}
+
+// angDiff returns the exact difference y - x reduced to [-180, 180] and its round-off error.
+func angDiff(x, y float64) (float64, float64) {
+	d, t := sumError(math.Remainder(-x, 360), math.Remainder(y, 360))
+	d, t = sumError(math.Remainder(d, 360), t)
+	if d == 0 || math.Abs(d) == 180 {
+		if t == 0 {
+			d = math.Copysign(d, y-x)
+		} else {
+			d = math.Copysign(d, -t)
+		}
+	}
+	return d, t
+}
+
+// sincosd returns sine and cosine of x in degree with exact results of multiples of 90.
+func sincosd(x float64) (float64, float64) {
+	r := math.Mod(x, 360)
+	q := 0
+	if !math.IsNaN(r) {
+		q = int(math.Round(r / 90))
+	}
+	r -= 90 * float64(q)
+	r = r * math.Pi / 180
+	s, c := math.Sincos(r)
+	switch ((q % 4) + 4) % 4 {
+	case 1:
+		s, c = c, -s
+	case 2:
+		s, c = -s, -c
+	case 3:
+		s, c = -c, s
+	}
+	c += 0
+	if x == 0 {
+		s = x
+	}
+	return s, c
+}
+
+// atan2d returns atan2(y, x) in degree.
+func atan2d(y, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		q = 2
+		x, y = y, x
+	}
+	if x < 0 {
+		q++
+		x = -x
+	}
+	ang := math.Atan2(y, x) * 180 / math.Pi
+	switch q {
+	case 1:
+		ang = math.Copysign(180, y) - ang
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+	return ang
+}
@@ -0,0 +1,136 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// LineLength returns the geodesic length of the line, unit m.
+func (g *Geodesic) LineLength(line matrix.LineMatrix) float64 {
+	length := 0.0
+	for i := 1; i < len(line); i++ {
+		length += g.Distance(line[i-1], line[i])
+	}
+	return length
+}
+
+// RingAreaPerimeter returns the signed geodesic area and the perimeter of the ring,
+// the area is positive if the ring is counter-clockwise.
+// Edges of the ring are geodesics, the ring may enclose a pole or cross the antimeridian.
+func (g *Geodesic) RingAreaPerimeter(ring matrix.LineMatrix) (area, perimeter float64) {
+	n := len(ring)
+	if n > 1 && matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[n-1])) {
+		n--
+	}
+	if n < 3 {
+		if n == 2 {
+			perimeter = 2 * g.Distance(ring[0], ring[1])
+		}
+		return 0, perimeter
+	}
+	sum, sumErr := 0.0, 0.0
+	crossings := 0
+	for i := 0; i < n; i++ {
+		from, to := ring[i], ring[(i+1)%n]
+		r := g.genInverse(from[1], from[0], to[1], to[0], true)
+		perimeter += r.s12
+		var e float64
+		sum, e = sumError(sum, r.s12Area)
+		sumErr += e
+		crossings += transit(from[0], to[0])
+	}
+	return g.reduceArea(sum+sumErr, crossings), perimeter
+}
+
+// reduceArea reduces the accumulated area of edges to (-area0/2, area0/2], positive counter-clockwise.
+func (g *Geodesic) reduceArea(area float64, crossings int) float64 {
+	area0 := g.EllipsoidArea()
+	area = math.Remainder(area, area0)
+	if crossings&1 != 0 {
+		if area < 0 {
+			area += area0 / 2
+		} else {
+			area -= area0 / 2
+		}
+	}
+	area = -area
+	if area > area0/2 {
+		area -= area0
+	} else if area <= -area0/2 {
+		area += area0
+	}
+	return area + 0
+}
+
+// PolygonArea returns the geodesic area and the perimeter of the polygon,
+// the area of holes is subtracted and the perimeter includes holes, unit m² and m.
+func (g *Geodesic) PolygonArea(polygon matrix.PolygonMatrix) (area, perimeter float64) {
+	for i, ring := range polygon {
+		a, p := g.RingAreaPerimeter(ring)
+		perimeter += p
+		if i == 0 {
+			area += math.Abs(a)
+		} else {
+			area -= math.Abs(a)
+		}
+	}
+	return area, perimeter
+}
+
+// transit returns 1 or -1 if crossing the prime meridian in east or west direction, otherwise 0.
+func transit(lon1, lon2 float64) int {
+	lon12, _ := angDiff(lon1, lon2)
+	lon1, lon2 = angNormalize(lon1), angNormalize(lon2)
+	if lon12 > 0 && ((lon1 < 0 && lon2 >= 0) || (lon1 > 0 && lon2 == 0)) {
+		return 1
+	}
+	if lon12 < 0 && lon1 >= 0 && lon2 < 0 {
+		return -1
+	}
+	return 0
+}
+
+// AreaPerimeter returns the geodesic area and the perimeter of the polygonal steric,
+// non-polygonal steric has zero area and perimeter.
+func (g *Geodesic) AreaPerimeter(steric matrix.Steric) (area, perimeter float64) {
+	switch m := steric.(type) {
+	case matrix.PolygonMatrix:
+		return g.PolygonArea(m)
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			a, p := g.PolygonArea(v)
+			area += a
+			perimeter += p
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			a, p := g.AreaPerimeter(v)
+			area += a
+			perimeter += p
+		}
+	}
+	return area, perimeter
+}
+
+// Length returns the geodesic length of the steric, it is the length of lines or the length of polygon rings.
+func (g *Geodesic) Length(steric matrix.Steric) float64 {
+	length := 0.0
+	switch m := steric.(type) {
+	case matrix.LineMatrix:
+		length = g.LineLength(m)
+	case matrix.PolygonMatrix:
+		for _, v := range m {
+			length += g.LineLength(v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			length += g.Length(matrix.PolygonMatrix(v))
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			length += g.Length(v)
+		}
+	}
+	return length
+}
@@ -0,0 +1,103 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestGeodesic_Inverse(t *testing.T) {
+	tests := []struct {
+		name         string
+		from, to     matrix.Matrix
+		s12          float64
+		azi1, azi2   float64
+		area         float64
+		checkAzimuth bool
+	}{
+		{name: "jfk to lhr", from: matrix.Matrix{-73.8, 40.6}, to: matrix.Matrix{-0.5, 51.6},
+			s12: 5551759.400319, azi1: 51.198882845579, azi2: 107.821776735514, checkAzimuth: true},
+		{name: "geodtest 1", from: matrix.Matrix{-139.44815, 35.60777}, to: matrix.Matrix{-69.95921, -11.17491},
+			s12: 8935244.5604818305, azi1: 111.098748429560326, azi2: 129.289270889708762,
+			area: 12841384694976.432, checkAzimuth: true},
+		{name: "geodtest 2", from: matrix.Matrix{106.05087, 55.52454}, to: matrix.Matrix{197.18234, 77.03196},
+			s12: 4105086.1713924406, azi1: 22.020059880982801, azi2: 109.112041110671519,
+			area: 61674961290615.615, checkAzimuth: true},
+		{name: "geodtest 3", from: matrix.Matrix{142.59065, -21.97856}, to: matrix.Matrix{98.56635, 41.84138},
+			s12: 8394328.894657671, azi1: -32.44456876433189, azi2: -41.84359951440466,
+			area: -6637997720646.717, checkAzimuth: true},
+		{name: "antipodal equator", from: matrix.Matrix{0, 0}, to: matrix.Matrix{180, 0}, s12: 20003931.458625},
+		{name: "meridian", from: matrix.Matrix{10, 0}, to: matrix.Matrix{10, 90}, s12: 10001965.729312},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s12, azi1, azi2 := WGS84Geodesic.Inverse(tt.from, tt.to)
+			if math.Abs(s12-tt.s12) > 1e-6 {
+				t.Errorf("Inverse() s12 = %v, want %v", s12, tt.s12)
+			}
+			if !tt.checkAzimuth {
+				return
+			}
+			if math.Abs(azi1-tt.azi1) > 1e-11 || math.Abs(azi2-tt.azi2) > 1e-11 {
+				t.Errorf("Inverse() azimuth = %v %v, want %v %v", azi1, azi2, tt.azi1, tt.azi2)
+			}
+			if tt.area != 0 {
+				r := WGS84Geodesic.genInverse(tt.from[1], tt.from[0], tt.to[1], tt.to[0], true)
+				if math.Abs(r.s12Area-tt.area) > 0.1 {
+					t.Errorf("Inverse() area = %v, want %v", r.s12Area, tt.area)
+				}
+			}
+			to, azi := WGS84Geodesic.Direct(tt.from, tt.azi1, tt.s12)
+			if !to.EqualsExact(matrix.Matrix{angNormalize(tt.to[0]), tt.to[1]}, 1e-11) || math.Abs(azi-tt.azi2) > 1e-11 {
+				t.Errorf("Direct() got = %v %v, want %v %v", to, azi, tt.to, tt.azi2)
+			}
+		})
+	}
+}
+
+func TestGeodesic_RingAreaPerimeter(t *testing.T) {
+	octant := WGS84Geodesic.EllipsoidArea() / 8
+	tests := []struct {
+		name      string
+		ring      matrix.LineMatrix
+		area      float64
+		perimeter float64
+	}{
+		{name: "octant ccw", ring: matrix.LineMatrix{{0, 0}, {90, 0}, {0, 90}, {0, 0}},
+			area: octant, perimeter: 30022685.630020},
+		{name: "octant cw", ring: matrix.LineMatrix{{0, 0}, {0, 90}, {90, 0}, {0, 0}},
+			area: -octant, perimeter: 30022685.630020},
+		{name: "pole", ring: matrix.LineMatrix{{0, 0}, {90, 0}, {180, 0}, {-90, 0}},
+			area: WGS84Geodesic.EllipsoidArea() / 2, perimeter: 40075016.685578},
+		{name: "antimeridian", ring: matrix.LineMatrix{{179, 0}, {-179, 0}, {-179, 1}, {179, 1}, {179, 0}},
+			area: 24619443759.277, perimeter: 666393.054799},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area, perimeter := WGS84Geodesic.RingAreaPerimeter(tt.ring)
+			if math.Abs(area-tt.area) > 0.01 || math.Abs(perimeter-tt.perimeter) > 1e-6 {
+				t.Errorf("RingAreaPerimeter() = %v %v, want %v %v", area, perimeter, tt.area, tt.perimeter)
+			}
+		})
+	}
+}
+
+func TestGeodesic_SegmentDistance(t *testing.T) {
+	tests := []struct {
+		name    string
+		p, a, b matrix.Matrix
+		want    float64
+	}{
+		{name: "nearest inside", p: matrix.Matrix{0, 0}, a: matrix.Matrix{-1, 1}, b: matrix.Matrix{1, 1}, want: 110591.342226},
+		{name: "nearest at a", p: matrix.Matrix{-1, 0}, a: matrix.Matrix{-1, 1}, b: matrix.Matrix{-1, 2}, want: 110574.388558},
+		{name: "zero length", p: matrix.Matrix{-1, 0}, a: matrix.Matrix{-1, 1}, b: matrix.Matrix{-1, 1}, want: 110574.388558},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WGS84Geodesic.SegmentDistance(tt.p, tt.a, tt.b); math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("SegmentDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package operation
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// Densify returns the geometry with points inserted along its segments, so that no segment is longer
+// than maxSegmentLength. A longer segment is divided into equal segments, the inserted points
+// interpolate all the coordinates of the segment ends. Points are returned unchanged, as the geometry
+// if maxSegmentLength is not positive.
+func Densify(geom matrix.Steric, maxSegmentLength float64) matrix.Steric {
+	return densifySteric(geom, func(line matrix.LineMatrix) matrix.LineMatrix {
+		return densifyLine(line, maxSegmentLength)
+	})
+}
+
+// DensifyGreatCircle returns the geometry of lng lat with points inserted along the great circles of its segments,
+// so that no segment is longer than maxSegmentLength, unit m.
+// Points are returned unchanged, as the geometry if maxSegmentLength is not positive.
+func DensifyGreatCircle(geom matrix.Steric, maxSegmentLength float64) matrix.Steric {
+	return densifySteric(geom, func(line matrix.LineMatrix) matrix.LineMatrix {
+		return measure.DensifyGreatCircle(line, maxSegmentLength)
+	})
+}
+
+// densifySteric returns the geometry with its lines and rings densified by densify.
+func densifySteric(geom matrix.Steric, densify func(matrix.LineMatrix) matrix.LineMatrix) matrix.Steric {
+	switch m := geom.(type) {
+	case matrix.LineMatrix:
+		return densify(m)
+	case matrix.PolygonMatrix:
+		poly := matrix.PolygonMatrix{}
+		for _, ring := range m {
+			poly = append(poly, densify(ring))
+		}
+		return poly
+	case matrix.MultiPolygonMatrix:
+		multi := matrix.MultiPolygonMatrix{}
+		for _, v := range m {
+			multi = append(multi, densifySteric(matrix.PolygonMatrix(v), densify).(matrix.PolygonMatrix))
+		}
+		return multi
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range m {
+			coll = append(coll, densifySteric(v, densify))
+		}
+		return coll
+	}
+	return geom
+}
+
+// densifyLine returns the line with its segments longer than maxSegmentLength divided into equal segments.
+func densifyLine(line matrix.LineMatrix, maxSegmentLength float64) matrix.LineMatrix {
+	if len(line) < 2 || maxSegmentLength <= 0 {
+		return line
+	}
+	densified := matrix.LineMatrix{line[0]}
+	for i := 1; i < len(line); i++ {
+		n := int(math.Ceil(measure.PlanarDistance(line[i-1], line[i]) / maxSegmentLength))
+		for k := 1; k < n; k++ {
+			f := float64(k) / float64(n)
+			densified = append(densified, combinePoints([]float64{1 - f, f}, line[i-1], line[i]))
+		}
+		densified = append(densified, line[i])
+	}
+	return densified
+}
@@ -0,0 +1,55 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestDensify(t *testing.T) {
+	tests := []struct {
+		name             string
+		geom             matrix.Steric
+		maxSegmentLength float64
+		want             matrix.Steric
+	}{
+		{"point", matrix.Matrix{1, 1}, 1, matrix.Matrix{1, 1}},
+		{"line", matrix.LineMatrix{{0, 0}, {3, 0}, {3, 1}}, 1,
+			matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}}},
+		{"equal segments", matrix.LineMatrix{{0, 0}, {5, 0}}, 2,
+			matrix.LineMatrix{{0, 0}, {5.0 / 3, 0}, {10.0 / 3, 0}, {5, 0}}},
+		{"z interpolated", matrix.LineMatrix{{0, 0, 10}, {2, 0, 20}}, 1,
+			matrix.LineMatrix{{0, 0, 10}, {1, 0, 15}, {2, 0, 20}}},
+		{"polygon", matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 0}}}, 1,
+			matrix.PolygonMatrix{{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {4.0 / 3, 4.0 / 3}, {2.0 / 3, 2.0 / 3}, {0, 0}}}},
+		{"not positive", matrix.LineMatrix{{0, 0}, {3, 0}}, 0, matrix.LineMatrix{{0, 0}, {3, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Densify(tt.geom, tt.maxSegmentLength); !got.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("Densify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDensifyGreatCircle(t *testing.T) {
+	polygon := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	got := DensifyGreatCircle(polygon, 200000).(matrix.PolygonMatrix)
+	ring := got[0]
+	if len(ring) <= len(polygon[0]) || !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+		t.Fatalf("DensifyGreatCircle() = %v, want a closed densified ring", got)
+	}
+	for i := 1; i < len(ring); i++ {
+		if d := measure.SpheroidDistance(ring[i-1], ring[i]); d > 200000+1e-3 {
+			t.Errorf("DensifyGreatCircle() segment %v length %v", i, d)
+		}
+	}
+	// the great circle between two points of the parallel 10 goes north of it
+	for _, v := range ring {
+		if v[0] > 1e-9 && v[0] < 10-1e-9 && v[1] > 5 && v[1] <= 10 {
+			t.Errorf("DensifyGreatCircle() point %v not north of the parallel", v)
+		}
+	}
+}
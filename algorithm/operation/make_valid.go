@@ -0,0 +1,206 @@
+package operation
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/algorithm/triangulate"
+)
+
+// MakeValid returns a valid geometry of the same points as the geometry, without losing its vertices.
+// Repeated points are removed, a line of one point collapses to a point.
+// The rings of a polygon are noded at their intersections, the area of the polygon is the part of the plane
+// inside an odd number of its rings, so self-intersecting rings and bow-ties are split into polygons,
+// overlapping holes cancel and a hole outside the shell is a polygon. The areas of a multi polygon are merged.
+// The collapsed parts of rings, as spikes and cut edges, are kept as their noded lines or points,
+// so a polygon of no area collapses to its lines or points.
+// The result is a Polygon or a Collection of polygons, lines and points for polygons,
+// a Collection of the valid elements for collections.
+func MakeValid(geom matrix.Steric) matrix.Steric {
+	switch st := geom.(type) {
+	case matrix.Matrix:
+		return st
+	case matrix.LineMatrix:
+		return makeValidLine(st)
+	case matrix.PolygonMatrix:
+		return makeValidPolygons([]matrix.PolygonMatrix{st})
+	case matrix.MultiPolygonMatrix:
+		polygons := []matrix.PolygonMatrix{}
+		for _, v := range st {
+			polygons = append(polygons, v)
+		}
+		return makeValidPolygons(polygons)
+	case matrix.Collection:
+		polygons := []matrix.PolygonMatrix{}
+		for _, v := range st {
+			if polygon, ok := v.(matrix.PolygonMatrix); ok {
+				polygons = append(polygons, polygon)
+			}
+		}
+		if len(st) > 0 && len(polygons) == len(st) {
+			return makeValidPolygons(polygons)
+		}
+		coll := matrix.Collection{}
+		for _, v := range st {
+			coll = append(coll, MakeValid(v))
+		}
+		return coll
+	}
+	return geom
+}
+
+// makeValidLine returns the line without repeated points, or its point if it has one.
+func makeValidLine(line matrix.LineMatrix) matrix.Steric {
+	points := removeRepeatedPoints(line)
+	if len(points) == 1 {
+		return matrix.Matrix(points[0])
+	}
+	return points
+}
+
+// makeValidPolygons returns the union of the areas inside an odd number of rings of each polygon,
+// with the collapsed lines and points of rings outside the areas, as keepCollapsed of JTS.
+func makeValidPolygons(polygons []matrix.PolygonMatrix) matrix.Steric {
+	rings := [][]matrix.LineMatrix{}
+	linework := matrix.Collection{}
+	for _, polygon := range polygons {
+		closed := []matrix.LineMatrix{}
+		for _, v := range polygon {
+			ring := removeRepeatedPoints(v)
+			if len(ring) > 1 && !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+				ring = append(ring, ring[0])
+			}
+			if len(ring) > 0 {
+				closed = append(closed, ring)
+				linework = append(linework, ring)
+			}
+		}
+		rings = append(rings, closed)
+	}
+	// isInside returns true if the point is inside an odd number of rings of a polygon.
+	isInside := func(p matrix.Matrix) bool {
+		for _, polygon := range rings {
+			count := 0
+			for _, ring := range polygon {
+				if len(ring) > 3 && relate.InPolygon(p, ring) {
+					count++
+				}
+			}
+			if count%2 == 1 {
+				return true
+			}
+		}
+		return false
+	}
+
+	// the boundary of the faces inside is the linework of the result.
+	faces := polygonize.PolygonizeFaces(linework)
+	inside := insideFaces(faces.Polygons(), isInside)
+	segments := map[[4]float64]int{}
+	for _, face := range inside {
+		for _, ring := range face {
+			for i := 1; i < len(ring); i++ {
+				segments[segmentKey(ring[i-1], ring[i])]++
+			}
+		}
+	}
+	boundary := matrix.Collection{}
+	for _, face := range inside {
+		for _, ring := range face {
+			for i := 1; i < len(ring); i++ {
+				if segments[segmentKey(ring[i-1], ring[i])] == 1 {
+					boundary = append(boundary, matrix.LineMatrix{ring[i-1], ring[i]})
+				}
+			}
+		}
+	}
+	areas := insideFaces(polygonize.PolygonizeFaces(boundary).Polygons(), isInside)
+	result := matrix.Collection{}
+	for _, polygon := range areas {
+		result = append(result, polygon)
+	}
+	// isCovered returns true if the point is inside the areas.
+	isCovered := func(p matrix.Matrix) bool {
+		for _, polygon := range areas {
+			covered := relate.InPolygon(p, polygon[0])
+			for _, hole := range polygon[1:] {
+				covered = covered && !relate.InPolygon(p, hole)
+			}
+			if covered {
+				return true
+			}
+		}
+		return false
+	}
+
+	// the collapsed parts of rings are their noded lines or points outside the area.
+	for _, v := range append(append(matrix.Collection{}, faces.Dangles()...), faces.CutEdges()...) {
+		line := v.(matrix.LineMatrix)
+		if !isCovered(matrix.Matrix{(line[0][0] + line[1][0]) / 2, (line[0][1] + line[1][1]) / 2}) {
+			result = append(result, line)
+		}
+	}
+	// the rings of the faces not bounding the areas, as a hole identical to its shell, collapse to lines.
+	collapsed := map[[4]float64]bool{}
+	for _, v := range faces.Polygons() {
+		for _, ring := range v.(matrix.PolygonMatrix) {
+			isCollapsed := !isCovered(matrix.Matrix{(ring[0][0] + ring[1][0]) / 2, (ring[0][1] + ring[1][1]) / 2})
+			for i := 1; i < len(ring) && isCollapsed; i++ {
+				key := segmentKey(ring[i-1], ring[i])
+				isCollapsed = segments[key] == 0 && !collapsed[key]
+			}
+			if !isCollapsed {
+				continue
+			}
+			for i := 1; i < len(ring); i++ {
+				collapsed[segmentKey(ring[i-1], ring[i])] = true
+			}
+			result = append(result, matrix.LineMatrix(ring))
+		}
+	}
+	for _, v := range linework {
+		if ring := v.(matrix.LineMatrix); len(ring) == 1 && !isCovered(ring[0]) {
+			result = append(result, matrix.Matrix(ring[0]))
+		}
+	}
+	if len(result) == 1 {
+		return result[0]
+	}
+	return result
+}
+
+// insideFaces returns the faces whose interior point is inside.
+func insideFaces(faces matrix.Collection, isInside func(p matrix.Matrix) bool) []matrix.PolygonMatrix {
+	inside := []matrix.PolygonMatrix{}
+	for _, v := range faces {
+		face := v.(matrix.PolygonMatrix)
+		triangles := triangulate.PolygonTriangles(face)
+		if len(triangles) == 0 {
+			continue
+		}
+		t := triangles[0].(matrix.PolygonMatrix)[0]
+		if isInside(matrix.Matrix{(t[0][0] + t[1][0] + t[2][0]) / 3, (t[0][1] + t[1][1] + t[2][1]) / 3}) {
+			inside = append(inside, face)
+		}
+	}
+	return inside
+}
+
+// segmentKey returns the key of the segment, the same in both directions.
+func segmentKey(a, b matrix.Matrix) [4]float64 {
+	if a[0] > b[0] || (a[0] == b[0] && a[1] > b[1]) {
+		a, b = b, a
+	}
+	return [4]float64{a[0], a[1], b[0], b[1]}
+}
+
+// removeRepeatedPoints returns a copy of the line without consecutive repeated points.
+func removeRepeatedPoints(line matrix.LineMatrix) matrix.LineMatrix {
+	points := matrix.LineMatrix{}
+	for _, v := range line {
+		if len(points) == 0 || !matrix.Matrix(points[len(points)-1]).Equals(matrix.Matrix(v)) {
+			points = append(points, v)
+		}
+	}
+	return points
+}
@@ -0,0 +1,134 @@
+package operation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestMakeValid(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name     string
+		geom     matrix.Steric
+		polygons int
+		holes    int
+		area     float64
+	}{
+		{"valid", matrix.PolygonMatrix{square}, 1, 0, 100},
+		{"bow-tie", matrix.PolygonMatrix{{{0, 0}, {10, 10}, {10, 0}, {0, 10}, {0, 0}}}, 2, 0, 50},
+		{"self-intersecting ring", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {5, 10}, {5, -5}, {0, -5}, {0, 0}}},
+			2, 0, 75},
+		{"repeated points", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 10}, {0, 0}}}, 1, 0, 100},
+		{"unclosed ring", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}}}, 1, 0, 100},
+		{"hole", matrix.PolygonMatrix{square, {{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}}, 1, 1, 64},
+		{"overlapping holes", matrix.PolygonMatrix{square,
+			{{1, 1}, {6, 1}, {6, 6}, {1, 6}, {1, 1}}, {{4, 4}, {9, 4}, {9, 9}, {4, 9}, {4, 4}}}, 2, 1, 58},
+		{"hole outside shell", matrix.PolygonMatrix{square, {{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}}, 2, 0, 200},
+		{"hole crossing shell", matrix.PolygonMatrix{square, {{5, 2}, {15, 2}, {15, 8}, {5, 8}, {5, 2}}}, 2, 0, 100},
+		{"touching hole", matrix.PolygonMatrix{square, {{0, 0}, {5, 2}, {2, 5}, {0, 0}}}, 1, 1, 89.5},
+		{"overlapping polygons", matrix.MultiPolygonMatrix{{square}, {{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}},
+			1, 0, 175},
+		{"polygon collection", matrix.Collection{matrix.PolygonMatrix{square},
+			matrix.PolygonMatrix{{{20, 0}, {30, 0}, {30, 10}, {20, 0}}}}, 2, 0, 150},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeValid(tt.geom)
+			polygons := []matrix.PolygonMatrix{}
+			switch g := got.(type) {
+			case matrix.PolygonMatrix:
+				polygons = append(polygons, g)
+			case matrix.Collection:
+				for _, v := range g {
+					polygons = append(polygons, v.(matrix.PolygonMatrix))
+				}
+			}
+			area, holes := 0.0, 0
+			for _, v := range polygons {
+				area += measure.AreaOfPolygon(v)
+				holes += len(v) - 1
+			}
+			if len(polygons) != tt.polygons || holes != tt.holes || math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("MakeValid() = %v, want %v polygons with %v holes of area %v", got, tt.polygons, tt.holes, tt.area)
+			}
+		})
+	}
+}
+
+func TestMakeValid_Collapsed(t *testing.T) {
+	tests := []struct {
+		name string
+		geom matrix.Steric
+		want matrix.Steric
+	}{
+		{"point", matrix.Matrix{1, 1}, matrix.Matrix{1, 1}},
+		{"line of one point", matrix.LineMatrix{{1, 1}, {1, 1}}, matrix.Matrix{1, 1}},
+		{"line", matrix.LineMatrix{{0, 0}, {1, 1}, {1, 1}, {2, 0}}, matrix.LineMatrix{{0, 0}, {1, 1}, {2, 0}}},
+		{"collapsed ring", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {0, 0}}}, matrix.LineMatrix{{10, 0}, {0, 0}}},
+		{"collapsed point", matrix.PolygonMatrix{{{1, 1}, {1, 1}, {1, 1}, {1, 1}}}, matrix.Matrix{1, 1}},
+		{"hole identical to shell", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			matrix.LineMatrix{{10, 0}, {10, 10}, {0, 10}, {0, 0}, {10, 0}}},
+		{"hole identical to shell and hole", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}},
+			matrix.Collection{matrix.PolygonMatrix{{{8, 8}, {2, 8}, {2, 2}, {8, 2}, {8, 8}}}, matrix.LineMatrix{{10, 0}, {10, 10}, {0, 10}, {0, 0}, {10, 0}}}},
+		{"collection", matrix.Collection{matrix.LineMatrix{{1, 1}, {1, 1}}, matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}}}},
+			matrix.Collection{matrix.Matrix{1, 1}, matrix.PolygonMatrix{{{10, 10}, {0, 0}, {10, 0}, {10, 10}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MakeValid(tt.geom); !got.Equals(tt.want) {
+				t.Errorf("MakeValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMakeValid_KeepCollapsed(t *testing.T) {
+	tests := []struct {
+		name string
+		geom matrix.PolygonMatrix
+		area float64
+	}{
+		{"spike", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {5, 10}, {5, 15}, {5, 10}, {0, 10}, {0, 0}}}, 100},
+		{"cut edge", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 5}, {20, 5}, {20, 0}, {30, 0}, {30, 10},
+			{20, 10}, {20, 5}, {10, 5}, {10, 10}, {0, 10}, {0, 0}}}, 200},
+		{"spike and point", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{20, 20}, {20, 20}, {20, 20}, {20, 20}}, {{10, 5}, {15, 5}, {10, 5}}}, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeValid(tt.geom)
+			vertices, area := map[[2]float64]bool{}, 0.0
+			for _, v := range got.(matrix.Collection) {
+				switch g := v.(type) {
+				case matrix.Matrix:
+					vertices[[2]float64{g[0], g[1]}] = true
+				case matrix.LineMatrix:
+					for _, p := range g {
+						vertices[[2]float64{p[0], p[1]}] = true
+					}
+				case matrix.PolygonMatrix:
+					area += measure.AreaOfPolygon(g)
+					for _, ring := range g {
+						for _, p := range ring {
+							vertices[[2]float64{p[0], p[1]}] = true
+						}
+					}
+				}
+			}
+			if math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("MakeValid() = %v, want area %v", got, tt.area)
+			}
+			for _, ring := range tt.geom {
+				for _, p := range ring {
+					if !vertices[[2]float64{p[0], p[1]}] {
+						t.Errorf("MakeValid() = %v, lost the vertex %v", got, p)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package operation
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// BezierSegments the number of segments of the cubic Bezier curve between two points of the line.
+const BezierSegments = 16
+
+// ChaikinSmooth returns the geometry smoothed by cutting the corners of its lines and rings iterations times,
+// each segment is replaced by the points at its quarter and three quarters.
+// The ends of the lines are kept, the rings stay closed. If the smoothed polygons are not valid,
+// they are smoothed with less iterations, down to the polygons unchanged.
+// Points are returned unchanged.
+func ChaikinSmooth(geom matrix.Steric, iterations int) matrix.Steric {
+	switch m := geom.(type) {
+	case matrix.LineMatrix:
+		return chaikinLine(m, iterations)
+	case matrix.PolygonMatrix, matrix.MultiPolygonMatrix:
+		for k := iterations; k > 0; k-- {
+			smoothed := smoothRings(m, func(ring matrix.LineMatrix) matrix.LineMatrix {
+				return chaikinLine(ring, k)
+			})
+			if (&ValidOP{smoothed}).IsValid() {
+				return smoothed
+			}
+		}
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range m {
+			coll = append(coll, ChaikinSmooth(v, iterations))
+		}
+		return coll
+	}
+	return geom
+}
+
+// BezierSmooth returns the geometry smoothed by cubic Bezier curves through the points of its lines and rings.
+// The control points follow the direction from the previous to the next point, alpha sets their distance,
+// 0 gives straight segments and 1 a Catmull-Rom like curve, larger values give rounder curves.
+// The ends of the lines are kept, the rings stay closed. If the smoothed polygons are not valid,
+// they are smoothed with half alpha, down to the polygons unchanged.
+// Points are returned unchanged.
+func BezierSmooth(geom matrix.Steric, alpha float64) matrix.Steric {
+	switch m := geom.(type) {
+	case matrix.LineMatrix:
+		return bezierLine(m, alpha)
+	case matrix.PolygonMatrix, matrix.MultiPolygonMatrix:
+		for k, a := 0, alpha; k < 4 && a > 0; k, a = k+1, a/2 {
+			smoothed := smoothRings(m, func(ring matrix.LineMatrix) matrix.LineMatrix {
+				return bezierLine(ring, a)
+			})
+			if (&ValidOP{smoothed}).IsValid() {
+				return smoothed
+			}
+		}
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range m {
+			coll = append(coll, BezierSmooth(v, alpha))
+		}
+		return coll
+	}
+	return geom
+}
+
+// smoothRings returns the polygon or multi polygon with its rings smoothed by smooth.
+func smoothRings(geom matrix.Steric, smooth func(matrix.LineMatrix) matrix.LineMatrix) matrix.Steric {
+	switch m := geom.(type) {
+	case matrix.PolygonMatrix:
+		poly := matrix.PolygonMatrix{}
+		for _, ring := range m {
+			poly = append(poly, smooth(ring))
+		}
+		return poly
+	case matrix.MultiPolygonMatrix:
+		multi := matrix.MultiPolygonMatrix{}
+		for _, v := range m {
+			multi = append(multi, smoothRings(matrix.PolygonMatrix(v), smooth).(matrix.PolygonMatrix))
+		}
+		return multi
+	}
+	return geom
+}
+
+// chaikinLine returns the line with its corners cut iterations times.
+func chaikinLine(line matrix.LineMatrix, iterations int) matrix.LineMatrix {
+	pts := removeRepeatedPoints(line)
+	if len(pts) < 3 {
+		return line
+	}
+	closed := matrix.Matrix(pts[0]).Equals(matrix.Matrix(pts[len(pts)-1]))
+	for k := 0; k < iterations; k++ {
+		smoothed := matrix.LineMatrix{}
+		if !closed {
+			smoothed = append(smoothed, pts[0])
+		}
+		for i := 1; i < len(pts); i++ {
+			smoothed = append(smoothed,
+				combinePoints([]float64{0.75, 0.25}, pts[i-1], pts[i]),
+				combinePoints([]float64{0.25, 0.75}, pts[i-1], pts[i]))
+		}
+		if closed {
+			smoothed = append(smoothed, smoothed[0])
+		} else {
+			smoothed = append(smoothed, pts[len(pts)-1])
+		}
+		pts = smoothed
+	}
+	return pts
+}
+
+// bezierLine returns the cubic Bezier curves through the points of the line, BezierSegments for each segment.
+func bezierLine(line matrix.LineMatrix, alpha float64) matrix.LineMatrix {
+	pts := removeRepeatedPoints(line)
+	if len(pts) < 3 || alpha <= 0 {
+		return line
+	}
+	closed := matrix.Matrix(pts[0]).Equals(matrix.Matrix(pts[len(pts)-1]))
+	n := len(pts)
+	// neighbour returns the point before or after the point at index i, the point itself at the ends of a line
+	neighbour := func(i, step int) []float64 {
+		j := i + step
+		if closed {
+			return pts[((j-1)%(n-1)+(n-1))%(n-1)+1]
+		}
+		if j < 0 || j >= n {
+			return pts[i]
+		}
+		return pts[j]
+	}
+	// control returns the control point of the segment at the point at index i, toward the point i+step
+	control := func(i, step int) []float64 {
+		prev, next := neighbour(i, -step), neighbour(i, step)
+		before := measure.PlanarDistance(pts[i], prev)
+		length := measure.PlanarDistance(pts[i], next)
+		f := alpha * length / (3 * (before + length))
+		return combinePoints([]float64{1, f, -f}, pts[i], next, prev)
+	}
+	curve := matrix.LineMatrix{pts[0]}
+	for i := 1; i < n; i++ {
+		c1, c2 := control(i-1, 1), control(i, -1)
+		for k := 1; k < BezierSegments; k++ {
+			t := float64(k) / BezierSegments
+			s := 1 - t
+			curve = append(curve, combinePoints([]float64{s * s * s, 3 * s * s * t, 3 * s * t * t, t * t * t},
+				pts[i-1], c1, c2, pts[i]))
+		}
+		curve = append(curve, pts[i])
+	}
+	return curve
+}
+
+// combinePoints returns the sum of the points multiplied by the weights, on all the dimensions of the first point.
+func combinePoints(weights []float64, points ...[]float64) matrix.Matrix {
+	p := make(matrix.Matrix, len(points[0]))
+	for d := range p {
+		for i, v := range points {
+			if d < len(v) {
+				p[d] += weights[i] * v[d]
+			}
+		}
+	}
+	return p
+}
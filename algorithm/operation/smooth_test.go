@@ -0,0 +1,74 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestChaikinSmooth(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name       string
+		geom       matrix.Steric
+		iterations int
+		want       matrix.Steric
+	}{
+		{"point", matrix.Matrix{1, 1}, 1, matrix.Matrix{1, 1}},
+		{"line ends kept", matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}}, 1,
+			matrix.LineMatrix{{0, 0}, {1, 0}, {3, 0}, {4, 1}, {4, 3}, {4, 4}}},
+		{"no iteration", matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}}, 0, matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}}},
+		{"ring closed", matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}, 1,
+			matrix.PolygonMatrix{{{1, 0}, {3, 0}, {4, 1}, {4, 3}, {3, 4}, {1, 4}, {0, 3}, {0, 1}, {1, 0}}}},
+		{"hole outside smoothed shell", matrix.PolygonMatrix{square, {{0.5, 0.5}, {3, 0.5}, {0.5, 3}, {0.5, 0.5}}}, 2,
+			matrix.PolygonMatrix{square, {{0.5, 0.5}, {3, 0.5}, {0.5, 3}, {0.5, 0.5}}}},
+		{"collection", matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}}}, 1,
+			matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {1, 0}, {3, 0}, {4, 1}, {4, 3}, {4, 4}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChaikinSmooth(tt.geom, tt.iterations); !got.Equals(tt.want) {
+				t.Errorf("ChaikinSmooth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBezierSmooth(t *testing.T) {
+	tests := []struct {
+		name   string
+		geom   matrix.Steric
+		alpha  float64
+		points []matrix.Matrix
+		size   int
+	}{
+		{"line", matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}}, 1,
+			[]matrix.Matrix{{0, 0}, {2.25, -0.25}, {4, 0}, {4.25, 1.75}, {4, 4}}, 2*BezierSegments + 1},
+		{"ring", matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}, 1,
+			[]matrix.Matrix{{0, 0}, {2, -0.5}, {4, 0}, {4.5, 2}, {4, 4}, {2, 4.5}, {0, 4}, {-0.5, 2}, {0, 0}},
+			4*BezierSegments + 1},
+		{"straight", matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}}, 0,
+			[]matrix.Matrix{{0, 0}, {4, 0}, {4, 4}}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BezierSmooth(tt.geom, tt.alpha)
+			line, ok := got.(matrix.LineMatrix)
+			if poly, isPoly := got.(matrix.PolygonMatrix); isPoly {
+				line, ok = poly[0], true
+			}
+			if !ok || len(line) != tt.size {
+				t.Fatalf("BezierSmooth() = %v, want %v points", got, tt.size)
+			}
+			step := (tt.size - 1) / (len(tt.points) - 1)
+			for i, v := range tt.points {
+				if !matrix.Matrix(line[i*step]).Equals(v) {
+					t.Errorf("BezierSmooth() point %v = %v, want %v", i*step, line[i*step], v)
+				}
+			}
+			if !(&ValidOP{got}).IsValid() {
+				t.Errorf("BezierSmooth() = %v, want valid", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package operation
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// SplitLine returns the parts of the line cut at the points on it, in order along the line.
+// The points not on the line and the points at its ends are ignored.
+func SplitLine(line matrix.LineMatrix, points []matrix.Matrix) matrix.Collection {
+	parts := matrix.Collection{}
+	if len(line) == 0 {
+		return parts
+	}
+	part := matrix.LineMatrix{line[0]}
+	for i := 1; i < len(line); i++ {
+		a, b := matrix.Matrix(line[i-1]), matrix.Matrix(line[i])
+		fractions := []float64{}
+		for _, p := range points {
+			if measure.PlanarDistance(p, measure.ClosestPoint(p, a, b)) > calc.AccuracyFloat {
+				continue
+			}
+			if f := measure.ProjectionFactor(p, a, b); f > 0 {
+				fractions = append(fractions, math.Min(f, 1))
+			}
+		}
+		sort.Float64s(fractions)
+		for _, f := range fractions {
+			cut := b
+			if f < 1 {
+				cut = matrix.Matrix{a[0] + f*(b[0]-a[0]), a[1] + f*(b[1]-a[1])}
+			}
+			part = appendPoint(part, cut)
+			if len(part) > 1 {
+				parts = append(parts, part)
+			}
+			part = matrix.LineMatrix{cut}
+		}
+		part = appendPoint(part, b)
+	}
+	if len(part) > 1 {
+		parts = append(parts, part)
+	}
+	return parts
+}
+
+// SplitLineByLines returns the parts of the line cut where the blades cross or touch it, in order along the line.
+// Where a blade overlaps the line, the line is cut at the ends of the overlap.
+func SplitLineByLines(line matrix.LineMatrix, blades []matrix.LineMatrix) matrix.Collection {
+	points := []matrix.Matrix{}
+	for i := 1; i < len(line); i++ {
+		a, b := matrix.Matrix(line[i-1]), matrix.Matrix(line[i])
+		for _, blade := range blades {
+			for j := 1; j < len(blade); j++ {
+				p, q := matrix.Matrix(blade[j-1]), matrix.Matrix(blade[j])
+				kind, ip := intersectSegments(a, b, p, q)
+				switch kind {
+				case noIntersection:
+				case overlapIntersection:
+					for _, v := range []matrix.Matrix{p, q} {
+						if isOnSegment(v, a, b) {
+							points = append(points, v)
+						}
+					}
+					for _, v := range []matrix.Matrix{a, b} {
+						if isOnSegment(v, p, q) {
+							points = append(points, v)
+						}
+					}
+				default:
+					points = append(points, ip)
+				}
+			}
+		}
+	}
+	return SplitLine(line, points)
+}
+
+// SplitPolygon returns the polygons of the polygon cut by the blades, ordered by the lower left corner of their bound.
+// The parts of the blades outside the polygon are ignored, the polygon is returned alone if the blades do not cut it.
+func SplitPolygon(polygon matrix.PolygonMatrix, blades []matrix.LineMatrix) matrix.Collection {
+	linework := matrix.Collection{}
+	for _, ring := range polygon {
+		linework = append(linework, matrix.LineMatrix(ring))
+	}
+	for _, blade := range blades {
+		linework = append(linework, blade)
+	}
+	isInside := func(p matrix.Matrix) bool {
+		if len(polygon) == 0 || !relate.InPolygon(p, polygon[0]) {
+			return false
+		}
+		for _, hole := range polygon[1:] {
+			if relate.InPolygon(p, hole) {
+				return false
+			}
+		}
+		return true
+	}
+	faces := insideFaces(polygonize.PolygonizeFaces(linework).Polygons(), isInside)
+	if len(faces) < 2 {
+		return matrix.Collection{polygon}
+	}
+	sort.SliceStable(faces, func(i, j int) bool {
+		bi, bj := faces[i].Bound(), faces[j].Bound()
+		if bi[0][0] != bj[0][0] {
+			return bi[0][0] < bj[0][0]
+		}
+		return bi[0][1] < bj[0][1]
+	})
+	result := matrix.Collection{}
+	for _, face := range faces {
+		result = append(result, face)
+	}
+	return result
+}
+
+// appendPoint appends the point to the line if it is not the last point of the line.
+func appendPoint(line matrix.LineMatrix, p matrix.Matrix) matrix.LineMatrix {
+	if len(line) > 0 && matrix.Matrix(line[len(line)-1]).Equals(p) {
+		return line
+	}
+	return append(line, p)
+}
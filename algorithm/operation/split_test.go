@@ -0,0 +1,91 @@
+package operation
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestSplitLine(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	tests := []struct {
+		name   string
+		points []matrix.Matrix
+		want   matrix.Collection
+	}{
+		{"inside segment", []matrix.Matrix{{5, 0}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {5, 0}}, matrix.LineMatrix{{5, 0}, {10, 0}, {10, 10}}}},
+		{"vertex", []matrix.Matrix{{10, 0}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.LineMatrix{{10, 0}, {10, 10}}}},
+		{"unordered points", []matrix.Matrix{{10, 5}, {2, 0}, {10, 5}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {2, 0}}, matrix.LineMatrix{{2, 0}, {10, 0}, {10, 5}}, matrix.LineMatrix{{10, 5}, {10, 10}}}},
+		{"ends", []matrix.Matrix{{0, 0}, {10, 10}}, matrix.Collection{line}},
+		{"off line", []matrix.Matrix{{5, 1}}, matrix.Collection{line}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitLine(line, tt.points); !got.Equals(tt.want) {
+				t.Errorf("SplitLine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitLineByLines(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}}
+	tests := []struct {
+		name   string
+		blades []matrix.LineMatrix
+		want   matrix.Collection
+	}{
+		{"crossing", []matrix.LineMatrix{{{5, -5}, {5, 5}}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {5, 0}}, matrix.LineMatrix{{5, 0}, {10, 0}}}},
+		{"touching", []matrix.LineMatrix{{{3, 5}, {3, 0}}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {3, 0}}, matrix.LineMatrix{{3, 0}, {10, 0}}}},
+		{"crossing twice", []matrix.LineMatrix{{{2, -1}, {4, 1}, {6, -1}}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {3, 0}}, matrix.LineMatrix{{3, 0}, {5, 0}}, matrix.LineMatrix{{5, 0}, {10, 0}}}},
+		{"overlapping", []matrix.LineMatrix{{{4, 0}, {6, 0}}},
+			matrix.Collection{matrix.LineMatrix{{0, 0}, {4, 0}}, matrix.LineMatrix{{4, 0}, {6, 0}}, matrix.LineMatrix{{6, 0}, {10, 0}}}},
+		{"disjoint", []matrix.LineMatrix{{{0, 1}, {10, 1}}}, matrix.Collection{line}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitLineByLines(line, tt.blades); !got.Equals(tt.want) {
+				t.Errorf("SplitLineByLines() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitPolygon(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name    string
+		polygon matrix.PolygonMatrix
+		blades  []matrix.LineMatrix
+		areas   []float64
+	}{
+		{"half", matrix.PolygonMatrix{square}, []matrix.LineMatrix{{{4, -5}, {4, 15}}}, []float64{40, 60}},
+		{"quarters", matrix.PolygonMatrix{square}, []matrix.LineMatrix{{{5, -5}, {5, 15}}, {{-5, 5}, {15, 5}}},
+			[]float64{25, 25, 25, 25}},
+		{"polyline", matrix.PolygonMatrix{square}, []matrix.LineMatrix{{{-1, 5}, {5, 5}, {5, 11}}}, []float64{75, 25}},
+		{"hole", matrix.PolygonMatrix{square, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			[]matrix.LineMatrix{{{5, -5}, {5, 15}}}, []float64{48, 48}},
+		{"not cut", matrix.PolygonMatrix{square}, []matrix.LineMatrix{{{5, -5}, {5, 5}}}, []float64{100}},
+		{"outside", matrix.PolygonMatrix{square}, []matrix.LineMatrix{{{20, -5}, {20, 15}}}, []float64{100}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitPolygon(tt.polygon, tt.blades)
+			if len(got) != len(tt.areas) {
+				t.Fatalf("SplitPolygon() = %v, want %v polygons", got, len(tt.areas))
+			}
+			for i, v := range got {
+				if area := measure.AreaOfPolygon(v.(matrix.PolygonMatrix)); area != tt.areas[i] {
+					t.Errorf("SplitPolygon() polygon %v area = %v, want %v", i, area, tt.areas[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,414 @@
+package operation
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// ValidErrorType the type of a validation error.
+type ValidErrorType int
+
+// The types of validation errors.
+const (
+	// ErrorInvalidCoordinate a coordinate is NaN or infinite.
+	ErrorInvalidCoordinate ValidErrorType = iota + 1
+	// ErrorRingNotClosed the first and last points of a ring are different.
+	ErrorRingNotClosed
+	// ErrorTooFewPoints a line has less than 2 distinct points or a ring less than 3.
+	ErrorTooFewPoints
+	// ErrorSelfIntersection two segments of a polygon or multi polygon cross or overlap.
+	ErrorSelfIntersection
+	// ErrorRingSelfIntersection a ring touches itself.
+	ErrorRingSelfIntersection
+	// ErrorHoleOutsideShell a hole is not inside the shell of its polygon.
+	ErrorHoleOutsideShell
+	// ErrorNestedHoles a hole is inside another hole of its polygon.
+	ErrorNestedHoles
+	// ErrorDisconnectedInterior the rings of a polygon touch so that its interior is split.
+	ErrorDisconnectedInterior
+	// ErrorNestedShells a polygon of a multi polygon is inside another.
+	ErrorNestedShells
+)
+
+// validErrorMessages the messages of the validation error types.
+var validErrorMessages = map[ValidErrorType]string{
+	ErrorInvalidCoordinate:    "Invalid Coordinate",
+	ErrorRingNotClosed:        "Ring is not closed",
+	ErrorTooFewPoints:         "Too few distinct points in geometry component",
+	ErrorSelfIntersection:     "Self-intersection",
+	ErrorRingSelfIntersection: "Ring Self-intersection",
+	ErrorHoleOutsideShell:     "Hole lies outside shell",
+	ErrorNestedHoles:          "Holes are nested",
+	ErrorDisconnectedInterior: "Interior is disconnected",
+	ErrorNestedShells:         "Nested shells",
+}
+
+// String returns the message of the error type.
+func (t ValidErrorType) String() string {
+	if message, ok := validErrorMessages[t]; ok {
+		return message
+	}
+	return "Topology Validation Error"
+}
+
+// ValidationError describes why a geometry is not valid and the coordinate where the error is found.
+type ValidationError struct {
+	Type       ValidErrorType
+	Coordinate matrix.Matrix
+}
+
+// Error returns the message of the error with its coordinate.
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v at or near point %v", e.Type, e.Coordinate)
+}
+
+// Validate returns the first validation error of the geometry, nil if it is valid.
+// A Collection is valid if its elements are valid, the polygons of a MultiPolygonMatrix must not
+// overlap or be nested, they may touch at points.
+func (el *ValidOP) Validate() *ValidationError {
+	switch matr := el.Steric.(type) {
+	case matrix.Matrix:
+		return validateCoordinates(matrix.LineMatrix{matr})
+	case matrix.LineMatrix:
+		return validateLine(matr)
+	case matrix.PolygonMatrix:
+		return validatePolygons([]matrix.PolygonMatrix{matr}, false)
+	case matrix.MultiPolygonMatrix:
+		polygons := []matrix.PolygonMatrix{}
+		for _, v := range matr {
+			polygons = append(polygons, v)
+		}
+		return validatePolygons(polygons, true)
+	case matrix.Collection:
+		for _, v := range matr {
+			elem := ValidOP{v}
+			if err := elem.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsValid returns true if the geometry has no validation error.
+func (el *ValidOP) IsValid() bool {
+	return el.Validate() == nil
+}
+
+// validateCoordinates returns an invalid coordinate error for the first NaN or infinite coordinate,
+// the Z of a measured point without Z is NaN.
+func validateCoordinates(line matrix.LineMatrix) *ValidationError {
+	for _, v := range line {
+		for i, c := range v {
+			if (math.IsNaN(c) && !(i == 2 && len(v) > 3)) || math.IsInf(c, 0) {
+				return &ValidationError{ErrorInvalidCoordinate, v}
+			}
+		}
+	}
+	return nil
+}
+
+// validateLine returns the validation error of the line, which must have two distinct points.
+func validateLine(line matrix.LineMatrix) *ValidationError {
+	if err := validateCoordinates(line); err != nil {
+		return err
+	}
+	if len(line) > 0 && len(removeRepeatedPoints(line)) < 2 {
+		return &ValidationError{ErrorTooFewPoints, line[0]}
+	}
+	return nil
+}
+
+// validatePolygons returns the validation error of the polygons.
+// The polygons must not intersect unless they are the polygons of a multi polygon.
+func validatePolygons(polygons []matrix.PolygonMatrix, isMulti bool) *ValidationError {
+	rings := []*validRing{}
+	for i, polygon := range polygons {
+		for j, ring := range polygon {
+			if err := validateCoordinates(ring); err != nil {
+				return err
+			}
+			if len(ring) == 0 {
+				continue
+			}
+			if !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+				return &ValidationError{ErrorRingNotClosed, ring[0]}
+			}
+			points := removeRepeatedPoints(ring)
+			if len(points) < 4 {
+				return &ValidationError{ErrorTooFewPoints, ring[0]}
+			}
+			rings = append(rings, &validRing{polygon: i, isHole: j > 0, points: points, touches: map[[2]float64]bool{}})
+		}
+	}
+	if err := validateIntersections(rings); err != nil {
+		return err
+	}
+	for _, ring := range rings {
+		if !ring.isHole {
+			continue
+		}
+		p := ring.freePoint()
+		if p == nil {
+			continue
+		}
+		for _, other := range rings {
+			if other.polygon != ring.polygon || other == ring {
+				continue
+			}
+			switch inside := relate.InPolygon(p, other.points); {
+			case !other.isHole && !inside:
+				return &ValidationError{ErrorHoleOutsideShell, p}
+			case other.isHole && inside:
+				return &ValidationError{ErrorNestedHoles, p}
+			}
+		}
+	}
+	if err := validateInteriorConnected(rings); err != nil {
+		return err
+	}
+	if isMulti {
+		return validateShellsNotNested(rings)
+	}
+	return nil
+}
+
+// validRing a ring of a polygon without repeated points, the points where other rings of the polygon touch it
+// and the rings touching it at each point.
+type validRing struct {
+	polygon int
+	isHole  bool
+	points  matrix.LineMatrix
+	touches map[[2]float64]bool
+	edges   []ringTouch
+}
+
+// ringTouch a point where a ring touches another ring.
+type ringTouch struct {
+	point matrix.Matrix
+	other *validRing
+}
+
+// freePoint returns a vertex of the ring which does not touch another ring, nil if there is none.
+func (r *validRing) freePoint() matrix.Matrix {
+	for _, v := range r.points {
+		if !r.touches[[2]float64{v[0], v[1]}] {
+			return v
+		}
+	}
+	return nil
+}
+
+// ringSegment a segment of a ring.
+type ringSegment struct {
+	ring  *validRing
+	index int
+	a, b  matrix.Matrix
+}
+
+// validateIntersections returns a self-intersection error if two segments cross or overlap,
+// or a ring self-intersection error if a ring touches itself, and records the points where rings of a polygon touch.
+// The polygons of a multi polygon may touch. Crossing and overlapping segments are reported before touching rings.
+func validateIntersections(rings []*validRing) *ValidationError {
+	var selfTouch *ValidationError
+	segments := []*ringSegment{}
+	for _, ring := range rings {
+		for i := 1; i < len(ring.points); i++ {
+			segments = append(segments, &ringSegment{ring: ring, index: i - 1, a: ring.points[i-1], b: ring.points[i]})
+		}
+	}
+	minX := func(s *ringSegment) float64 { return math.Min(s.a[0], s.b[0]) }
+	maxX := func(s *ringSegment) float64 { return math.Max(s.a[0], s.b[0]) }
+	sort.SliceStable(segments, func(i, j int) bool { return minX(segments[i]) < minX(segments[j]) })
+
+	for i, s := range segments {
+		for j := i + 1; j < len(segments) && minX(segments[j]) <= maxX(s); j++ {
+			o := segments[j]
+			kind, p := intersectSegments(s.a, s.b, o.a, o.b)
+			switch {
+			case kind == noIntersection:
+				continue
+			case kind != touchIntersection:
+				return &ValidationError{ErrorSelfIntersection, p}
+			case s.ring == o.ring:
+				if selfTouch == nil && !isAdjacent(s, o) {
+					selfTouch = &ValidationError{ErrorRingSelfIntersection, p}
+				}
+			case s.ring.polygon == o.ring.polygon:
+				key := [2]float64{p[0], p[1]}
+				s.ring.touches[key], o.ring.touches[key] = true, true
+				s.ring.edges = append(s.ring.edges, ringTouch{p, o.ring})
+			}
+		}
+	}
+	return selfTouch
+}
+
+// isAdjacent returns true if the segments are consecutive in their ring.
+func isAdjacent(s, o *ringSegment) bool {
+	last := len(s.ring.points) - 2
+	d := s.index - o.index
+	return d == 1 || d == -1 || (s.index == 0 && o.index == last) || (o.index == 0 && s.index == last)
+}
+
+// validateInteriorConnected returns a disconnected interior error if the rings of a polygon
+// and the points where they touch form a cycle, which splits the interior of the polygon.
+func validateInteriorConnected(rings []*validRing) *ValidationError {
+	parent := map[interface{}]interface{}{}
+	var find func(x interface{}) interface{}
+	find = func(x interface{}) interface{} {
+		if p, ok := parent[x]; ok && p != x {
+			root := find(p)
+			parent[x] = root
+			return root
+		}
+		return x
+	}
+	type vertex struct {
+		polygon int
+		x, y    float64
+	}
+	linked := map[[2]interface{}]bool{}
+	for _, ring := range rings {
+		for _, touch := range ring.edges {
+			point := vertex{ring.polygon, touch.point[0], touch.point[1]}
+			for _, r := range []*validRing{ring, touch.other} {
+				if linked[[2]interface{}{r, point}] {
+					continue
+				}
+				linked[[2]interface{}{r, point}] = true
+				if find(r) == find(point) {
+					return &ValidationError{ErrorDisconnectedInterior, touch.point}
+				}
+				parent[find(r)] = find(point)
+			}
+		}
+	}
+	return nil
+}
+
+// validateShellsNotNested returns a nested shells error if a shell of a multi polygon is inside another polygon.
+func validateShellsNotNested(rings []*validRing) *ValidationError {
+	for _, shell := range rings {
+		if shell.isHole {
+			continue
+		}
+		for _, other := range rings {
+			if other.isHole || other.polygon == shell.polygon {
+				continue
+			}
+			p := pointNotOnPolygon(shell, other.polygon, rings)
+			if p == nil || !relate.InPolygon(p, other.points) {
+				continue
+			}
+			inHole := false
+			for _, hole := range rings {
+				if hole.isHole && hole.polygon == other.polygon && relate.InPolygon(p, hole.points) {
+					inHole = true
+				}
+			}
+			if !inHole {
+				return &ValidationError{ErrorNestedShells, p}
+			}
+		}
+	}
+	return nil
+}
+
+// pointNotOnPolygon returns a vertex or segment midpoint of the ring which is not on the rings of the polygon.
+func pointNotOnPolygon(ring *validRing, polygon int, rings []*validRing) matrix.Matrix {
+	candidates := matrix.LineMatrix{}
+	candidates = append(candidates, ring.points...)
+	for i := 1; i < len(ring.points); i++ {
+		a, b := ring.points[i-1], ring.points[i]
+		candidates = append(candidates, []float64{(a[0] + b[0]) / 2, (a[1] + b[1]) / 2})
+	}
+	for _, v := range candidates {
+		onPolygon := false
+		for _, other := range rings {
+			if other.polygon != polygon {
+				continue
+			}
+			for i := 1; i < len(other.points) && !onPolygon; i++ {
+				onPolygon = isOnSegment(v, other.points[i-1], other.points[i])
+			}
+		}
+		if !onPolygon {
+			return v
+		}
+	}
+	return nil
+}
+
+// The kinds of intersection of two segments.
+const (
+	noIntersection = iota
+	touchIntersection
+	crossIntersection
+	overlapIntersection
+)
+
+// intersectSegments returns the kind of intersection of the segments a-b and p-q and an intersection point,
+// they touch if they have one common point which is an endpoint of one of them.
+func intersectSegments(a, b, p, q matrix.Matrix) (int, matrix.Matrix) {
+	if math.Max(a[0], b[0]) < math.Min(p[0], q[0]) || math.Max(p[0], q[0]) < math.Min(a[0], b[0]) ||
+		math.Max(a[1], b[1]) < math.Min(p[1], q[1]) || math.Max(p[1], q[1]) < math.Min(a[1], b[1]) {
+		return noIntersection, nil
+	}
+	dp, dq := cross(a, b, p), cross(a, b, q)
+	da, db := cross(p, q, a), cross(p, q, b)
+	if dp == 0 && dq == 0 {
+		// collinear segments, the common points are the endpoints on the other segment.
+		common := matrix.LineMatrix{}
+		for _, v := range []matrix.Matrix{p, q} {
+			if isOnSegment(v, a, b) {
+				common = append(common, v)
+			}
+		}
+		for _, v := range []matrix.Matrix{a, b} {
+			if isOnSegment(v, p, q) {
+				common = append(common, v)
+			}
+		}
+		for _, v := range common[1:] {
+			if !matrix.Matrix(v).Equals(matrix.Matrix(common[0])) {
+				return overlapIntersection, v
+			}
+		}
+		if len(common) == 0 {
+			return noIntersection, nil
+		}
+		return touchIntersection, common[0]
+	}
+	if (dp > 0 && dq < 0 || dp < 0 && dq > 0) && (da > 0 && db < 0 || da < 0 && db > 0) {
+		d := dp / (dp - dq)
+		return crossIntersection, matrix.Matrix{p[0] + d*(q[0]-p[0]), p[1] + d*(q[1]-p[1])}
+	}
+	for _, v := range []matrix.Matrix{p, q} {
+		if isOnSegment(v, a, b) {
+			return touchIntersection, v
+		}
+	}
+	for _, v := range []matrix.Matrix{a, b} {
+		if isOnSegment(v, p, q) {
+			return touchIntersection, v
+		}
+	}
+	return noIntersection, nil
+}
+
+// isOnSegment returns true if the point is on the segment a-b.
+func isOnSegment(v, a, b matrix.Matrix) bool {
+	return cross(a, b, v) == 0 &&
+		v[0] >= math.Min(a[0], b[0]) && v[0] <= math.Max(a[0], b[0]) &&
+		v[1] >= math.Min(a[1], b[1]) && v[1] <= math.Max(a[1], b[1])
+}
+
+// cross returns the cross product of a-b and a-p, positive if p is on the left of a-b.
+func cross(a, b, p matrix.Matrix) float64 {
+	return (b[0]-a[0])*(p[1]-a[1]) - (b[1]-a[1])*(p[0]-a[0])
+}
@@ -0,0 +1,66 @@
+package operation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestValidOP_Validate(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name     string
+		geom     matrix.Steric
+		wantType ValidErrorType
+		wantAt   matrix.Matrix
+	}{
+		{"valid polygon", matrix.PolygonMatrix{square, {{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}}, 0, nil},
+		{"valid line", matrix.LineMatrix{{0, 0}, {1, 1}, {0, 1}, {1, 0}}, 0, nil},
+		{"invalid coordinate", matrix.Matrix{math.NaN(), 1}, ErrorInvalidCoordinate, matrix.Matrix{math.NaN(), 1}},
+		{"line of one point", matrix.LineMatrix{{1, 1}, {1, 1}}, ErrorTooFewPoints, matrix.Matrix{1, 1}},
+		{"ring not closed", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}}}, ErrorRingNotClosed, matrix.Matrix{0, 0}},
+		{"too few points", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 0}, {0, 0}}}, ErrorTooFewPoints, matrix.Matrix{0, 0}},
+		{"bow-tie", matrix.PolygonMatrix{{{0, 0}, {10, 10}, {10, 0}, {0, 10}, {0, 0}}}, ErrorSelfIntersection, matrix.Matrix{5, 5}},
+		{"spike", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {10, 20}, {10, 10}, {0, 10}, {0, 0}}},
+			ErrorSelfIntersection, matrix.Matrix{10, 10}},
+		{"ring self-touch", matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {5, 0}, {0, 10}, {0, 0}}},
+			ErrorRingSelfIntersection, matrix.Matrix{5, 0}},
+		{"hole crossing shell", matrix.PolygonMatrix{square, {{5, 2}, {15, 2}, {15, 8}, {5, 8}, {5, 2}}},
+			ErrorSelfIntersection, matrix.Matrix{10, 2}},
+		{"hole outside shell", matrix.PolygonMatrix{square, {{20, 0}, {30, 0}, {30, 10}, {20, 0}}},
+			ErrorHoleOutsideShell, matrix.Matrix{20, 0}},
+		{"nested holes", matrix.PolygonMatrix{square, {{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, {{2, 2}, {8, 2}, {8, 8}, {2, 2}}},
+			ErrorNestedHoles, matrix.Matrix{2, 2}},
+		{"touching hole", matrix.PolygonMatrix{square, {{0, 0}, {5, 2}, {2, 5}, {0, 0}}}, 0, nil},
+		{"disconnected interior", matrix.PolygonMatrix{square, {{0, 5}, {5, 0}, {10, 5}, {5, 10}, {0, 5}}},
+			ErrorDisconnectedInterior, matrix.Matrix{5, 10}},
+		{"disconnected by holes", matrix.PolygonMatrix{square, {{0, 5}, {5, 2}, {4, 5}, {5, 8}, {0, 5}},
+			{{5, 2}, {10, 5}, {5, 8}, {6, 5}, {5, 2}}}, ErrorDisconnectedInterior, matrix.Matrix{5, 8}},
+		{"touching polygons", matrix.MultiPolygonMatrix{{square}, {{{10, 10}, {20, 10}, {20, 20}, {10, 10}}}}, 0, nil},
+		{"overlapping polygons", matrix.MultiPolygonMatrix{{square}, {{{5, 5}, {15, 5}, {15, 15}, {5, 5}}}},
+			ErrorSelfIntersection, matrix.Matrix{10, 5}},
+		{"nested shells", matrix.MultiPolygonMatrix{{square}, {{{2, 2}, {8, 2}, {8, 8}, {2, 2}}}},
+			ErrorNestedShells, matrix.Matrix{2, 2}},
+		{"polygon in hole", matrix.MultiPolygonMatrix{{square, {{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}},
+			{{{3, 3}, {7, 3}, {7, 7}, {3, 3}}}}, 0, nil},
+		{"collection", matrix.Collection{square, matrix.LineMatrix{{1, 1}}}, ErrorTooFewPoints, matrix.Matrix{1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			el := &ValidOP{tt.geom}
+			err := el.Validate()
+			if tt.wantType == 0 {
+				if err != nil || !el.IsValid() {
+					t.Errorf("Validate() = %v, want valid", err)
+				}
+				return
+			}
+			if err == nil || err.Type != tt.wantType || !err.Coordinate.EqualsExact(tt.wantAt, 1e-9) {
+				if err == nil || !(math.IsNaN(err.Coordinate[0]) && math.IsNaN(tt.wantAt[0])) {
+					t.Errorf("Validate() = %v, want %v at or near point %v", err, tt.wantType, tt.wantAt)
+				}
+			}
+		})
+	}
+}
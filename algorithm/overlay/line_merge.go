@@ -67,26 +67,26 @@ func MergeLine(ml matrix.Collection, i, j int) (matrix.Collection, bool) {
 					if i < j {
 						temp1, temp2 := ml[i+1:j], ml[j+1:]
 						if i > 0 {
-							result = append(result, ml[:i])
+							result = append(result, ml[:i]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
 					if i > j {
 						temp1, temp2 := ml[j+1:i], ml[i+1:]
 						if j > 0 {
-							result = append(result, ml[:j])
+							result = append(result, ml[:j]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
@@ -99,26 +99,26 @@ func MergeLine(ml matrix.Collection, i, j int) (matrix.Collection, bool) {
 					if i < j {
 						temp1, temp2 := ml[i+1:j], ml[j+1:]
 						if i > 0 {
-							result = append(result, ml[:i])
+							result = append(result, ml[:i]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
 					if i > j {
 						temp1, temp2 := ml[j+1:i], ml[i+1:]
 						if j > 0 {
-							result = append(result, ml[:j])
+							result = append(result, ml[:j]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
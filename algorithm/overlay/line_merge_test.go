@@ -24,6 +24,9 @@ func TestLineMerge(t *testing.T) {
 			want: matrix.Collection{matrix.LineMatrix{{-29, -27}, {-30, -29.7}, {-36, -31}, {-45, -33}}, matrix.LineMatrix{{-45.2, -33.2}, {-46, -32}}}},
 		{name: "line line2", args: args{matrix.Collection{matrix.LineMatrix{{50, 100}, {50, 200}}, matrix.LineMatrix{{30, 150}, {80, 150}}}},
 			want: matrix.Collection{matrix.LineMatrix{{50, 100}, {50, 200}}, matrix.LineMatrix{{30, 150}, {80, 150}}}},
+		{name: "lines between", args: args{matrix.Collection{matrix.LineMatrix{{0, 0}, {1, 0}}, matrix.LineMatrix{{5, 5}, {6, 6}},
+			matrix.LineMatrix{{1, 0}, {2, 0}}, matrix.LineMatrix{{7, 7}, {8, 8}}}},
+			want: matrix.Collection{matrix.LineMatrix{{5, 5}, {6, 6}}, matrix.LineMatrix{{7, 7}, {8, 8}}, matrix.LineMatrix{{0, 0}, {1, 0}, {2, 0}}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
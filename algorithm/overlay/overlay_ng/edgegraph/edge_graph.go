@@ -0,0 +1,85 @@
+package edgegraph
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// EdgeGraph A graph comprised of HalfEdges. It supports tracking the vertices in the graph
+// via edges incident on them, to allow efficient lookup of edges and vertices.
+// The edges and vertices are kept in the order they are added.
+type EdgeGraph struct {
+	vertexMap map[[2]float64]*HalfEdge
+	edges     []*HalfEdge
+}
+
+// NewEdgeGraph Creates an empty EdgeGraph.
+func NewEdgeGraph() *EdgeGraph {
+	return &EdgeGraph{vertexMap: map[[2]float64]*HalfEdge{}}
+}
+
+// AddEdge Adds an edge between the coordinates orig and dest to this graph.
+// Only valid edges can be added (in particular, zero-length segments cannot be added).
+// If the edge is already in the graph the existing edge in the direction from orig to dest is returned.
+// Params:
+//		orig – the edge origin location
+//		dest – the edge destination location.
+// Returns:
+//		the created or existing edge, or nil if the edge was invalid
+func (g *EdgeGraph) AddEdge(orig, dest matrix.Matrix) *HalfEdge {
+	if !IsValidEdge(orig, dest) {
+		return nil
+	}
+	// Attempt to find the edge already in the graph.
+	eAdj, ok := g.vertexMap[vertexKey(orig)]
+	if ok {
+		if eSame := eAdj.Find(dest); eSame != nil {
+			return eSame
+		}
+	}
+	return g.insert(orig, dest, eAdj)
+}
+
+// insert Inserts an edge not already present into the graph.
+// Params:
+//		eAdj – an existing edge for the origin vertex, or nil if the vertex is new
+func (g *EdgeGraph) insert(orig, dest matrix.Matrix, eAdj *HalfEdge) *HalfEdge {
+	e := Create(orig, dest)
+	if eAdj != nil {
+		eAdj.Insert(e)
+	} else {
+		g.vertexMap[vertexKey(orig)] = e
+	}
+	if eAdjDest, ok := g.vertexMap[vertexKey(dest)]; ok {
+		eAdjDest.Insert(e.Sym())
+	} else {
+		g.vertexMap[vertexKey(dest)] = e.Sym()
+	}
+	g.edges = append(g.edges, e, e.Sym())
+	return e
+}
+
+// Edges Gets all HalfEdges in the graph, both edges of each symmetric pair in the order they are added.
+func (g *EdgeGraph) Edges() []*HalfEdge {
+	return g.edges
+}
+
+// FindEdge Finds an edge in this graph with the given origin and destination, if one exists.
+// Returns:
+//		an edge with the given orig and dest, or nil if none exists
+func (g *EdgeGraph) FindEdge(orig, dest matrix.Matrix) *HalfEdge {
+	e, ok := g.vertexMap[vertexKey(orig)]
+	if !ok {
+		return nil
+	}
+	return e.Find(dest)
+}
+
+// IsValidEdge Tests if the given coordinates form a valid edge (with non-zero length).
+func IsValidEdge(orig, dest matrix.Matrix) bool {
+	return !orig.Equals(dest)
+}
+
+// vertexKey returns the key of the vertex in the vertex map.
+func vertexKey(p matrix.Matrix) [2]float64 {
+	return [2]float64{p[0], p[1]}
+}
@@ -0,0 +1,49 @@
+package edgegraph
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestEdgeGraph_AddEdge(t *testing.T) {
+	centre := matrix.Matrix{0, 0}
+	tests := []struct {
+		name  string
+		dests []matrix.Matrix
+		want  []matrix.Matrix
+	}{
+		{"one edge", []matrix.Matrix{{1, 0}}, []matrix.Matrix{{1, 0}}},
+		{"star", []matrix.Matrix{{0, -1}, {1, 0}, {-1, 0}, {0, 1}, {1, 1}},
+			[]matrix.Matrix{{0, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 0}}},
+		{"repeated edge", []matrix.Matrix{{1, 0}, {-1, -1}, {1, 0}}, []matrix.Matrix{{1, 0}, {-1, -1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewEdgeGraph()
+			for _, v := range tt.dests {
+				g.AddEdge(centre, v)
+			}
+			if len(g.Edges()) != 2*len(tt.want) {
+				t.Fatalf("AddEdge() %v edges, want %v", len(g.Edges()), 2*len(tt.want))
+			}
+			e := g.FindEdge(centre, tt.want[0])
+			if e.Degree() != len(tt.want) {
+				t.Errorf("Degree() = %v, want %v", e.Degree(), len(tt.want))
+			}
+			// edges around the origin are in counter-clockwise order.
+			for _, v := range tt.want {
+				if !e.Dest().Equals(v) {
+					t.Errorf("ONext() dest = %v, want %v", e.Dest(), v)
+				}
+				if e.Sym().Sym() != e || !e.Sym().Dest().Equals(centre) {
+					t.Errorf("Sym() = %v, want the edge to %v", e.Sym().ToString(), centre)
+				}
+				e = e.ONext()
+			}
+		})
+	}
+	if NewEdgeGraph().AddEdge(centre, centre) != nil {
+		t.Errorf("AddEdge() of a zero-length edge is not nil")
+	}
+}
@@ -2,8 +2,11 @@ package edgegraph
 
 import (
 	"fmt"
-	"github.com/spatial-go/geoos/algorithm/matrix"
 	"reflect"
+
+	"github.com/spatial-go/geoos/algorithm/calc/angle"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
 )
 
 // Represents a directed component of an edge in an EdgeGraph. HalfEdges link
@@ -26,6 +29,25 @@ type HalfEdge struct {
 	sym, next *HalfEdge
 }
 
+// NewHalfEdge Creates a half-edge originating from a given coordinate.
+// Params:
+//		orig – the origin coordinate
+func NewHalfEdge(orig matrix.Matrix) *HalfEdge {
+	return &HalfEdge{orig: orig}
+}
+
+// Create Creates a HalfEdge pair representing an edge between two vertices located at coordinates p0 and p1.
+// Params:
+//		p0 – a vertex coordinate
+//		p1 – a vertex coordinate
+// Returns:
+//		the HalfEdge with origin at p0
+func Create(p0, p1 matrix.Matrix) *HalfEdge {
+	e0, e1 := NewHalfEdge(p0), NewHalfEdge(p1)
+	e0.Link(e1)
+	return e0
+}
+
 // Link Links this edge with its sym (opposite) edge.
 // This must be done for each pair of edges created.
 // Params:
@@ -45,6 +67,16 @@ func (h *HalfEdge) Sym() *HalfEdge {
 	return h.sym
 }
 
+// Orig Gets the origin coordinate of this edge.
+func (h *HalfEdge) Orig() matrix.Matrix {
+	return h.orig
+}
+
+// Dest Gets the destination coordinate of this edge.
+func (h *HalfEdge) Dest() matrix.Matrix {
+	return h.sym.orig
+}
+
 // SetSym Sets the symmetric (opposite) edge to this edge.
 // Params:
 //		e – the sym edge to set
@@ -59,7 +91,30 @@ func (h *HalfEdge) SetNext(e *HalfEdge) {
 	h.next = e
 }
 
-// oNext Gets the next edge CCW around the origin of this edge, with the
+// Next Gets the next edge CCW around the destination vertex of this edge,
+// with the dest vertex as its origin. If the vertex has degree 1 then this is the sym edge.
+// Returns:
+//		the next edge
+func (h *HalfEdge) Next() *HalfEdge {
+	return h.next
+}
+
+// Prev Gets the edge previous to this one, with dest being the same as this orig.
+// Returns:
+//		the previous edge to this one
+func (h *HalfEdge) Prev() *HalfEdge {
+	curr, prev := h, h
+	for {
+		prev = curr
+		curr = curr.ONext()
+		if curr == h {
+			break
+		}
+	}
+	return prev.sym
+}
+
+// ONext Gets the next edge CCW around the origin of this edge, with the
 // same origin. If the origin vertex has degree 1 then this is the edge itself.
 // e.ONext() is equal to e.sym().next()
 // Returns:
@@ -68,7 +123,41 @@ func (h *HalfEdge) ONext() *HalfEdge {
 	return h.sym.next
 }
 
-// insert inserts an edge into the ring of edges around the origin vertex
+// Find Finds the edge starting at the origin of this edge with the given dest vertex, if any.
+// Params:
+//		dest – the dest vertex to search for
+// Returns:
+//		the edge with the required dest vertex, if it exists, or nil
+func (h *HalfEdge) Find(dest matrix.Matrix) *HalfEdge {
+	oNext := h
+	for {
+		if oNext.Dest().Equals(dest) {
+			return oNext
+		}
+		oNext = oNext.ONext()
+		if oNext == h {
+			return nil
+		}
+	}
+}
+
+// Degree Computes the degree of the origin vertex. The degree is the number of edges terminating at the vertex.
+// Returns:
+//		the number of edges around the origin
+func (h *HalfEdge) Degree() int {
+	degree := 0
+	e := h
+	for {
+		degree++
+		e = e.ONext()
+		if e == h {
+			break
+		}
+	}
+	return degree
+}
+
+// Insert inserts an edge into the ring of edges around the origin vertex
 // of this edge, ensuring that the edges remain ordered CCW. The inserted
 // edge must have the same origin as this edge.
 // Params:
@@ -157,11 +246,29 @@ func (h *HalfEdge) compareTo(obj interface{}) int {
 // if the vectors lie in the same quadrant, the Orientation.index(Coordinate, Coordinate,
 // Coordinate) function can be used to determine the relative orientation of the vectors.
 func (h *HalfEdge) compareAngularDirection(e *HalfEdge) int {
-	// todo
-	return 0
+	dx, dy := h.DirectionPt()[0]-h.orig[0], h.DirectionPt()[1]-h.orig[1]
+	dx2, dy2 := e.DirectionPt()[0]-e.orig[0], e.DirectionPt()[1]-e.orig[1]
+	// same vector
+	if dx == dx2 && dy == dy2 {
+		return 0
+	}
+	quadrant, _ := angle.QuadrantFloat(dx, dy)
+	quadrant2, _ := angle.QuadrantFloat(dx2, dy2)
+	// if the vectors are in different quadrants, determining the ordering is trivial
+	if quadrant > quadrant2 {
+		return 1
+	}
+	if quadrant < quadrant2 {
+		return -1
+	}
+	// vectors are in the same quadrant
+	// Check relative orientation of direction vectors
+	// this is > e if it is CCW of e
+	return measure.CGAlgorithmsDD{}.OrientationIndexPair(e.orig, e.DirectionPt(), h.DirectionPt())
 }
 
-// DirectionPt...
+// DirectionPt Gets the direction point of this edge, the coordinate its angle at the origin is computed from,
+// which is the dest vertex.
 func (h *HalfEdge) DirectionPt() matrix.Matrix {
 	return h.sym.orig
 }
@@ -170,5 +277,5 @@ func (h *HalfEdge) DirectionPt() matrix.Matrix {
 // Returns:
 //		a string representation
 func (h *HalfEdge) ToString() string {
-	return fmt.Sprintf("HE(%v %v, %v %v)", h.orig[0], h.orig[0], h.sym.orig[0], h.sym.orig[0])
+	return fmt.Sprintf("HE(%v %v, %v %v)", h.orig[0], h.orig[1], h.sym.orig[0], h.sym.orig[1])
 }
@@ -19,7 +19,7 @@ func HalfEdgerONext(h HalfEdger) HalfEdger {
 
 // HalfEdgerSym...
 func HalfEdgerSym(h HalfEdger) HalfEdger {
-	return h.ONext()
+	return h.Sym()
 }
 
 // HalfEdgerInsert...
@@ -0,0 +1,102 @@
+package polygonize
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// segment a segment of an input line, index is its position in the line.
+type segment struct {
+	line, index int
+	a, b        matrix.Matrix
+}
+
+// nodeLines returns the lines with a vertex added at each intersection with another segment,
+// a segment endpoint closer than calc.AccuracyFloat to another segment is a vertex of both.
+// The segments are swept in order of their smallest x.
+func nodeLines(lines []matrix.LineMatrix) []matrix.LineMatrix {
+	segments := []*segment{}
+	for i, line := range lines {
+		for j := 1; j < len(line); j++ {
+			segments = append(segments, &segment{line: i, index: j - 1, a: line[j-1], b: line[j]})
+		}
+	}
+	bySweep := append([]*segment{}, segments...)
+	minX := func(s *segment) float64 { return math.Min(s.a[0], s.b[0]) }
+	maxX := func(s *segment) float64 { return math.Max(s.a[0], s.b[0]) }
+	sort.SliceStable(bySweep, func(i, j int) bool { return minX(bySweep[i]) < minX(bySweep[j]) })
+
+	splits := map[*segment]matrix.LineMatrix{}
+	for i, s := range bySweep {
+		for j := i + 1; j < len(bySweep) && minX(bySweep[j]) <= maxX(s); j++ {
+			o := bySweep[j]
+			if math.Max(s.a[1], s.b[1]) < math.Min(o.a[1], o.b[1]) || math.Max(o.a[1], o.b[1]) < math.Min(s.a[1], s.b[1]) {
+				continue
+			}
+			for _, p := range intersections(s.a, s.b, o.a, o.b) {
+				if !p.Equals(s.a) && !p.Equals(s.b) {
+					splits[s] = append(splits[s], p)
+				}
+				if !p.Equals(o.a) && !p.Equals(o.b) {
+					splits[o] = append(splits[o], p)
+				}
+			}
+		}
+	}
+
+	noded := make([]matrix.LineMatrix, len(lines))
+	for _, s := range segments {
+		points := splits[s]
+		sort.SliceStable(points, func(i, j int) bool {
+			return measure.PlanarDistance(s.a, points[i]) < measure.PlanarDistance(s.a, points[j])
+		})
+		if s.index == 0 {
+			noded[s.line] = matrix.LineMatrix{s.a}
+		}
+		for _, p := range append(points, s.b) {
+			if !matrix.Matrix(noded[s.line][len(noded[s.line])-1]).Equals(matrix.Matrix(p)) {
+				noded[s.line] = append(noded[s.line], p)
+			}
+		}
+	}
+	return noded
+}
+
+// intersections returns the intersection points of the segments a-b and p-q,
+// the endpoints of each segment on the other, or the crossing point if they cross properly.
+func intersections(a, b, p, q matrix.Matrix) []matrix.Matrix {
+	points := []matrix.Matrix{}
+	for _, v := range []matrix.Matrix{p, q} {
+		if isOnSegment(v, a, b) {
+			points = append(points, v)
+		}
+	}
+	for _, v := range []matrix.Matrix{a, b} {
+		if isOnSegment(v, p, q) {
+			points = append(points, v)
+		}
+	}
+	if len(points) > 0 {
+		return points
+	}
+	dp, dq := cross(a, b, p), cross(a, b, q)
+	if dp*dq >= 0 || cross(p, q, a)*cross(p, q, b) >= 0 {
+		return points
+	}
+	d := dp / (dp - dq)
+	return append(points, matrix.Matrix{p[0] + d*(q[0]-p[0]), p[1] + d*(q[1]-p[1])})
+}
+
+// isOnSegment returns true if the point is closer than calc.AccuracyFloat to the segment a-b.
+func isOnSegment(v, a, b matrix.Matrix) bool {
+	return measure.DistanceSegmentToPoint(v, a, b, measure.PlanarDistance) <= calc.AccuracyFloat
+}
+
+// cross returns the cross product of a-b and a-p, positive if p is on the left of a-b.
+func cross(a, b, p matrix.Matrix) float64 {
+	return (b[0]-a[0])*(p[1]-a[1]) - (b[1]-a[1])*(p[0]-a[0])
+}
@@ -0,0 +1,359 @@
+// Package polygonize builds the polygons formed by a set of lines.
+package polygonize
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/overlay/overlay_ng/edgegraph"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// Polygonizer builds the polygons formed by the lines and polygon rings of a geometry.
+// The lines are noded at their intersections, each closed face of the noded lines is a polygon
+// and the faces inside it are its holes.
+// The lines which do not bound a face are reported separately:
+// dangles have an end not connected to other lines, cut edges bound the same face on both sides,
+// invalid ring lines are the face boundaries which touch themselves and can not be polygon shells.
+type Polygonizer struct {
+	graph           *edgegraph.EdgeGraph
+	lineOf          map[*edgegraph.HalfEdge]int
+	removed         map[*edgegraph.HalfEdge]bool
+	checkRingsValid bool
+
+	polygons, dangles, cutEdges, invalidRingLines matrix.Collection
+}
+
+// Polygonize creates a Polygonizer of the lines and polygon rings of geometry and builds the polygons.
+func Polygonize(geom matrix.Steric) *Polygonizer {
+	return polygonize(geom, true)
+}
+
+// PolygonizeFaces creates a Polygonizer as Polygonize which builds a polygon of every face,
+// a face boundary which touches itself is split at the touching vertices into a shell and holes
+// instead of being reported as an invalid ring line.
+func PolygonizeFaces(geom matrix.Steric) *Polygonizer {
+	return polygonize(geom, false)
+}
+
+// polygonize creates a Polygonizer and builds the polygons, the shells which touch themselves
+// are invalid ring lines if checkRingsValid.
+func polygonize(geom matrix.Steric, checkRingsValid bool) *Polygonizer {
+	p := &Polygonizer{
+		graph:            edgegraph.NewEdgeGraph(),
+		lineOf:           map[*edgegraph.HalfEdge]int{},
+		removed:          map[*edgegraph.HalfEdge]bool{},
+		checkRingsValid:  checkRingsValid,
+		polygons:         matrix.Collection{},
+		invalidRingLines: matrix.Collection{},
+	}
+	for i, line := range nodeLines(linesOf(geom)) {
+		for j := 1; j < len(line); j++ {
+			e := p.graph.AddEdge(line[j-1], line[j])
+			if _, ok := p.lineOf[e]; !ok {
+				p.lineOf[e], p.lineOf[e.Sym()] = i, i
+			}
+		}
+	}
+	p.dangles = p.chains(p.removeDangles())
+	p.cutEdges = p.chains(p.removeCutEdges())
+	p.buildPolygons()
+	return p
+}
+
+// Polygons returns the polygons formed by the lines, counter-clockwise shells with clockwise holes.
+func (p *Polygonizer) Polygons() matrix.Collection {
+	return p.polygons
+}
+
+// Dangles returns the lines with an end not connected to other lines, directly or through other dangles.
+func (p *Polygonizer) Dangles() matrix.Collection {
+	return p.dangles
+}
+
+// CutEdges returns the lines connected at both ends which are not on the boundary of a polygon,
+// the same face is on both sides of them.
+func (p *Polygonizer) CutEdges() matrix.Collection {
+	return p.cutEdges
+}
+
+// InvalidRingLines returns the closed face boundaries which touch themselves,
+// they are not polygons.
+func (p *Polygonizer) InvalidRingLines() matrix.Collection {
+	return p.invalidRingLines
+}
+
+// removeDangles removes the edges with an end of degree 1 until there is none and returns them.
+func (p *Polygonizer) removeDangles() []*edgegraph.HalfEdge {
+	dangles := []*edgegraph.HalfEdge{}
+	stack := append([]*edgegraph.HalfEdge{}, p.graph.Edges()...)
+	for len(stack) > 0 {
+		e := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if p.removed[e] || p.degree(e) != 1 {
+			continue
+		}
+		p.removed[e], p.removed[e.Sym()] = true, true
+		dangles = append(dangles, e)
+		if next := p.next(e); next != e.Sym() {
+			stack = append(stack, next)
+		}
+	}
+	return dangles
+}
+
+// removeCutEdges removes the edges whose two sides are in the same ring and returns them.
+func (p *Polygonizer) removeCutEdges() []*edgegraph.HalfEdge {
+	ringOf := map[*edgegraph.HalfEdge]int{}
+	for i, ring := range p.rings() {
+		for _, e := range ring {
+			ringOf[e] = i
+		}
+	}
+	cutEdges := []*edgegraph.HalfEdge{}
+	for _, e := range p.graph.Edges() {
+		if !p.removed[e] && ringOf[e] == ringOf[e.Sym()] {
+			p.removed[e], p.removed[e.Sym()] = true, true
+			cutEdges = append(cutEdges, e)
+		}
+	}
+	return cutEdges
+}
+
+// buildPolygons builds the polygons of the rings left, a clockwise ring is the shell of the face inside it,
+// a counter-clockwise ring bounds the face outside it and is a hole of the smallest shell containing it.
+func (p *Polygonizer) buildPolygons() {
+	shells, holes := []matrix.LineMatrix{}, []matrix.LineMatrix{}
+	for _, ring := range p.rings() {
+		coords := matrix.LineMatrix{}
+		for _, e := range ring {
+			coords = append(coords, e.Orig())
+		}
+		coords = append(coords, ring[0].Orig())
+		switch area := signedArea(coords); {
+		case area > 0:
+			holes = append(holes, splitRing(coords)...)
+		case area < 0 && len(splitRing(coords)) == 1:
+			shells = append(shells, coords)
+		case area < 0 && !p.checkRingsValid:
+			for _, loop := range splitRing(coords) {
+				if signedArea(loop) < 0 {
+					shells = append(shells, loop)
+				} else {
+					holes = append(holes, loop)
+				}
+			}
+		default:
+			p.invalidRingLines = append(p.invalidRingLines, coords)
+		}
+	}
+
+	polygons := make([]matrix.PolygonMatrix, len(shells))
+	envs := make([]*envelope.Envelope, len(shells))
+	for i, shell := range shells {
+		polygons[i] = matrix.PolygonMatrix{reverse(shell)}
+		envs[i] = envelopeOf(shell)
+	}
+	for _, hole := range holes {
+		env := envelopeOf(hole)
+		best := -1
+		for i, shell := range shells {
+			if !envs[i].Covers(env) || !isInShell(hole, shell) {
+				continue
+			}
+			if best < 0 || math.Abs(signedArea(shell)) < math.Abs(signedArea(shells[best])) {
+				best = i
+			}
+		}
+		if best >= 0 {
+			polygons[best] = append(polygons[best], reverse(hole))
+		}
+	}
+	for _, polygon := range polygons {
+		p.polygons = append(p.polygons, polygon)
+	}
+}
+
+// rings returns the rings of the edges left, each edge is followed by the next edge counter-clockwise
+// around its destination, so the face of the ring is on the right of its edges.
+func (p *Polygonizer) rings() [][]*edgegraph.HalfEdge {
+	rings := [][]*edgegraph.HalfEdge{}
+	visited := map[*edgegraph.HalfEdge]bool{}
+	for _, e := range p.graph.Edges() {
+		if p.removed[e] || visited[e] {
+			continue
+		}
+		ring := []*edgegraph.HalfEdge{}
+		for next := e; !visited[next]; next = p.next(next) {
+			visited[next] = true
+			ring = append(ring, next)
+		}
+		rings = append(rings, ring)
+	}
+	return rings
+}
+
+// next returns the edge left following e counter-clockwise around its destination.
+func (p *Polygonizer) next(e *edgegraph.HalfEdge) *edgegraph.HalfEdge {
+	next := e.Sym().ONext()
+	for p.removed[next] && next != e.Sym() {
+		next = next.ONext()
+	}
+	return next
+}
+
+// degree returns the number of edges left at the origin of e.
+func (p *Polygonizer) degree(e *edgegraph.HalfEdge) int {
+	degree := 0
+	next := e
+	for {
+		if !p.removed[next] {
+			degree++
+		}
+		if next = next.ONext(); next == e {
+			return degree
+		}
+	}
+}
+
+// chains returns the edges as lines, the edges of the same input line are merged at vertices of degree 2.
+func (p *Polygonizer) chains(edges []*edgegraph.HalfEdge) matrix.Collection {
+	inChains := map[*edgegraph.HalfEdge]bool{}
+	for _, e := range edges {
+		inChains[e], inChains[e.Sym()] = true, true
+	}
+	// follows returns the edge after e in its chain, nil at the end of the chain.
+	follows := func(e *edgegraph.HalfEdge) *edgegraph.HalfEdge {
+		if e.Sym().Degree() != 2 {
+			return nil
+		}
+		next := e.Sym().ONext()
+		if !inChains[next] || p.lineOf[next] != p.lineOf[e] {
+			return nil
+		}
+		return next
+	}
+	lines := matrix.Collection{}
+	used := map[*edgegraph.HalfEdge]bool{}
+	for _, e := range edges {
+		if used[e] {
+			continue
+		}
+		start := e
+		for prev := follows(start.Sym()); prev != nil && prev.Sym() != e; prev = follows(start.Sym()) {
+			start = prev.Sym()
+		}
+		line := matrix.LineMatrix{start.Orig()}
+		for next := start; next != nil && !used[next]; next = follows(next) {
+			used[next], used[next.Sym()] = true, true
+			line = append(line, next.Dest())
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// isInShell returns true if a vertex of the hole which is not a vertex of the shell is inside the shell.
+func isInShell(hole, shell matrix.LineMatrix) bool {
+	vertices := map[[2]float64]bool{}
+	for _, v := range shell {
+		vertices[[2]float64{v[0], v[1]}] = true
+	}
+	for _, v := range hole {
+		if !vertices[[2]float64{v[0], v[1]}] {
+			return relate.InPolygon(v, shell)
+		}
+	}
+	return false
+}
+
+// splitRing returns the simple rings of a ring which touches itself, split at its repeated vertices,
+// or the ring if it does not.
+func splitRing(ring matrix.LineMatrix) []matrix.LineMatrix {
+	rings := []matrix.LineMatrix{}
+	stack := matrix.LineMatrix{}
+	index := map[[2]float64]int{}
+	for _, v := range ring[:len(ring)-1] {
+		key := [2]float64{v[0], v[1]}
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(stack)
+			stack = append(stack, v)
+			continue
+		}
+		loop := append(matrix.LineMatrix{}, stack[i:]...)
+		rings = append(rings, append(loop, v))
+		for _, w := range stack[i+1:] {
+			delete(index, [2]float64{w[0], w[1]})
+		}
+		stack = stack[:i+1]
+	}
+	if len(rings) == 0 {
+		return []matrix.LineMatrix{ring}
+	}
+	if len(stack) > 2 {
+		rings = append(rings, append(stack, stack[0]))
+	}
+	return rings
+}
+
+// linesOf returns the lines and polygon rings of the geometry without repeated points.
+func linesOf(geom matrix.Steric) []matrix.LineMatrix {
+	lines := []matrix.LineMatrix{}
+	add := func(line matrix.LineMatrix) {
+		points := matrix.LineMatrix{}
+		for _, v := range line {
+			if len(points) == 0 || !matrix.Matrix(points[len(points)-1]).Equals(matrix.Matrix(v)) {
+				points = append(points, v)
+			}
+		}
+		if len(points) > 1 {
+			lines = append(lines, points)
+		}
+	}
+	switch st := geom.(type) {
+	case matrix.LineMatrix:
+		add(st)
+	case matrix.PolygonMatrix:
+		for _, v := range st {
+			add(v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range st {
+			lines = append(lines, linesOf(matrix.PolygonMatrix(v))...)
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			lines = append(lines, linesOf(v)...)
+		}
+	}
+	return lines
+}
+
+// signedArea returns the area of the ring, positive if it is counter-clockwise.
+func signedArea(ring matrix.LineMatrix) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	return area / 2
+}
+
+// envelopeOf returns the envelope of the line.
+func envelopeOf(line matrix.LineMatrix) *envelope.Envelope {
+	env := envelope.Empty()
+	for _, v := range line {
+		env.ExpandToIncludeMatrix(v)
+	}
+	return env
+}
+
+// reverse returns a copy of the line in reverse order.
+func reverse(line matrix.LineMatrix) matrix.LineMatrix {
+	reversed := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		reversed[len(line)-1-i] = v
+	}
+	return reversed
+}
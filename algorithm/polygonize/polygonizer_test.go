@@ -0,0 +1,87 @@
+package polygonize
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestPolygonize(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name                                        string
+		geom                                        matrix.Steric
+		polygons, holes, dangles, cutEdges, invalid int
+		area                                        float64
+	}{
+		{"ring", square, 1, 0, 0, 0, 0, 100},
+		{"crossing lines", matrix.Collection{square,
+			matrix.LineMatrix{{0, 5}, {10, 5}}, matrix.LineMatrix{{5, 0}, {5, 10}}}, 4, 0, 0, 0, 0, 100},
+		{"unnoded lines", matrix.Collection{matrix.LineMatrix{{-1, 0}, {11, 0}}, matrix.LineMatrix{{10, -1}, {10, 11}},
+			matrix.LineMatrix{{11, 10}, {-1, 10}}, matrix.LineMatrix{{0, 11}, {0, -1}}}, 1, 0, 8, 0, 0, 100},
+		{"hole", matrix.Collection{square,
+			matrix.LineMatrix{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}}, 2, 1, 0, 0, 0, 100},
+		{"dangles and cut edge", matrix.Collection{square,
+			matrix.LineMatrix{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}, matrix.LineMatrix{{5, -5}, {5, 5}}}, 2, 1, 2, 1, 0, 100},
+		{"cut edge", matrix.Collection{matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+			matrix.LineMatrix{{4, 2}, {10, 2}, {10, 6}, {6, 6}, {6, 2}}}, 2, 0, 0, 1, 0, 32},
+		{"touching holes", matrix.Collection{matrix.LineMatrix{{-2, -2}, {10, -2}, {10, 10}, {-2, 10}, {-2, -2}},
+			matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}, matrix.LineMatrix{{4, 4}, {8, 4}, {8, 8}, {4, 8}, {4, 4}}},
+			3, 2, 0, 0, 0, 144},
+		{"invalid ring", matrix.Collection{square,
+			matrix.LineMatrix{{0, 0}, {5, 2}, {2, 5}, {0, 0}}}, 1, 0, 0, 0, 1, 10.5},
+		{"polygons", matrix.MultiPolygonMatrix{{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}, 3, 0, 0, 0, 0, 175},
+		{"line", matrix.LineMatrix{{0, 0}, {10, 0}}, 0, 0, 1, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Polygonize(tt.geom)
+			if len(p.Polygons()) != tt.polygons || len(p.Dangles()) != tt.dangles ||
+				len(p.CutEdges()) != tt.cutEdges || len(p.InvalidRingLines()) != tt.invalid {
+				t.Fatalf("Polygonize() = %v, dangles %v, cut edges %v, invalid ring lines %v",
+					p.Polygons(), p.Dangles(), p.CutEdges(), p.InvalidRingLines())
+			}
+			area, holes := 0.0, 0
+			for _, v := range p.Polygons() {
+				polygon := v.(matrix.PolygonMatrix)
+				if signedArea(polygon[0]) <= 0 {
+					t.Errorf("Polygonize() shell %v is not counter-clockwise", polygon[0])
+				}
+				area += measure.AreaOfPolygon(polygon)
+				holes += len(polygon) - 1
+			}
+			if holes != tt.holes || math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("Polygonize() area %v with %v holes, want %v with %v holes", area, holes, tt.area, tt.holes)
+			}
+		})
+	}
+}
+
+func TestPolygonizeFaces(t *testing.T) {
+	geom := matrix.Collection{matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		matrix.LineMatrix{{0, 0}, {5, 2}, {2, 5}, {0, 0}}}
+	p := PolygonizeFaces(geom)
+	if len(p.Polygons()) != 2 || len(p.InvalidRingLines()) != 0 {
+		t.Fatalf("PolygonizeFaces() = %v, invalid ring lines %v", p.Polygons(), p.InvalidRingLines())
+	}
+	if polygon := p.Polygons()[0].(matrix.PolygonMatrix); len(polygon) != 2 || measure.AreaOfPolygon(polygon) != 89.5 {
+		t.Errorf("PolygonizeFaces() = %v, want a polygon of area 89.5 with a hole", polygon)
+	}
+}
+
+func TestSplitRing(t *testing.T) {
+	ring := matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}, {8, 4}, {8, 8}, {4, 8}, {4, 4}, {0, 4}, {0, 0}}
+	want := []matrix.LineMatrix{{{4, 4}, {8, 4}, {8, 8}, {4, 8}, {4, 4}}, {{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+	got := splitRing(ring)
+	if len(got) != len(want) {
+		t.Fatalf("splitRing() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Equals(want[i]) {
+			t.Errorf("splitRing() = %v, want %v", got, want)
+		}
+	}
+}
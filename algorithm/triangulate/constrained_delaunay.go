@@ -0,0 +1,330 @@
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// maxConformingPasses the passes of splitting segments which are not Delaunay edges,
+// the segments left are inserted as constraints.
+const maxConformingPasses = 16
+
+// ConstrainedDelaunayBuilder builds the Delaunay triangulation of the vertices of a geometry
+// constrained by the segments of its lines and polygon rings, which are edges of the triangulation.
+// Crossing segments are split at their intersections.
+// If the geometry has polygons, only the triangles inside the polygons are returned.
+type ConstrainedDelaunayBuilder struct {
+	index      *siteIndex
+	segments   [][2]int
+	polygons   []matrix.PolygonMatrix
+	conforming bool
+	mesh       *mesh
+}
+
+// ConstrainedDelaunayWithGeom creates a builder of the constrained Delaunay triangulation of geometry,
+// the triangles have no vertex other than the vertices of geometry and the intersections of segments.
+// Vertices closer than tolerance are merged.
+func ConstrainedDelaunayWithGeom(geom matrix.Steric, tolerance float64) *ConstrainedDelaunayBuilder {
+	c := &ConstrainedDelaunayBuilder{index: newSiteIndex(tolerance)}
+	c.addSteric(geom)
+	c.nodeSegments()
+	return c
+}
+
+// ConformingDelaunayWithGeom creates a builder of the conforming Delaunay triangulation of geometry,
+// the segments are split by Steiner points at their midpoints until they are Delaunay edges,
+// so all triangles are Delaunay triangles of the vertices.
+// Vertices closer than tolerance are merged.
+func ConformingDelaunayWithGeom(geom matrix.Steric, tolerance float64) *ConstrainedDelaunayBuilder {
+	c := ConstrainedDelaunayWithGeom(geom, tolerance)
+	c.conforming = true
+	return c
+}
+
+// Sites returns the vertices of the triangulation, including the Steiner points after triangulating.
+func (c *ConstrainedDelaunayBuilder) Sites() matrix.LineMatrix {
+	return c.index.sites
+}
+
+// Triangles returns the triangles of the triangulation as a collection of counter-clockwise PolygonMatrix.
+func (c *ConstrainedDelaunayBuilder) Triangles() matrix.Collection {
+	c.triangulate()
+	coll := matrix.Collection{}
+	for i, t := range c.mesh.triangles {
+		if !c.mesh.live[i] {
+			continue
+		}
+		a, b, p := c.mesh.vertices[t[0]], c.mesh.vertices[t[1]], c.mesh.vertices[t[2]]
+		if cross(a, b, p) <= 0 || !c.isInside(matrix.Matrix{(a[0] + b[0] + p[0]) / 3, (a[1] + b[1] + p[1]) / 3}) {
+			continue
+		}
+		coll = append(coll, matrix.PolygonMatrix{{a, b, p, a}})
+	}
+	return coll
+}
+
+// isInside returns true if the geometry has no polygon or the point is inside a polygon.
+func (c *ConstrainedDelaunayBuilder) isInside(p matrix.Matrix) bool {
+	if len(c.polygons) == 0 {
+		return true
+	}
+	for _, polygon := range c.polygons {
+		if !relate.InPolygon(p, polygon[0]) {
+			continue
+		}
+		inHole := false
+		for _, hole := range polygon[1:] {
+			if relate.InPolygon(p, hole) {
+				inHole = true
+				break
+			}
+		}
+		if !inHole {
+			return true
+		}
+	}
+	return false
+}
+
+// addSteric adds the vertices and segments of the geometry.
+func (c *ConstrainedDelaunayBuilder) addSteric(geom matrix.Steric) {
+	switch st := geom.(type) {
+	case matrix.Matrix:
+		c.index.add(st)
+	case matrix.LineMatrix:
+		c.addLine(st)
+	case matrix.PolygonMatrix:
+		if len(st) == 0 {
+			return
+		}
+		for _, ring := range st {
+			c.addLine(ring)
+		}
+		c.polygons = append(c.polygons, st)
+	case matrix.Collection:
+		for _, v := range st {
+			c.addSteric(v)
+		}
+	}
+}
+
+func (c *ConstrainedDelaunayBuilder) addLine(line matrix.LineMatrix) {
+	for i, v := range line {
+		j := c.index.add(v)
+		if i > 0 {
+			c.segments = append(c.segments, [2]int{c.index.add(line[i-1]), j})
+		}
+	}
+}
+
+// nodeSegments splits the crossing segments at their intersections and removes repeated segments.
+func (c *ConstrainedDelaunayBuilder) nodeSegments() {
+	sites := func() matrix.LineMatrix { return c.index.sites }
+	minX := func(s [2]int) float64 { return math.Min(sites()[s[0]][0], sites()[s[1]][0]) }
+	maxX := func(s [2]int) float64 { return math.Max(sites()[s[0]][0], sites()[s[1]][0]) }
+	segments := append([][2]int{}, c.segments...)
+	sort.SliceStable(segments, func(i, j int) bool { return minX(segments[i]) < minX(segments[j]) })
+
+	splits := make([][]int, len(segments))
+	for i, s := range segments {
+		for j := i + 1; j < len(segments) && minX(segments[j]) <= maxX(s); j++ {
+			o := segments[j]
+			a, b, p, q := sites()[s[0]], sites()[s[1]], sites()[o[0]], sites()[o[1]]
+			if !properlyCrosses(a, b, p, q) {
+				continue
+			}
+			d := cross(a, b, p) / (cross(a, b, p) - cross(a, b, q))
+			k := c.index.add(matrix.Matrix{p[0] + d*(q[0]-p[0]), p[1] + d*(q[1]-p[1])})
+			splits[i], splits[j] = append(splits[i], k), append(splits[j], k)
+		}
+	}
+
+	c.segments = [][2]int{}
+	visited := map[[2]int]bool{}
+	for i, s := range segments {
+		from := sites()[s[0]]
+		points := append([]int{s[0]}, splits[i]...)
+		sort.SliceStable(points[1:], func(j, k int) bool {
+			p, q := sites()[points[1+j]], sites()[points[1+k]]
+			return math.Hypot(p[0]-from[0], p[1]-from[1]) < math.Hypot(q[0]-from[0], q[1]-from[1])
+		})
+		points = append(points, s[1])
+		for j := 1; j < len(points); j++ {
+			a, b := points[j-1], points[j]
+			if a > b {
+				a, b = b, a
+			}
+			if a == b || visited[[2]int{a, b}] {
+				continue
+			}
+			visited[[2]int{a, b}] = true
+			c.segments = append(c.segments, [2]int{a, b})
+		}
+	}
+}
+
+// triangulate builds the mesh of the Delaunay triangulation and inserts the segments.
+func (c *ConstrainedDelaunayBuilder) triangulate() {
+	if c.mesh != nil {
+		return
+	}
+	segments := c.segments
+	for pass := 0; ; pass++ {
+		d := &DelaunayBuilder{sites: c.index.sites}
+		c.mesh = newMesh(d.sites, d.siteTriangles())
+		if !c.conforming || pass == maxConformingPasses {
+			break
+		}
+		// the segments which are not edges are split at their midpoints.
+		split, missing := [][2]int{}, false
+		for _, s := range segments {
+			if c.mesh.hasEdge(s[0], s[1]) {
+				split = append(split, s)
+				continue
+			}
+			a, b := c.index.sites[s[0]], c.index.sites[s[1]]
+			k := c.index.add(matrix.Matrix{(a[0] + b[0]) / 2, (a[1] + b[1]) / 2})
+			if k == s[0] || k == s[1] {
+				split = append(split, s)
+				continue
+			}
+			split, missing = append(split, [2]int{s[0], k}, [2]int{k, s[1]}), true
+		}
+		segments = split
+		if !missing {
+			break
+		}
+	}
+	for _, s := range segments {
+		c.mesh.insertSegment(s[0], s[1])
+	}
+}
+
+// mesh a triangulation of vertices with the triangles of each directed edge.
+type mesh struct {
+	vertices  matrix.LineMatrix
+	triangles [][3]int
+	live      []bool
+	edges     map[[2]int]int
+}
+
+func newMesh(vertices matrix.LineMatrix, triangles []triangle) *mesh {
+	m := &mesh{vertices: vertices, edges: map[[2]int]int{}}
+	for _, t := range triangles {
+		m.add(t.v[0], t.v[1], t.v[2])
+	}
+	return m
+}
+
+// add adds the triangle in counter-clockwise order.
+func (m *mesh) add(a, b, c int) {
+	if cross(m.vertices[a], m.vertices[b], m.vertices[c]) < 0 {
+		b, c = c, b
+	}
+	i := len(m.triangles)
+	m.triangles, m.live = append(m.triangles, [3]int{a, b, c}), append(m.live, true)
+	m.edges[[2]int{a, b}], m.edges[[2]int{b, c}], m.edges[[2]int{c, a}] = i, i, i
+}
+
+func (m *mesh) remove(i int) {
+	t := m.triangles[i]
+	m.live[i] = false
+	for k := 0; k < 3; k++ {
+		if m.edges[[2]int{t[k], t[(k+1)%3]}] == i {
+			delete(m.edges, [2]int{t[k], t[(k+1)%3]})
+		}
+	}
+}
+
+func (m *mesh) hasEdge(a, b int) bool {
+	_, ok := m.edges[[2]int{a, b}]
+	_, reverse := m.edges[[2]int{b, a}]
+	return ok || reverse
+}
+
+// insertSegment makes the segment an edge, the triangles crossed by it are removed
+// and the cavities on both sides of it are triangulated again.
+func (m *mesh) insertSegment(a, b int) {
+	if a == b || m.hasEdge(a, b) {
+		return
+	}
+	pa, pb := m.vertices[a], m.vertices[b]
+	// a vertex on the segment splits it.
+	for v, p := range m.vertices {
+		if v != a && v != b && cross(pa, pb, p) == 0 &&
+			(p[0]-pa[0])*(pb[0]-pa[0])+(p[1]-pa[1])*(pb[1]-pa[1]) > 0 &&
+			(p[0]-pb[0])*(pa[0]-pb[0])+(p[1]-pb[1])*(pa[1]-pb[1]) > 0 {
+			m.insertSegment(a, v)
+			m.insertSegment(v, b)
+			return
+		}
+	}
+	// the walk from a to b removes the crossed triangles, the vertices on the right and on the left
+	// of the segment are the chains of the cavities on both sides of it.
+	u, v := -1, -1
+search:
+	for i, t := range m.triangles {
+		for k := 0; k < 3 && m.live[i]; k++ {
+			if t[k] == a && cross(pa, pb, m.vertices[t[(k+1)%3]]) < 0 && cross(pa, pb, m.vertices[t[(k+2)%3]]) > 0 {
+				u, v = t[(k+1)%3], t[(k+2)%3]
+				m.remove(i)
+				break search
+			}
+		}
+	}
+	if u < 0 {
+		return
+	}
+	right, left := []int{u}, []int{v}
+	for {
+		i, ok := m.edges[[2]int{v, u}]
+		if !ok {
+			return
+		}
+		t := m.triangles[i]
+		w := t[0] + t[1] + t[2] - u - v
+		m.remove(i)
+		if w == b {
+			break
+		}
+		if cross(pa, pb, m.vertices[w]) > 0 {
+			v, left = w, append(left, w)
+		} else {
+			u, right = w, append(right, w)
+		}
+	}
+	m.fillCavity(a, b, right)
+	m.fillCavity(a, b, left)
+}
+
+// fillCavity triangulates the cavity of the edge ab and the chain of vertices from a to b,
+// the vertex whose circle with ab contains no other vertex of the chain forms the triangle of ab.
+func (m *mesh) fillCavity(a, b int, chain []int) {
+	if len(chain) == 0 {
+		return
+	}
+	pa, pb := m.vertices[a], m.vertices[b]
+	c := 0
+	for i := 1; i < len(chain); i++ {
+		p, q := pa, pb
+		if cross(p, q, m.vertices[chain[c]]) < 0 {
+			p, q = q, p
+		}
+		if inCircle(p, q, m.vertices[chain[c]], m.vertices[chain[i]]) {
+			c = i
+		}
+	}
+	m.add(a, b, chain[c])
+	m.fillCavity(a, chain[c], chain[:c])
+	m.fillCavity(chain[c], b, chain[c+1:])
+}
+
+// properlyCrosses returns true if the segments ab and pq cross at a point interior to both.
+func properlyCrosses(a, b, p, q matrix.Matrix) bool {
+	o1, o2 := cross(a, b, p), cross(a, b, q)
+	o3, o4 := cross(p, q, a), cross(p, q, b)
+	return ((o1 > 0 && o2 < 0) || (o1 < 0 && o2 > 0)) && ((o3 > 0 && o4 < 0) || (o3 < 0 && o4 > 0))
+}
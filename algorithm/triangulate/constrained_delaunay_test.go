@@ -0,0 +1,100 @@
+package triangulate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestConstrainedDelaunayBuilder(t *testing.T) {
+	tests := []struct {
+		name      string
+		geom      matrix.Steric
+		sites     int
+		triangles int
+		area      float64
+	}{
+		{name: "square with holes", geom: squareWithHoles, sites: 12, triangles: 14, area: 92},
+		{name: "comb", geom: comb, sites: 20, triangles: 18, area: 29},
+		{name: "star", geom: star(), sites: 10, triangles: 8, area: 10 * 4 * math.Sin(math.Pi/5) * 5},
+		{name: "crossing lines", geom: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 10}}, matrix.LineMatrix{{0, 10}, {10, 0}}, matrix.LineMatrix{{5, 0}, {5, 10}},
+		}, sites: 7, triangles: 6, area: 100},
+		{name: "constraint", geom: matrix.Collection{matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.Matrix{5, 1}, matrix.Matrix{5, -1}},
+			sites: 4, triangles: 2, area: 10},
+		{name: "empty", geom: matrix.Collection{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ConstrainedDelaunayWithGeom(tt.geom, 0)
+			triangles := c.Triangles()
+			if len(c.Sites()) != tt.sites {
+				t.Errorf("Sites() = %v, want %v sites", c.Sites(), tt.sites)
+			}
+			if len(triangles) != tt.triangles {
+				t.Errorf("Triangles() = %v, want %v triangles", triangles, tt.triangles)
+			}
+			if area := triangleArea(triangles); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("Triangles() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestConformingDelaunayBuilder(t *testing.T) {
+	tests := []struct {
+		name  string
+		geom  matrix.Steric
+		sites int
+		area  float64
+	}{
+		{name: "square with holes", geom: squareWithHoles, area: 92},
+		{name: "comb", geom: comb, area: 29},
+		{name: "constraint", geom: matrix.Collection{matrix.LineMatrix{{0, 0}, {10, 0}}, matrix.Matrix{5, 1}, matrix.Matrix{5, -1}},
+			sites: 5, area: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ConformingDelaunayWithGeom(tt.geom, 0)
+			triangles := c.Triangles()
+			if tt.sites > 0 && len(c.Sites()) != tt.sites {
+				t.Errorf("Sites() = %v, want %v sites", c.Sites(), tt.sites)
+			}
+			if area := triangleArea(triangles); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("Triangles() area = %v, want %v", area, tt.area)
+			}
+			for _, v := range triangles {
+				ring := v.(matrix.PolygonMatrix)[0]
+				for _, p := range c.Sites() {
+					if inCircle(ring[0], ring[1], ring[2], p) {
+						t.Fatalf("Triangles() %v circumcircle contains %v", ring, p)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestConstrainedDelaunayBuilder_HullArea(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		for _, size := range [][2]float64{{100, 1}, {100, 100}} {
+			sites := randomSites(seed, 60, size[0], size[1])
+			// the first sites are joined by a few constraints, the others are points
+			geom := matrix.Collection{matrix.LineMatrix{sites[0], sites[1], sites[2]},
+				matrix.LineMatrix{sites[3], sites[4]}, matrix.LineMatrix{sites[5], sites[6], sites[7]}}
+			for _, v := range sites[8:] {
+				geom = append(geom, matrix.Matrix(v))
+			}
+			want := hullArea(sites)
+			for name, c := range map[string]*ConstrainedDelaunayBuilder{
+				"constrained": ConstrainedDelaunayWithGeom(geom, 0),
+				"conforming":  ConformingDelaunayWithGeom(geom, 0),
+			} {
+				if area := triangleArea(c.Triangles()); math.Abs(area-want) > 1e-9*want {
+					t.Errorf("%v Triangles() seed %v %v area = %v, want hull area %v", name, seed, size, area, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,376 @@
+// Package triangulate define the Delaunay triangulation and the Voronoi diagram of sites.
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// inCircleErrorBound the relative error bound of the in circle determinant in double precision.
+const inCircleErrorBound = 1.1102230246251577e-15
+
+// triangle a triangle of vertex indices in counter-clockwise order and its circumcircle,
+// the center is nil if the triangle is degenerate or a ghost triangle.
+// A ghost triangle joins an edge of the convex hull to the vertex at infinity, whose index is the number of sites,
+// its circumcircle is the open half plane outside the edge.
+type triangle struct {
+	v       [3]int
+	center  matrix.Matrix
+	radius2 float64
+}
+
+// DelaunayBuilder builds the Delaunay triangulation of the sites of a geometry,
+// the sites are the unique vertices of the geometry, sites closer than tolerance are merged.
+type DelaunayBuilder struct {
+	sites     matrix.LineMatrix
+	tolerance float64
+	triangles []triangle
+}
+
+// DelaunayWithGeom creates a builder of the Delaunay triangulation of the vertices of geometry.
+func DelaunayWithGeom(geom matrix.Steric, tolerance float64) *DelaunayBuilder {
+	return &DelaunayBuilder{sites: extractSites(geom, tolerance), tolerance: tolerance}
+}
+
+// Sites returns the sites of the triangulation.
+func (d *DelaunayBuilder) Sites() matrix.LineMatrix {
+	return d.sites
+}
+
+// Triangles returns the triangles of the triangulation as a collection of PolygonMatrix,
+// it is empty if there are fewer than three sites or all sites are collinear.
+func (d *DelaunayBuilder) Triangles() matrix.Collection {
+	coll := matrix.Collection{}
+	for _, t := range d.siteTriangles() {
+		a, b, c := d.sites[t.v[0]], d.sites[t.v[1]], d.sites[t.v[2]]
+		coll = append(coll, matrix.PolygonMatrix{{a, b, c, a}})
+	}
+	return coll
+}
+
+// siteTriangles returns the triangles of the triangulation which are not ghost triangles.
+func (d *DelaunayBuilder) siteTriangles() []triangle {
+	d.triangulate()
+	triangles := []triangle{}
+	for _, t := range d.triangles {
+		if d.isSiteTriangle(t) && t.center != nil {
+			triangles = append(triangles, t)
+		}
+	}
+	return triangles
+}
+
+// Edges returns the unique edges of the triangulation as a collection of LineMatrix,
+// the sites of collinear sites are connected in order.
+func (d *DelaunayBuilder) Edges() matrix.Collection {
+	d.triangulate()
+	n := len(d.sites)
+	type edge struct{ from, to int }
+	visited := map[edge]bool{}
+	coll := matrix.Collection{}
+	for _, t := range d.triangles {
+		for k := 0; k < 3; k++ {
+			e := edge{t.v[k], t.v[(k+1)%3]}
+			if e.from >= n || e.to >= n {
+				continue
+			}
+			if e.from > e.to {
+				e.from, e.to = e.to, e.from
+			}
+			if visited[e] {
+				continue
+			}
+			visited[e] = true
+			coll = append(coll, matrix.LineMatrix{d.sites[e.from], d.sites[e.to]})
+		}
+	}
+	return coll
+}
+
+// isSiteTriangle returns true if no vertex of the triangle is the vertex at infinity.
+func (d *DelaunayBuilder) isSiteTriangle(t triangle) bool {
+	n := len(d.sites)
+	return t.v[0] < n && t.v[1] < n && t.v[2] < n
+}
+
+// triangulate inserts the sites by the Bowyer-Watson algorithm in order of x,
+// each site is outside the convex hull of the sites before it, so its cavity has ghost triangles.
+// The sites before the first site off their line are joined by pairs of ghost triangles.
+// A triangle whose circumcircle lies left of the inserted site is complete.
+func (d *DelaunayBuilder) triangulate() {
+	if d.triangles != nil {
+		return
+	}
+	n := len(d.sites)
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if d.sites[order[i]][0] == d.sites[order[j]][0] {
+			return d.sites[order[i]][1] < d.sites[order[j]][1]
+		}
+		return d.sites[order[i]][0] < d.sites[order[j]][0]
+	})
+
+	complete, open := []triangle{}, []triangle{}
+	for k, i := range order {
+		if k == 0 {
+			continue
+		}
+		p := d.sites[i]
+		cavity, kept := []triangle{}, []triangle{}
+		for _, t := range open {
+			if t.center != nil {
+				if dx := p[0] - t.center[0]; dx > 0 && dx*dx > t.radius2*(1+1e-10) {
+					complete = append(complete, t)
+					continue
+				}
+			}
+			if d.inCircumcircle(t, p) {
+				cavity = append(cavity, t)
+			} else {
+				kept = append(kept, t)
+			}
+		}
+		if len(cavity) == 0 {
+			// the site is on the line of the sites before it, beyond the last one.
+			prev := order[k-1]
+			open = append(kept, d.newTriangle(prev, i, n), d.newTriangle(i, prev, n))
+			continue
+		}
+		cavity, kept = d.starShaped(cavity, kept, p)
+		open = kept
+		// the boundary of the cavity is the edges not shared by two removed triangles.
+		for _, e := range boundaryEdges(cavity) {
+			open = append(open, d.newTriangle(e[0], e[1], i))
+		}
+	}
+	d.triangles = append(complete, open...)
+	sort.SliceStable(d.triangles, func(i, j int) bool {
+		a, b := d.triangles[i].v, d.triangles[j].v
+		for k := 0; k < 3; k++ {
+			if a[k] != b[k] {
+				return a[k] < b[k]
+			}
+		}
+		return false
+	})
+}
+
+// inCircumcircle returns true if the point is strictly inside the circumcircle of the triangle,
+// for a ghost triangle if it is strictly outside the hull edge.
+func (d *DelaunayBuilder) inCircumcircle(t triangle, p matrix.Matrix) bool {
+	n := len(d.sites)
+	for k := 0; k < 3; k++ {
+		if t.v[k] == n {
+			return cross(d.sites[t.v[(k+1)%3]], d.sites[t.v[(k+2)%3]], p) > 0
+		}
+	}
+	return inCircle(d.sites[t.v[0]], d.sites[t.v[1]], d.sites[t.v[2]], p)
+}
+
+// starShaped keeps the triangles of the cavity which have a boundary edge not facing the point,
+// so that the cavity is star-shaped from the point though the circumcircle tests are rounded.
+func (d *DelaunayBuilder) starShaped(cavity, kept []triangle, p matrix.Matrix) ([]triangle, []triangle) {
+	for changed := true; changed; {
+		changed = false
+		edges := map[[2]int]bool{}
+		for _, t := range cavity {
+			for k := 0; k < 3; k++ {
+				edges[[2]int{t.v[k], t.v[(k+1)%3]}] = true
+			}
+		}
+		shrunk := cavity[:0]
+		n := len(d.sites)
+		for _, t := range cavity {
+			facing := true
+			for k := 0; k < 3 && facing; k++ {
+				a, b := t.v[k], t.v[(k+1)%3]
+				facing = edges[[2]int{b, a}] || a == n || b == n || cross(d.sites[a], d.sites[b], p) > 0
+			}
+			if facing {
+				shrunk = append(shrunk, t)
+			} else {
+				kept, changed = append(kept, t), true
+			}
+		}
+		cavity = shrunk
+	}
+	return cavity, kept
+}
+
+// boundaryEdges returns the directed edges of the triangles not shared by two of them.
+func boundaryEdges(triangles []triangle) [][2]int {
+	edges := map[[2]int]bool{}
+	for _, t := range triangles {
+		for k := 0; k < 3; k++ {
+			edges[[2]int{t.v[k], t.v[(k+1)%3]}] = true
+		}
+	}
+	boundary := [][2]int{}
+	for _, t := range triangles {
+		for k := 0; k < 3; k++ {
+			if e := [2]int{t.v[k], t.v[(k+1)%3]}; !edges[[2]int{e[1], e[0]}] {
+				boundary = append(boundary, e)
+			}
+		}
+	}
+	return boundary
+}
+
+// newTriangle returns the triangle of the vertices in counter-clockwise order with its circumcircle,
+// the vertices of a ghost triangle are kept in order.
+func (d *DelaunayBuilder) newTriangle(a, b, c int) triangle {
+	if n := len(d.sites); a == n || b == n || c == n {
+		return triangle{v: rotateMin([3]int{a, b, c}), radius2: math.Inf(1)}
+	}
+	pa, pb, pc := d.sites[a], d.sites[b], d.sites[c]
+	o := cross(pa, pb, pc)
+	if o < 0 {
+		b, c = c, b
+		pb, pc = pc, pb
+	}
+	t := triangle{v: rotateMin([3]int{a, b, c})}
+	if o == 0 {
+		t.radius2 = math.Inf(1)
+		return t
+	}
+	bx, by := pb[0]-pa[0], pb[1]-pa[1]
+	cx, cy := pc[0]-pa[0], pc[1]-pa[1]
+	det := 2 * (bx*cy - by*cx)
+	if det == 0 {
+		t.radius2 = math.Inf(1)
+		return t
+	}
+	b2, c2 := bx*bx+by*by, cx*cx+cy*cy
+	ux, uy := (cy*b2-by*c2)/det, (bx*c2-cx*b2)/det
+	t.center = matrix.Matrix{pa[0] + ux, pa[1] + uy}
+	t.radius2 = ux*ux + uy*uy
+	return t
+}
+
+// inCircle returns true if the point is strictly inside the circumcircle of the counter-clockwise triangle abc,
+// the determinant is computed in double-double precision when its sign is not certain in double precision.
+func inCircle(a, b, c, p matrix.Matrix) bool {
+	adx, ady := a[0]-p[0], a[1]-p[1]
+	bdx, bdy := b[0]-p[0], b[1]-p[1]
+	cdx, cdy := c[0]-p[0], c[1]-p[1]
+	alift, blift, clift := adx*adx+ady*ady, bdx*bdx+bdy*bdy, cdx*cdx+cdy*cdy
+	det := alift*(bdx*cdy-cdx*bdy) + blift*(cdx*ady-adx*cdy) + clift*(adx*bdy-bdx*ady)
+	permanent := (math.Abs(bdx*cdy)+math.Abs(cdx*bdy))*alift +
+		(math.Abs(cdx*ady)+math.Abs(adx*cdy))*blift +
+		(math.Abs(adx*bdy)+math.Abs(bdx*ady))*clift
+	if math.Abs(det) > inCircleErrorBound*permanent {
+		return det > 0
+	}
+	return inCirclePair(a, b, c, p)
+}
+
+// inCirclePair returns true if the point is strictly inside the circumcircle of the counter-clockwise triangle abc,
+// computed in double-double precision.
+func inCirclePair(a, b, c, p matrix.Matrix) bool {
+	diff := func(x, y float64) *calc.PairFloat { return calc.ValueOf(x).SelfAddOne(-y) }
+	lift := func(x, y *calc.PairFloat) *calc.PairFloat { return x.MultiplyPair(x).SelfAddPair(y.MultiplyPair(y)) }
+	adx, ady := diff(a[0], p[0]), diff(a[1], p[1])
+	bdx, bdy := diff(b[0], p[0]), diff(b[1], p[1])
+	cdx, cdy := diff(c[0], p[0]), diff(c[1], p[1])
+	det := lift(adx, ady).SelfMultiplyPair(calc.DeterminantPair(bdx, bdy, cdx, cdy)).
+		SelfAddPair(lift(bdx, bdy).SelfMultiplyPair(calc.DeterminantPair(cdx, cdy, adx, ady))).
+		SelfAddPair(lift(cdx, cdy).SelfMultiplyPair(calc.DeterminantPair(adx, ady, bdx, bdy)))
+	return det.Signum() > 0
+}
+
+// cross returns the cross product of ab and ac, it is positive if abc is counter-clockwise,
+// it is computed in double-double precision when its sign is not certain in double precision.
+func cross(a, b, c matrix.Matrix) float64 {
+	left, right := (b[0]-a[0])*(c[1]-a[1]), (b[1]-a[1])*(c[0]-a[0])
+	if det := left - right; math.Abs(det) > measure.DP_SAFE_EPSILON*(math.Abs(left)+math.Abs(right)) {
+		return det
+	}
+	return calc.DeterminantPair(calc.ValueOf(b[0]).SelfAddOne(-a[0]), calc.ValueOf(b[1]).SelfAddOne(-a[1]),
+		calc.ValueOf(c[0]).SelfAddOne(-a[0]), calc.ValueOf(c[1]).SelfAddOne(-a[1])).Value()
+}
+
+// rotateMin rotates the vertices so that the smallest index is first, keeping the orientation.
+func rotateMin(v [3]int) [3]int {
+	switch {
+	case v[1] < v[0] && v[1] < v[2]:
+		return [3]int{v[1], v[2], v[0]}
+	case v[2] < v[0] && v[2] < v[1]:
+		return [3]int{v[2], v[0], v[1]}
+	}
+	return v
+}
+
+// extractSites returns the unique vertices of the geometry in order,
+// a vertex closer than tolerance to an earlier site is merged into it.
+func extractSites(geom matrix.Steric, tolerance float64) matrix.LineMatrix {
+	index := newSiteIndex(tolerance)
+	if geom != nil && !geom.IsEmpty() {
+		for _, v := range matrix.TransMatrixes(geom) {
+			index.add(v)
+		}
+	}
+	return index.sites
+}
+
+// siteCell a cell of the grid of tolerance hashing the sites.
+type siteCell struct{ x, y int64 }
+
+// siteIndex indexes the sites, a point closer than tolerance to a site is merged into it.
+type siteIndex struct {
+	sites     matrix.LineMatrix
+	tolerance float64
+	exact     map[[2]float64]int
+	grid      map[siteCell][]int
+}
+
+func newSiteIndex(tolerance float64) *siteIndex {
+	return &siteIndex{sites: matrix.LineMatrix{}, tolerance: tolerance,
+		exact: map[[2]float64]int{}, grid: map[siteCell][]int{}}
+}
+
+// add returns the index of the site of the point, the point is a new site if no site is within tolerance.
+func (s *siteIndex) add(p matrix.Matrix) int {
+	if i, ok := s.exact[[2]float64{p[0], p[1]}]; ok {
+		return i
+	}
+	i := s.nearest(p)
+	if i < 0 {
+		i = len(s.sites)
+		s.sites = append(s.sites, matrix.Matrix{p[0], p[1]})
+		if s.tolerance > 0 {
+			c := s.cellOf(p)
+			s.grid[c] = append(s.grid[c], i)
+		}
+	}
+	s.exact[[2]float64{p[0], p[1]}] = i
+	return i
+}
+
+// nearest returns the index of a site within tolerance of the point, or -1.
+func (s *siteIndex) nearest(p matrix.Matrix) int {
+	if s.tolerance <= 0 {
+		return -1
+	}
+	c := s.cellOf(p)
+	for x := c.x - 1; x <= c.x+1; x++ {
+		for y := c.y - 1; y <= c.y+1; y++ {
+			for _, i := range s.grid[siteCell{x, y}] {
+				if math.Hypot(s.sites[i][0]-p[0], s.sites[i][1]-p[1]) <= s.tolerance {
+					return i
+				}
+			}
+		}
+	}
+	return -1
+}
+
+func (s *siteIndex) cellOf(p matrix.Matrix) siteCell {
+	return siteCell{int64(math.Floor(p[0] / s.tolerance)), int64(math.Floor(p[1] / s.tolerance))}
+}
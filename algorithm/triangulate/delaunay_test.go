@@ -0,0 +1,149 @@
+package triangulate
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func grid(n int) matrix.LineMatrix {
+	points := matrix.LineMatrix{}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			points = append(points, matrix.Matrix{float64(i), float64(j)})
+		}
+	}
+	return points
+}
+
+func triangleArea(coll matrix.Collection) float64 {
+	area := 0.0
+	for _, v := range coll {
+		ring := v.(matrix.PolygonMatrix)[0]
+		area += cross(ring[0], ring[1], ring[2]) / 2
+	}
+	return area
+}
+
+// hullArea returns the area of the convex hull of the points by the monotone chain.
+func hullArea(points matrix.LineMatrix) float64 {
+	pts := append(matrix.LineMatrix{}, points...)
+	sort.Slice(pts, func(i, j int) bool {
+		if pts[i][0] != pts[j][0] {
+			return pts[i][0] < pts[j][0]
+		}
+		return pts[i][1] < pts[j][1]
+	})
+	hull := matrix.LineMatrix{}
+	for pass := 0; pass < 2; pass++ {
+		start := len(hull)
+		for _, p := range pts {
+			for len(hull) >= start+2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+				hull = hull[:len(hull)-1]
+			}
+			hull = append(hull, p)
+		}
+		hull = hull[:len(hull)-1]
+		for i, j := 0, len(pts)-1; i < j; i, j = i+1, j-1 {
+			pts[i], pts[j] = pts[j], pts[i]
+		}
+	}
+	area := 0.0
+	for i := range hull {
+		area += cross(hull[0], hull[i], hull[(i+1)%len(hull)]) / 2
+	}
+	return area
+}
+
+// randomSites returns n random sites in the rectangle of width and height.
+func randomSites(seed int64, n int, width, height float64) matrix.LineMatrix {
+	r := rand.New(rand.NewSource(seed))
+	sites := matrix.LineMatrix{}
+	for i := 0; i < n; i++ {
+		sites = append(sites, matrix.Matrix{r.Float64() * width, r.Float64() * height})
+	}
+	return sites
+}
+
+func TestDelaunayBuilder(t *testing.T) {
+	tests := []struct {
+		name      string
+		geom      matrix.Steric
+		tolerance float64
+		triangles int
+		edges     int
+		area      float64
+	}{
+		{name: "triangle", geom: matrix.LineMatrix{{0, 0}, {1, 0}, {0, 1}}, triangles: 1, edges: 3, area: 0.5},
+		{name: "interior site", geom: matrix.LineMatrix{{0, 0}, {2, 0}, {1, 1}, {1, 3}}, triangles: 3, edges: 6, area: 3},
+		{name: "cocircular grid", geom: grid(4), triangles: 18, edges: 33, area: 9},
+		{name: "collinear", geom: matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}, triangles: 0, edges: 2},
+		{name: "repeated sites", geom: matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, triangles: 2, edges: 5, area: 1},
+		{name: "tolerance", geom: matrix.LineMatrix{{0, 0}, {1, 0}, {0, 1}, {0.01, 0.01}}, tolerance: 0.1,
+			triangles: 1, edges: 3, area: 0.5},
+		{name: "empty", geom: matrix.LineMatrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DelaunayWithGeom(tt.geom, tt.tolerance)
+			triangles := d.Triangles()
+			if len(triangles) != tt.triangles {
+				t.Errorf("Triangles() = %v, want %v triangles", triangles, tt.triangles)
+			}
+			if area := triangleArea(triangles); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("Triangles() area = %v, want %v", area, tt.area)
+			}
+			if edges := d.Edges(); len(edges) != tt.edges {
+				t.Errorf("Edges() = %v, want %v edges", edges, tt.edges)
+			}
+		})
+	}
+}
+
+func TestDelaunayBuilder_HullArea(t *testing.T) {
+	for seed := int64(1); seed <= 20; seed++ {
+		for _, size := range [][2]float64{{100, 1}, {100, 100}, {1, 1000}} {
+			sites := randomSites(seed, 100, size[0], size[1])
+			area, want := triangleArea(DelaunayWithGeom(sites, 0).Triangles()), hullArea(sites)
+			if math.Abs(area-want) > 1e-9*want {
+				t.Errorf("Triangles() seed %v %v area = %v, want hull area %v", seed, size, area, want)
+			}
+		}
+	}
+	// a thin set whose hull triangles have huge circumcircles
+	sites := matrix.LineMatrix{{0, 0}, {50, 0.001}, {100, 0}, {30, -0.002}, {70, -0.001}}
+	if area, want := triangleArea(DelaunayWithGeom(sites, 0).Triangles()), hullArea(sites); math.Abs(area-want) > 1e-12 {
+		t.Errorf("Triangles() area = %v, want hull area %v", area, want)
+	}
+	// the crossings of a random line are nearly collinear with the vertices of its segments
+	for seed := int64(1); seed <= 20; seed++ {
+		sites := ConstrainedDelaunayWithGeom(randomSites(seed, 40, 100, 1), 0).Sites()
+		area, want := triangleArea(DelaunayWithGeom(sites, 0).Triangles()), hullArea(sites)
+		if math.Abs(area-want) > 1e-9*want {
+			t.Errorf("Triangles() noded line seed %v area = %v, want hull area %v", seed, area, want)
+		}
+	}
+}
+
+func TestDelaunayBuilder_EmptyCircumcircle(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sites := matrix.LineMatrix{}
+	for i := 0; i < 300; i++ {
+		sites = append(sites, matrix.Matrix{r.Float64() * 100, r.Float64() * 100})
+	}
+	d := DelaunayWithGeom(sites, 0)
+	for _, v := range d.Triangles() {
+		ring := v.(matrix.PolygonMatrix)[0]
+		if cross(ring[0], ring[1], ring[2]) <= 0 {
+			t.Fatalf("Triangles() %v is not counter-clockwise", ring)
+		}
+		for _, p := range sites {
+			if inCircle(ring[0], ring[1], ring[2], p) {
+				t.Fatalf("Triangles() %v circumcircle contains %v", ring, p)
+			}
+		}
+	}
+}
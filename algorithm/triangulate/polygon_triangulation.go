@@ -0,0 +1,262 @@
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// PolygonTriangles triangulates the polygons of geometry by ear clipping, the holes are bridged to the shell.
+// It returns a collection of counter-clockwise triangle PolygonMatrix covering the polygons,
+// the vertices of triangles are the vertices of the polygons.
+func PolygonTriangles(geom matrix.Steric) matrix.Collection {
+	coll := matrix.Collection{}
+	for _, polygon := range polygonsOf(geom) {
+		ring := bridgeHoles(polygon)
+		for _, t := range earClip(ring) {
+			a, b, c := ring[t[0]], ring[t[1]], ring[t[2]]
+			coll = append(coll, matrix.PolygonMatrix{{a, b, c, a}})
+		}
+	}
+	return coll
+}
+
+// polygonsOf returns the polygons of the geometry.
+func polygonsOf(geom matrix.Steric) []matrix.PolygonMatrix {
+	polygons := []matrix.PolygonMatrix{}
+	switch st := geom.(type) {
+	case matrix.PolygonMatrix:
+		if len(st) > 0 {
+			polygons = append(polygons, st)
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			polygons = append(polygons, polygonsOf(v)...)
+		}
+	}
+	return polygons
+}
+
+// openRing returns the points of the ring without the closing point and repeated points,
+// in counter-clockwise order if ccw, otherwise clockwise.
+func openRing(ring matrix.LineMatrix, ccw bool) matrix.LineMatrix {
+	points := matrix.LineMatrix{}
+	for _, v := range ring {
+		if len(points) == 0 || !equals(points[len(points)-1], v) {
+			points = append(points, v)
+		}
+	}
+	for len(points) > 1 && equals(points[0], points[len(points)-1]) {
+		points = points[:len(points)-1]
+	}
+	area := 0.0
+	for i := range points {
+		p, q := points[i], points[(i+1)%len(points)]
+		area += p[0]*q[1] - q[0]*p[1]
+	}
+	if (area > 0) != ccw {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	return points
+}
+
+// bridgeHoles returns the counter-clockwise shell with the clockwise holes joined by bridges into one ring.
+// The holes are bridged in order of their rightmost vertices from the right, each to a shell vertex visible from it.
+func bridgeHoles(polygon matrix.PolygonMatrix) matrix.LineMatrix {
+	outer := openRing(polygon[0], true)
+	holes := []matrix.LineMatrix{}
+	for _, v := range polygon[1:] {
+		if hole := openRing(v, false); len(hole) >= 3 {
+			holes = append(holes, hole)
+		}
+	}
+	rightmost := func(ring matrix.LineMatrix) int {
+		m := 0
+		for i, v := range ring {
+			if v[0] > ring[m][0] || (v[0] == ring[m][0] && v[1] < ring[m][1]) {
+				m = i
+			}
+		}
+		return m
+	}
+	sort.SliceStable(holes, func(i, j int) bool {
+		return holes[i][rightmost(holes[i])][0] > holes[j][rightmost(holes[j])][0]
+	})
+	for _, hole := range holes {
+		m := rightmost(hole)
+		p := bridgeVertex(outer, hole[m])
+		if p < 0 {
+			continue
+		}
+		joined := append(matrix.LineMatrix{}, outer[:p+1]...)
+		joined = append(joined, hole[m:]...)
+		joined = append(joined, hole[:m+1]...)
+		joined = append(joined, outer[p:]...)
+		outer = joined
+	}
+	return outer
+}
+
+// bridgeVertex returns the index of the vertex of the counter-clockwise ring visible from the point inside it.
+// The ray from the point to the east hits the nearest edge, its end of larger x is visible
+// unless reflex vertices are in the triangle of the point, the hit and the end,
+// then the reflex vertex of the smallest angle to the ray is visible.
+func bridgeVertex(ring matrix.LineMatrix, m matrix.Matrix) int {
+	n := len(ring)
+	hitX, p := math.Inf(1), -1
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%n]
+		if (a[1] > m[1]) == (b[1] > m[1]) && a[1] != m[1] {
+			continue
+		}
+		if a[1] == b[1] {
+			continue
+		}
+		x := a[0] + (m[1]-a[1])*(b[0]-a[0])/(b[1]-a[1])
+		if x < m[0] || x >= hitX {
+			continue
+		}
+		hitX = x
+		switch {
+		case a[1] == m[1]:
+			p = i
+		case b[1] == m[1]:
+			p = (i + 1) % n
+		case a[0] > b[0]:
+			p = i
+		default:
+			p = (i + 1) % n
+		}
+	}
+	if p < 0 {
+		return -1
+	}
+	hit := matrix.Matrix{hitX, m[1]}
+	if equals(ring[p], hit) {
+		return locallyInsideVertex(ring, p, m)
+	}
+	best, bestTan := p, math.Inf(1)
+	for i, v := range ring {
+		if !isReflex(ring, i) || !inTriangle(m, hit, ring[p], v) {
+			continue
+		}
+		tan := math.Abs(v[1]-m[1]) / (v[0] - m[0])
+		if tan < bestTan || (tan == bestTan && v[0] < ring[best][0]) {
+			best, bestTan = i, tan
+		}
+	}
+	return locallyInsideVertex(ring, best, m)
+}
+
+// locallyInsideVertex returns the index of a vertex at the point of vertex p where m is inside the angle of the ring,
+// a point repeated by earlier bridges has more than one vertex.
+func locallyInsideVertex(ring matrix.LineMatrix, p int, m matrix.Matrix) int {
+	n := len(ring)
+	for k := 0; k < n; k++ {
+		i := (p + k) % n
+		if !equals(ring[i], ring[p]) {
+			continue
+		}
+		prev, v, next := ring[(i+n-1)%n], ring[i], ring[(i+1)%n]
+		if cross(prev, v, next) >= 0 {
+			if cross(v, next, m) >= 0 && cross(prev, v, m) >= 0 {
+				return i
+			}
+		} else if cross(v, next, m) >= 0 || cross(prev, v, m) >= 0 {
+			return i
+		}
+	}
+	return p
+}
+
+// isReflex returns true if the vertex of the counter-clockwise ring is reflex.
+func isReflex(ring matrix.LineMatrix, i int) bool {
+	n := len(ring)
+	return cross(ring[(i+n-1)%n], ring[i], ring[(i+1)%n]) < 0
+}
+
+// inTriangle returns true if p is inside or on the counter-clockwise or clockwise triangle abc.
+func inTriangle(a, b, c, p matrix.Matrix) bool {
+	d1, d2, d3 := cross(a, b, p), cross(b, c, p), cross(c, a, p)
+	hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+	hasPos := d1 > 0 || d2 > 0 || d3 > 0
+	return !(hasNeg && hasPos)
+}
+
+// earClip triangulates the counter-clockwise ring, it returns the triangles of indices of the ring.
+// An ear is a convex vertex whose triangle with its neighbours contains no other vertex,
+// clipping ears one by one leaves the last triangle.
+func earClip(ring matrix.LineMatrix) [][3]int {
+	n := len(ring)
+	triangles := [][3]int{}
+	if n < 3 {
+		return triangles
+	}
+	prev, next := make([]int, n), make([]int, n)
+	for i := range ring {
+		prev[i], next[i] = (i+n-1)%n, (i+1)%n
+	}
+	isEar := func(i int) bool {
+		a, b, c := ring[prev[i]], ring[i], ring[next[i]]
+		if cross(a, b, c) <= 0 {
+			return false
+		}
+		for j := next[next[i]]; j != prev[i]; j = next[j] {
+			p := ring[j]
+			if equals(p, a) || equals(p, b) || equals(p, c) {
+				continue
+			}
+			if cross(ring[prev[j]], p, ring[next[j]]) <= 0 && inTriangle(a, b, c, p) {
+				return false
+			}
+		}
+		return true
+	}
+	clip := func(i int, emit bool) int {
+		if emit {
+			triangles = append(triangles, [3]int{prev[i], i, next[i]})
+		}
+		next[prev[i]], prev[next[i]] = next[i], prev[i]
+		n--
+		return next[i]
+	}
+	for i, stall := 0, 0; n > 3; {
+		if isEar(i) {
+			i, stall = clip(i, true), 0
+			continue
+		}
+		i, stall = next[i], stall+1
+		if stall < n {
+			continue
+		}
+		// no ear is left for rounding or degenerate rings, a collinear vertex is dropped or a convex vertex clipped.
+		forced := i
+		for j, k := next[i], 0; k < n; j, k = next[j], k+1 {
+			if cross(ring[prev[j]], ring[j], ring[next[j]]) == 0 {
+				forced = j
+				break
+			}
+			if cross(ring[prev[j]], ring[j], ring[next[j]]) > 0 {
+				forced = j
+			}
+		}
+		i, stall = clip(forced, cross(ring[prev[forced]], ring[forced], ring[next[forced]]) > 0), 0
+	}
+	for i := 0; i < len(ring); i++ {
+		if next[prev[i]] == i && prev[next[i]] == i {
+			if cross(ring[prev[i]], ring[i], ring[next[i]]) > 0 {
+				triangles = append(triangles, [3]int{prev[i], i, next[i]})
+			}
+			break
+		}
+	}
+	return triangles
+}
+
+// equals returns true if the points have the same x y.
+func equals(p, q matrix.Matrix) bool {
+	return p[0] == q[0] && p[1] == q[1]
+}
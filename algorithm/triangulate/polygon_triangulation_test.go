@@ -0,0 +1,61 @@
+package triangulate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+var (
+	squareWithHoles = matrix.PolygonMatrix{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+		{{6, 6}, {6, 8}, {8, 8}, {8, 6}, {6, 6}},
+	}
+	comb = matrix.PolygonMatrix{
+		{{0, 0}, {9, 0}, {9, 5}, {8, 5}, {8, 1}, {7, 1}, {7, 5}, {6, 5}, {6, 1}, {5, 1}, {5, 5}, {4, 5},
+			{4, 1}, {3, 1}, {3, 5}, {2, 5}, {2, 1}, {1, 1}, {1, 5}, {0, 5}, {0, 0}},
+	}
+)
+
+func star() matrix.PolygonMatrix {
+	ring := matrix.LineMatrix{}
+	for i := 0; i < 10; i++ {
+		r := 10.0
+		if i%2 == 1 {
+			r = 4
+		}
+		a := float64(i) * math.Pi / 5
+		ring = append(ring, matrix.Matrix{r * math.Cos(a), r * math.Sin(a)})
+	}
+	return matrix.PolygonMatrix{append(ring, ring[0])}
+}
+
+func TestPolygonTriangles(t *testing.T) {
+	tests := []struct {
+		name      string
+		geom      matrix.Steric
+		triangles int
+		area      float64
+	}{
+		{name: "square", geom: matrix.PolygonMatrix{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}, triangles: 2, area: 1},
+		{name: "square with holes", geom: squareWithHoles, triangles: 14, area: 92},
+		{name: "comb", geom: comb, triangles: 18, area: 29},
+		{name: "star", geom: star(), triangles: 8, area: 10 * 4 * math.Sin(math.Pi/5) * 5},
+		{name: "multi polygon", geom: matrix.Collection{squareWithHoles, matrix.PolygonMatrix{{{20, 0}, {25, 0}, {25, 5}, {20, 5}, {20, 0}}}},
+			triangles: 16, area: 117},
+		{name: "line", geom: matrix.LineMatrix{{0, 0}, {1, 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			triangles := PolygonTriangles(tt.geom)
+			if len(triangles) != tt.triangles {
+				t.Errorf("PolygonTriangles() = %v, want %v triangles", triangles, tt.triangles)
+			}
+			if area := triangleArea(triangles); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("PolygonTriangles() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
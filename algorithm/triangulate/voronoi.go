@@ -0,0 +1,190 @@
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+)
+
+// VoronoiBuilder builds the Voronoi diagram of the sites of a geometry,
+// it is the dual of the Delaunay triangulation, each site has the cell of points closer to it than to other sites.
+type VoronoiBuilder struct {
+	delaunay *DelaunayBuilder
+	clipEnv  *envelope.Envelope
+}
+
+// VoronoiWithGeom creates a builder of the Voronoi diagram of the vertices of geometry,
+// sites closer than tolerance are merged.
+func VoronoiWithGeom(geom matrix.Steric, tolerance float64) *VoronoiBuilder {
+	return &VoronoiBuilder{delaunay: DelaunayWithGeom(geom, tolerance)}
+}
+
+// SetClipEnvelope sets the envelope to clip the diagram to.
+// The diagram is clipped to the larger of this envelope and the default envelope around the sites,
+// which is the envelope of the sites expanded by its larger extent.
+func (v *VoronoiBuilder) SetClipEnvelope(env *envelope.Envelope) {
+	v.clipEnv = env
+}
+
+// Sites returns the sites of the diagram.
+func (v *VoronoiBuilder) Sites() matrix.LineMatrix {
+	return v.delaunay.Sites()
+}
+
+// Diagram returns the cells of the diagram as a collection of PolygonMatrix clipped to the clip envelope,
+// one cell per site in the order of Sites.
+func (v *VoronoiBuilder) Diagram() matrix.Collection {
+	sites := v.delaunay.Sites()
+	coll := matrix.Collection{}
+	if len(sites) == 0 {
+		return coll
+	}
+	env := v.envelope()
+	v.delaunay.triangulate()
+	n := len(sites)
+
+	// the circumcenters of triangles around a site are the vertices of its cell,
+	// the cells of hull sites are closed by points far along the bisectors of the hull edges.
+	far := 4 * (env.Width() + env.Height())
+	for _, t := range v.delaunay.triangles {
+		if t.center != nil {
+			far = math.Max(far, 4*(env.Width()+env.Height())+2*math.Sqrt(t.radius2))
+		}
+	}
+	centers := make([]matrix.LineMatrix, n)
+	normals := make([][]matrix.Matrix, n)
+	neighbours := make([]map[int]bool, n)
+	for _, t := range v.delaunay.triangles {
+		if t.center != nil {
+			for _, i := range t.v {
+				centers[i] = append(centers[i], t.center)
+			}
+			continue
+		}
+		for k := 0; k < 3; k++ {
+			if t.v[k] != n {
+				continue
+			}
+			a, b := t.v[(k+1)%3], t.v[(k+2)%3]
+			pa, pb := sites[a], sites[b]
+			length := math.Hypot(pb[0]-pa[0], pb[1]-pa[1])
+			// the outside of the hull edge is on its left.
+			normal := matrix.Matrix{-(pb[1] - pa[1]) / length, (pb[0] - pa[0]) / length}
+			point := matrix.Matrix{(pa[0]+pb[0])/2 + normal[0]*far, (pa[1]+pb[1])/2 + normal[1]*far}
+			for _, i := range []int{a, b} {
+				centers[i], normals[i] = append(centers[i], point), append(normals[i], normal)
+				if neighbours[i] == nil {
+					neighbours[i] = map[int]bool{}
+				}
+			}
+			neighbours[a][b], neighbours[b][a] = true, true
+		}
+	}
+	for i, site := range sites {
+		centers[i] = append(centers[i], farPoints(site, sites, normals[i], neighbours[i], far)...)
+	}
+
+	for i, site := range sites {
+		cell := centers[i]
+		sort.Slice(cell, func(j, k int) bool {
+			return math.Atan2(cell[j][1]-site[1], cell[j][0]-site[0]) < math.Atan2(cell[k][1]-site[1], cell[k][0]-site[0])
+		})
+		ring := clipRing(cell, env)
+		if len(ring) < 3 {
+			continue
+		}
+		coll = append(coll, matrix.PolygonMatrix{append(ring, ring[0])})
+	}
+	return coll
+}
+
+// farPoints returns the points far from the site in the directions between the normals of its hull edges,
+// away from its neighbour at the end of collinear sites, around it for a single site.
+func farPoints(site matrix.Matrix, sites matrix.LineMatrix, normals []matrix.Matrix, neighbours map[int]bool, far float64) matrix.LineMatrix {
+	directions := []matrix.Matrix{}
+	switch {
+	case len(sites) == 1:
+		directions = append(directions, matrix.Matrix{1, 0}, matrix.Matrix{0, 1}, matrix.Matrix{-1, 0}, matrix.Matrix{0, -1})
+	case len(neighbours) == 1:
+		for j := range neighbours {
+			directions = append(directions, matrix.Matrix{site[0] - sites[j][0], site[1] - sites[j][1]})
+		}
+	case len(normals) == 2:
+		directions = append(directions, matrix.Matrix{normals[0][0] + normals[1][0], normals[0][1] + normals[1][1]})
+	}
+	points := matrix.LineMatrix{}
+	for _, d := range directions {
+		length := math.Hypot(d[0], d[1])
+		points = append(points, matrix.Matrix{site[0] + d[0]/length*far, site[1] + d[1]/length*far})
+	}
+	return points
+}
+
+// envelope returns the clip envelope of the diagram.
+func (v *VoronoiBuilder) envelope() *envelope.Envelope {
+	env := envelope.Empty()
+	for _, p := range v.delaunay.Sites() {
+		env.ExpandToIncludeMatrix(p)
+	}
+	expand := math.Max(env.Width(), env.Height())
+	if expand == 0 {
+		expand = 1
+	}
+	env.ExpandBy(expand)
+	if v.clipEnv != nil && !v.clipEnv.IsNil() {
+		env.ExpandToIncludeEnv(v.clipEnv)
+	}
+	return env
+}
+
+// clipRing clips the convex ring of points in counter-clockwise order to the envelope,
+// each side of the envelope cuts off the points outside it, the repeated points are removed.
+func clipRing(ring matrix.LineMatrix, env *envelope.Envelope) matrix.LineMatrix {
+	sides := []struct {
+		inside func(p matrix.Matrix) bool
+		cut    func(p, q matrix.Matrix) matrix.Matrix
+	}{
+		{func(p matrix.Matrix) bool { return p[0] >= env.MinX }, func(p, q matrix.Matrix) matrix.Matrix { return cutX(p, q, env.MinX) }},
+		{func(p matrix.Matrix) bool { return p[0] <= env.MaxX }, func(p, q matrix.Matrix) matrix.Matrix { return cutX(p, q, env.MaxX) }},
+		{func(p matrix.Matrix) bool { return p[1] >= env.MinY }, func(p, q matrix.Matrix) matrix.Matrix { return cutY(p, q, env.MinY) }},
+		{func(p matrix.Matrix) bool { return p[1] <= env.MaxY }, func(p, q matrix.Matrix) matrix.Matrix { return cutY(p, q, env.MaxY) }},
+	}
+	for _, side := range sides {
+		clipped := matrix.LineMatrix{}
+		for i, q := range ring {
+			p := ring[(i+len(ring)-1)%len(ring)]
+			switch {
+			case side.inside(q) && !side.inside(p):
+				clipped = append(clipped, side.cut(p, q), q)
+			case side.inside(q):
+				clipped = append(clipped, q)
+			case side.inside(p):
+				clipped = append(clipped, side.cut(p, q))
+			}
+		}
+		ring = clipped
+	}
+	// the cuts at a corner of the envelope or at a point of the ring on a side repeat points.
+	points := matrix.LineMatrix{}
+	for _, p := range ring {
+		if len(points) == 0 || !matrix.Matrix(points[len(points)-1]).Equals(matrix.Matrix(p)) {
+			points = append(points, p)
+		}
+	}
+	for len(points) > 1 && matrix.Matrix(points[len(points)-1]).Equals(matrix.Matrix(points[0])) {
+		points = points[:len(points)-1]
+	}
+	return points
+}
+
+// cutX returns the point of the segment pq at x.
+func cutX(p, q matrix.Matrix, x float64) matrix.Matrix {
+	return matrix.Matrix{x, p[1] + (q[1]-p[1])*(x-p[0])/(q[0]-p[0])}
+}
+
+// cutY returns the point of the segment pq at y.
+func cutY(p, q matrix.Matrix, y float64) matrix.Matrix {
+	return matrix.Matrix{p[0] + (q[0]-p[0])*(y-p[1])/(q[1]-p[1]), y}
+}
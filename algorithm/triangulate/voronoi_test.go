@@ -0,0 +1,84 @@
+package triangulate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+func TestVoronoiBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    matrix.Steric
+		clipEnv *envelope.Envelope
+		cells   int
+		area    float64
+		rings   []matrix.LineMatrix
+	}{
+		{name: "single site", geom: matrix.Matrix{1, 1}, cells: 1, area: 4,
+			rings: []matrix.LineMatrix{{{0, 2}, {0, 0}, {2, 0}, {2, 2}, {0, 2}}}},
+		{name: "two sites", geom: matrix.LineMatrix{{0, 0}, {2, 0}}, cells: 2, area: 24,
+			rings: []matrix.LineMatrix{{{-2, 2}, {-2, -2}, {1, -2}, {1, 2}, {-2, 2}}, {{1, 2}, {1, -2}, {4, -2}, {4, 2}, {1, 2}}}},
+		{name: "triangle", geom: matrix.LineMatrix{{0, 0}, {1, 0}, {0, 1}}, cells: 3, area: 9,
+			rings: []matrix.LineMatrix{{{-1, -1}, {0.5, -1}, {0.5, 0.5}, {-1, 0.5}, {-1, -1}},
+				{{0.5, -1}, {2, -1}, {2, 2}, {0.5, 0.5}, {0.5, -1}}, {{-1, 2}, {-1, 0.5}, {0.5, 0.5}, {2, 2}, {-1, 2}}}},
+		{name: "collinear", geom: matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}, cells: 3, area: 36},
+		{name: "grid", geom: grid(4), cells: 16, area: 81},
+		{name: "clip envelope", geom: grid(4), clipEnv: envelope.FourFloat(-10, 10, -10, 10), cells: 16, area: 400},
+		{name: "smaller clip envelope", geom: grid(4), clipEnv: envelope.FourFloat(0, 1, 0, 1), cells: 16, area: 81},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := VoronoiWithGeom(tt.geom, 0)
+			v.SetClipEnvelope(tt.clipEnv)
+			cells := v.Diagram()
+			if len(cells) != tt.cells {
+				t.Fatalf("Diagram() = %v, want %v cells", cells, tt.cells)
+			}
+			area := 0.0
+			for i, cell := range cells {
+				ring := cell.(matrix.PolygonMatrix)[0]
+				for j := 1; j < len(ring); j++ {
+					area += (ring[j-1][0]*ring[j][1] - ring[j][0]*ring[j-1][1]) / 2
+				}
+				if site := v.Sites()[i]; !relate.InPolygon(site, ring) {
+					t.Errorf("Diagram() cell %v not contains site %v", ring, site)
+				}
+				for j := 1; j < len(ring); j++ {
+					if matrix.Matrix(ring[j-1]).Equals(matrix.Matrix(ring[j])) {
+						t.Errorf("Diagram() cell %v repeats point %v", ring, ring[j])
+					}
+				}
+				if tt.rings != nil && !matrix.LineMatrix(ring).Equals(tt.rings[i]) {
+					t.Errorf("Diagram() cell %v, want %v", ring, tt.rings[i])
+				}
+			}
+			if math.Abs(area-tt.area) > 1e-6 {
+				t.Errorf("Diagram() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestVoronoiBuilder_Random(t *testing.T) {
+	for seed := int64(1); seed <= 10; seed++ {
+		for _, size := range [][2]float64{{100, 1}, {100, 100}} {
+			v := VoronoiWithGeom(randomSites(seed, 50, size[0], size[1]), 0)
+			cells := v.Diagram()
+			area := 0.0
+			for _, cell := range cells {
+				ring := cell.(matrix.PolygonMatrix)[0]
+				for j := 1; j < len(ring); j++ {
+					area += (ring[j-1][0]*ring[j][1] - ring[j][0]*ring[j-1][1]) / 2
+				}
+			}
+			// the cells cover the clip envelope
+			if want := v.envelope().Area(); len(cells) != 50 || math.Abs(area-want) > 1e-6*want {
+				t.Errorf("Diagram() seed %v %v = %v cells of area %v, want 50 cells of area %v", seed, size, len(cells), area, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package coordtransform
+
+import "math"
+
+// LambertConformalConic Lambert Conformal Conic projection with two standard parallels on the ellipsoid.
+// Use NewLambertConformalConic to create it.
+type LambertConformalConic struct {
+	Ellipsoid                   Ellipsoid
+	CentralMeridian, LatOrigin  float64
+	StandardParallel1           float64
+	StandardParallel2           float64
+	FalseEasting, FalseNorthing float64
+	e, n, f, rho0               float64
+}
+
+// NewLambertConformalConic Creates a Lambert Conformal Conic projection, angles unit degree.
+// If the two standard parallels are equal it is the one standard parallel variant.
+func NewLambertConformalConic(ellipsoid Ellipsoid, centralMeridian, latOrigin, standardParallel1, standardParallel2,
+	falseEasting, falseNorthing float64) *LambertConformalConic {
+	lcc := &LambertConformalConic{
+		Ellipsoid:         ellipsoid,
+		CentralMeridian:   centralMeridian,
+		LatOrigin:         latOrigin,
+		StandardParallel1: standardParallel1,
+		StandardParallel2: standardParallel2,
+		FalseEasting:      falseEasting,
+		FalseNorthing:     falseNorthing,
+	}
+	e2 := ellipsoid.E2()
+	lcc.e = ellipsoid.Eccentricity()
+	phi1, phi2, phi0 := standardParallel1*degToRad, standardParallel2*degToRad, latOrigin*degToRad
+	m1, m2 := conformalM(phi1, e2), conformalM(phi2, e2)
+	t1, t2, t0 := conformalT(phi1, lcc.e), conformalT(phi2, lcc.e), conformalT(phi0, lcc.e)
+	if math.Abs(phi1-phi2) < projectionTolerance {
+		lcc.n = math.Sin(phi1)
+	} else {
+		lcc.n = (math.Log(m1) - math.Log(m2)) / (math.Log(t1) - math.Log(t2))
+	}
+	lcc.f = m1 / (lcc.n * math.Pow(t1, lcc.n))
+	lcc.rho0 = ellipsoid.A * lcc.f * math.Pow(t0, lcc.n)
+	return lcc
+}
+
+// Forward projects the geographic coordinates.
+func (lcc *LambertConformalConic) Forward(lng, lat float64) (float64, float64) {
+	rho := lcc.Ellipsoid.A * lcc.f * math.Pow(conformalT(lat*degToRad, lcc.e), lcc.n)
+	theta := lcc.n * normalizeLng(lng, lcc.CentralMeridian)
+	return lcc.FalseEasting + rho*math.Sin(theta), lcc.FalseNorthing + lcc.rho0 - rho*math.Cos(theta)
+}
+
+// Inverse unprojects the projected coordinates.
+func (lcc *LambertConformalConic) Inverse(x, y float64) (float64, float64) {
+	dx, dy := x-lcc.FalseEasting, lcc.rho0-(y-lcc.FalseNorthing)
+	sign := 1.0
+	if lcc.n < 0 {
+		sign = -1.0
+	}
+	rho := sign * math.Hypot(dx, dy)
+	theta := math.Atan2(sign*dx, sign*dy)
+	if rho == 0 {
+		return lcc.CentralMeridian, sign * 90
+	}
+	t := math.Pow(rho/(lcc.Ellipsoid.A*lcc.f), 1/lcc.n)
+	phi := phiFromT(t, lcc.e)
+	return lcc.CentralMeridian + theta/lcc.n*radToDeg, phi * radToDeg
+}
+
+// AlbersEqualArea Albers Equal Area conic projection on the ellipsoid.
+// Use NewAlbersEqualArea to create it.
+type AlbersEqualArea struct {
+	Ellipsoid                   Ellipsoid
+	CentralMeridian, LatOrigin  float64
+	StandardParallel1           float64
+	StandardParallel2           float64
+	FalseEasting, FalseNorthing float64
+	e, e2, n, c, rho0           float64
+}
+
+// NewAlbersEqualArea Creates an Albers Equal Area projection, angles unit degree.
+func NewAlbersEqualArea(ellipsoid Ellipsoid, centralMeridian, latOrigin, standardParallel1, standardParallel2,
+	falseEasting, falseNorthing float64) *AlbersEqualArea {
+	aea := &AlbersEqualArea{
+		Ellipsoid:         ellipsoid,
+		CentralMeridian:   centralMeridian,
+		LatOrigin:         latOrigin,
+		StandardParallel1: standardParallel1,
+		StandardParallel2: standardParallel2,
+		FalseEasting:      falseEasting,
+		FalseNorthing:     falseNorthing,
+	}
+	aea.e2 = ellipsoid.E2()
+	aea.e = ellipsoid.Eccentricity()
+	phi1, phi2, phi0 := standardParallel1*degToRad, standardParallel2*degToRad, latOrigin*degToRad
+	m1, m2 := conformalM(phi1, aea.e2), conformalM(phi2, aea.e2)
+	q1, q2, q0 := aea.q(phi1), aea.q(phi2), aea.q(phi0)
+	if math.Abs(phi1-phi2) < projectionTolerance {
+		aea.n = math.Sin(phi1)
+	} else {
+		aea.n = (m1*m1 - m2*m2) / (q2 - q1)
+	}
+	aea.c = m1*m1 + aea.n*q1
+	aea.rho0 = ellipsoid.A * math.Sqrt(aea.c-aea.n*q0) / aea.n
+	return aea
+}
+
+// Forward projects the geographic coordinates.
+func (aea *AlbersEqualArea) Forward(lng, lat float64) (float64, float64) {
+	rho := aea.Ellipsoid.A * math.Sqrt(aea.c-aea.n*aea.q(lat*degToRad)) / aea.n
+	theta := aea.n * normalizeLng(lng, aea.CentralMeridian)
+	return aea.FalseEasting + rho*math.Sin(theta), aea.FalseNorthing + aea.rho0 - rho*math.Cos(theta)
+}
+
+// Inverse unprojects the projected coordinates.
+func (aea *AlbersEqualArea) Inverse(x, y float64) (float64, float64) {
+	dx, dy := x-aea.FalseEasting, aea.rho0-(y-aea.FalseNorthing)
+	sign := 1.0
+	if aea.n < 0 {
+		sign = -1.0
+	}
+	rho := math.Hypot(dx, dy)
+	theta := math.Atan2(sign*dx, sign*dy)
+	q := (aea.c - rho*rho*aea.n*aea.n/(aea.Ellipsoid.A*aea.Ellipsoid.A)) / aea.n
+
+	qPole := aea.q(math.Pi / 2)
+	var phi float64
+	if math.Abs(math.Abs(q)-qPole) < projectionTolerance {
+		phi = math.Copysign(math.Pi/2, q)
+	} else {
+		phi = math.Asin(q / 2)
+		for i := 0; i < projectionMaxIterations; i++ {
+			sinPhi := math.Sin(phi)
+			esinPhi := aea.e * sinPhi
+			one := 1 - esinPhi*esinPhi
+			dPhi := one * one / (2 * math.Cos(phi)) *
+				(q/(1-aea.e2) - sinPhi/one + math.Log((1-esinPhi)/(1+esinPhi))/(2*aea.e))
+			phi += dPhi
+			if math.Abs(dPhi) < projectionTolerance {
+				break
+			}
+		}
+	}
+	return aea.CentralMeridian + theta/aea.n*radToDeg, phi * radToDeg
+}
+
+// q the authalic q function (Snyder 3-12).
+func (aea *AlbersEqualArea) q(phi float64) float64 {
+	sinPhi := math.Sin(phi)
+	esinPhi := aea.e * sinPhi
+	return (1 - aea.e2) * (sinPhi/(1-esinPhi*esinPhi) - math.Log((1-esinPhi)/(1+esinPhi))/(2*aea.e))
+}
+
+// PolarStereographic Polar Stereographic projection on the ellipsoid.
+// Scale is defined either by the latitude of true scale (variant B),
+// or by the scale factor at the pole (variant A) when LatTrueScale is the pole.
+// Use NewPolarStereographic to create it.
+type PolarStereographic struct {
+	Ellipsoid                   Ellipsoid
+	CentralMeridian             float64
+	LatTrueScale                float64
+	Scale                       float64
+	FalseEasting, FalseNorthing float64
+	South                       bool
+	e, k                        float64
+}
+
+// NewPolarStereographic Creates a Polar Stereographic projection, angles unit degree.
+// latTrueScale is the latitude of true scale, its sign selects the pole,
+// if it is ±90 the scale is the scale factor at the pole.
+func NewPolarStereographic(ellipsoid Ellipsoid, centralMeridian, latTrueScale, scale,
+	falseEasting, falseNorthing float64) *PolarStereographic {
+	ps := &PolarStereographic{
+		Ellipsoid:       ellipsoid,
+		CentralMeridian: centralMeridian,
+		LatTrueScale:    latTrueScale,
+		Scale:           scale,
+		FalseEasting:    falseEasting,
+		FalseNorthing:   falseNorthing,
+		South:           latTrueScale < 0,
+	}
+	ps.e = ellipsoid.Eccentricity()
+	phiC := math.Abs(latTrueScale) * degToRad
+	if math.Abs(phiC-math.Pi/2) < projectionTolerance {
+		ps.k = 2 * ellipsoid.A * scale / math.Sqrt(math.Pow(1+ps.e, 1+ps.e)*math.Pow(1-ps.e, 1-ps.e))
+	} else {
+		ps.k = ellipsoid.A * conformalM(phiC, ellipsoid.E2()) / conformalT(phiC, ps.e)
+	}
+	return ps
+}
+
+// Forward projects the geographic coordinates.
+func (ps *PolarStereographic) Forward(lng, lat float64) (float64, float64) {
+	lam := normalizeLng(lng, ps.CentralMeridian)
+	phi := lat * degToRad
+	if ps.South {
+		phi, lam = -phi, -lam
+	}
+	rho := ps.k * conformalT(phi, ps.e)
+	x, y := rho*math.Sin(lam), -rho*math.Cos(lam)
+	if ps.South {
+		x, y = -x, -y
+	}
+	return ps.FalseEasting + x, ps.FalseNorthing + y
+}
+
+// Inverse unprojects the projected coordinates.
+func (ps *PolarStereographic) Inverse(x, y float64) (float64, float64) {
+	dx, dy := x-ps.FalseEasting, y-ps.FalseNorthing
+	if ps.South {
+		dx, dy = -dx, -dy
+	}
+	rho := math.Hypot(dx, dy)
+	phi := phiFromT(rho/ps.k, ps.e)
+	lam := math.Atan2(dx, -dy)
+	if ps.South {
+		phi, lam = -phi, -lam
+	}
+	return ps.CentralMeridian + lam*radToDeg, phi * radToDeg
+}
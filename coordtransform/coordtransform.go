@@ -2,92 +2,157 @@ package coordtransform
 
 import (
 	"errors"
+	"runtime"
 	"sync"
 
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
+// const coord transform type
 const (
 	MERCATORTOLL = "MERCATORTOLL"
 	LLTOMERCATOR = "LLTOMERCATOR"
+
+	WGS84TOGCJ02 = "WGS84TOGCJ02"
+	GCJ02TOWGS84 = "GCJ02TOWGS84"
+	GCJ02TOBD09  = "GCJ02TOBD09"
+	BD09TOGCJ02  = "BD09TOGCJ02"
+	WGS84TOBD09  = "WGS84TOBD09"
+	BD09TOWGS84  = "BD09TOWGS84"
+
+	// Web variants, the GCJ02Web and BD09Web side is Web Mercator, unit m.
+	WGS84TOGCJ02WEB   = "WGS84TOGCJ02WEB"
+	GCJ02WEBTOWGS84   = "GCJ02WEBTOWGS84"
+	WGS84TOBD09WEB    = "WGS84TOBD09WEB"
+	BD09WEBTOWGS84    = "BD09WEBTOWGS84"
+	GCJ02WEBTOBD09WEB = "GCJ02WEBTOBD09WEB"
+	BD09WEBTOGCJ02WEB = "BD09WEBTOGCJ02WEB"
 )
 
+// ErrGeometryType geometry type is not supported by the transformer.
+var ErrGeometryType = errors.New("error geometry type")
+
+// Transformer transforms coordinates of geometry by its coord type,
+// or by the coordinate reference systems it is created with.
+// A Transformer is immutable once created, it is safe for concurrent use by multiple goroutines,
+// and its Transform methods return new coordinates without changing the input.
 type Transformer struct {
-	CoordType string
+	coordType string
+	transform func(x, y float64) (float64, float64)
 }
 
-var instance *Transformer
-var once sync.Once
-
-// GetInstance ...
+// GetInstance returns a new Transformer without coord type, which does not change coordinates.
+//
+// Deprecated: use NewTransformer of the coord type instead.
 func GetInstance() *Transformer {
-	once.Do(func() {
-		instance = &Transformer{}
-	})
-	return instance
+	return &Transformer{}
 }
 
-// NewTransformer ...
+// NewTransformer Creates a Transformer of the coord type, e.g. LLTOMERCATOR.
 func NewTransformer(coordType string) *Transformer {
-	return &Transformer{CoordType: coordType}
+	return &Transformer{coordType: coordType}
+}
+
+// NewTransformerFunc Creates a Transformer which transforms coordinates by the func,
+// the func must be safe for concurrent use.
+func NewTransformerFunc(coordType string, transform func(x, y float64) (float64, float64)) *Transformer {
+	return &Transformer{coordType: coordType, transform: transform}
+}
+
+// CoordType returns the coord type of the transformer.
+func (t *Transformer) CoordType() string {
+	return t.coordType
 }
 
 // TransformLatLng ...
 func (t *Transformer) TransformLatLng(lng, lat float64) (float64, float64) {
-	switch t.CoordType {
+	if t.transform != nil {
+		return t.transform(lng, lat)
+	}
+	switch t.coordType {
 	case MERCATORTOLL:
 		lng, lat = MercatorToLL(lng, lat)
 	case LLTOMERCATOR:
 		lng, lat = LLToMercator(lng, lat)
+	case WGS84TOGCJ02:
+		lng, lat = WGS84ToGCJ02(lng, lat)
+	case GCJ02TOWGS84:
+		lng, lat = GCJ02ToWGS84(lng, lat)
+	case GCJ02TOBD09:
+		lng, lat = GCJ02ToBD09(lng, lat)
+	case BD09TOGCJ02:
+		lng, lat = BD09ToGCJ02(lng, lat)
+	case WGS84TOBD09:
+		lng, lat = WGS84ToBD09(lng, lat)
+	case BD09TOWGS84:
+		lng, lat = BD09ToWGS84(lng, lat)
+	case WGS84TOGCJ02WEB:
+		lng, lat = LLToMercator(WGS84ToGCJ02(lng, lat))
+	case GCJ02WEBTOWGS84:
+		lng, lat = GCJ02ToWGS84(MercatorToLL(lng, lat))
+	case WGS84TOBD09WEB:
+		lng, lat = LLToMercator(WGS84ToBD09(lng, lat))
+	case BD09WEBTOWGS84:
+		lng, lat = BD09ToWGS84(MercatorToLL(lng, lat))
+	case GCJ02WEBTOBD09WEB:
+		lng, lat = LLToMercator(GCJ02ToBD09(MercatorToLL(lng, lat)))
+	case BD09WEBTOGCJ02WEB:
+		lng, lat = LLToMercator(BD09ToGCJ02(MercatorToLL(lng, lat)))
 	default:
 	}
 	return lng, lat
 }
 
-// TransformPoint ...
+// TransformPoint returns the point with its x and y transformed, its Z and M are kept.
 func (t *Transformer) TransformPoint(point matrix.Matrix) matrix.Matrix {
-	lng, lat := t.TransformLatLng(point[0], point[1])
-	return matrix.Matrix{lng, lat}
+	result := append(matrix.Matrix{}, point...)
+	result[0], result[1] = t.TransformLatLng(point[0], point[1])
+	return result
 }
 
 // TransformMultiPoint ...
 func (t *Transformer) TransformMultiPoint(multiPoint []matrix.Matrix) []matrix.Matrix {
+	result := make([]matrix.Matrix, len(multiPoint))
 	for i := range multiPoint {
-		multiPoint[i] = t.TransformPoint(multiPoint[i])
+		result[i] = t.TransformPoint(multiPoint[i])
 	}
-	return multiPoint
+	return result
 }
 
 // TransformLine ...
 func (t *Transformer) TransformLine(lineString matrix.LineMatrix) matrix.LineMatrix {
+	result := make(matrix.LineMatrix, len(lineString))
 	for i := range lineString {
-		lineString[i] = t.TransformPoint(lineString[i])
+		result[i] = t.TransformPoint(lineString[i])
 	}
-	return lineString
+	return result
 }
 
 // TransformPolygon ...
 func (t *Transformer) TransformPolygon(polygon matrix.PolygonMatrix) matrix.PolygonMatrix {
+	result := make(matrix.PolygonMatrix, len(polygon))
 	for i := range polygon {
-		polygon[i] = t.TransformLine(polygon[i])
+		result[i] = t.TransformLine(polygon[i])
 	}
-	return polygon
+	return result
 }
 
 // TransformMultiLineString ...
 func (t *Transformer) TransformMultiLineString(multiLineString []matrix.LineMatrix) []matrix.LineMatrix {
+	result := make([]matrix.LineMatrix, len(multiLineString))
 	for i := range multiLineString {
-		multiLineString[i] = t.TransformLine(multiLineString[i])
+		result[i] = t.TransformLine(multiLineString[i])
 	}
-	return multiLineString
+	return result
 }
 
 // TransformMultiPolygon ...
 func (t *Transformer) TransformMultiPolygon(multiPolygon []matrix.PolygonMatrix) []matrix.PolygonMatrix {
+	result := make([]matrix.PolygonMatrix, len(multiPolygon))
 	for i := range multiPolygon {
-		multiPolygon[i] = t.TransformPolygon(multiPolygon[i])
+		result[i] = t.TransformPolygon(multiPolygon[i])
 	}
-	return multiPolygon
+	return result
 }
 
 // TransformGeometry ...
@@ -99,12 +164,68 @@ func (t *Transformer) TransformGeometry(geom matrix.Steric) (matrix.Steric, erro
 		return t.TransformLine(mt), nil
 	case matrix.PolygonMatrix:
 		return t.TransformPolygon(mt), nil
+	case matrix.MultiPolygonMatrix:
+		result := make(matrix.MultiPolygonMatrix, len(mt))
+		for i := range mt {
+			result[i] = t.TransformPolygon(mt[i])
+		}
+		return result, nil
 	case matrix.Collection:
-		for i, _ := range mt {
-			mt[i], _ = t.TransformGeometry(mt[i])
+		result := make(matrix.Collection, len(mt))
+		for i := range mt {
+			v, err := t.TransformGeometry(mt[i])
+			if err != nil {
+				return nil, err
+			}
+			result[i] = v
 		}
-		return mt, nil
+		return result, nil
 	default:
-		return nil, errors.New("error geometry type")
+		return nil, ErrGeometryType
+	}
+}
+
+// TransformGeometries transforms the geometries in parallel by a pool of workers,
+// workers <= 0 means runtime.NumCPU(). The results keep the order of geoms, nil geometry is kept nil.
+// It returns the first error encountered.
+func (t *Transformer) TransformGeometries(geoms []matrix.Steric, workers int) ([]matrix.Steric, error) {
+	result := make([]matrix.Steric, len(geoms))
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > len(geoms) {
+		workers = len(geoms)
+	}
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	indexes := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if geoms[i] == nil {
+					continue
+				}
+				v, err := t.TransformGeometry(geoms[i])
+				if err != nil {
+					errOnce.Do(func() { firstErr = err })
+					continue
+				}
+				result[i] = v
+			}
+		}()
+	}
+	for i := range geoms {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
 	}
+	return result, nil
 }
@@ -30,11 +30,38 @@ func TestTransformer_TransformLatLng(t1 *testing.T) {
 			name: "mercator to lnglat", fields: fields{CoordType: MERCATORTOLL},
 			args: args{lng: 12245143, lat: 4865942}, want: 109.9999911, want1: 39.9999981, tolerance: 0.0000001,
 		},
+		{
+			name: "wgs84 to gcj02", fields: fields{CoordType: WGS84TOGCJ02},
+			args: args{lng: 116.3912757, lat: 39.906217}, want: 116.3975167, want1: 39.9076182, tolerance: 0.0000001,
+		},
+		{
+			name: "gcj02 to wgs84", fields: fields{CoordType: GCJ02TOWGS84},
+			args: args{lng: 116.3975167, lat: 39.9076182}, want: 116.3912757, want1: 39.906217, tolerance: 0.0000001,
+		},
+		{
+			name: "gcj02 to bd09", fields: fields{CoordType: GCJ02TOBD09},
+			args: args{lng: 116.3975167, lat: 39.9076182}, want: 116.4038906, want1: 39.9139619, tolerance: 0.0000001,
+		},
+		{
+			name: "bd09 to wgs84", fields: fields{CoordType: BD09TOWGS84},
+			args: args{lng: 116.4038906, lat: 39.9139619}, want: 116.3912757, want1: 39.906217, tolerance: 0.00001,
+		},
+		{
+			name: "wgs84 out of china", fields: fields{CoordType: WGS84TOGCJ02},
+			args: args{lng: 2.3522, lat: 48.8566}, want: 2.3522, want1: 48.8566, tolerance: 0,
+		},
+		{
+			name: "wgs84 to gcj02 web", fields: fields{CoordType: WGS84TOGCJ02WEB},
+			args: args{lng: 116.3912757, lat: 39.906217}, want: 12957312.23, want1: 4852526.68, tolerance: 0.1,
+		},
+		{
+			name: "gcj02 web to wgs84", fields: fields{CoordType: GCJ02WEBTOWGS84},
+			args: args{lng: 12957312.23, lat: 4852526.68}, want: 116.3912757, want1: 39.906217, tolerance: 0.000001,
+		},
 	}
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
-			t := GetInstance()
-			t.CoordType = tt.fields.CoordType
+			t := NewTransformer(tt.fields.CoordType)
 			got, got1 := t.TransformLatLng(tt.args.lng, tt.args.lat)
 			gotPoint := matrix.Matrix{got, got1}
 			wantPoint := matrix.Matrix{tt.want, tt.want1}
@@ -44,3 +71,94 @@ func TestTransformer_TransformLatLng(t1 *testing.T) {
 		})
 	}
 }
+
+func TestTransformer_TransformPoint(t *testing.T) {
+	tests := []struct {
+		name  string
+		point matrix.Matrix
+	}{
+		{"xy", matrix.Matrix{116.3912757, 39.906217}},
+		{"xyz", matrix.Matrix{116.3912757, 39.906217, 50}},
+		{"xyzm", matrix.Matrix{116.3912757, 39.906217, 50, 7}},
+	}
+	toMercator := NewTransformer(LLTOMERCATOR)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toMercator.TransformPoint(tt.point)
+			x, y := toMercator.TransformLatLng(tt.point[0], tt.point[1])
+			want := append(matrix.Matrix{x, y}, tt.point[2:]...)
+			if !got.Equals(want) {
+				t.Errorf("TransformPoint() got = %v, want %v", got, want)
+			}
+		})
+	}
+
+	if toMercator.CoordType() != LLTOMERCATOR {
+		t.Errorf("CoordType() got = %v, want %v", toMercator.CoordType(), LLTOMERCATOR)
+	}
+	instance := GetInstance()
+	if instance == GetInstance() {
+		t.Errorf("GetInstance() got the same transformer twice")
+	}
+	if got := instance.TransformPoint(matrix.Matrix{116, 39}); !got.Equals(matrix.Matrix{116, 39}) {
+		t.Errorf("GetInstance().TransformPoint() got = %v, want %v", got, matrix.Matrix{116, 39})
+	}
+}
+
+func TestTransformer_TransformGeometry(t *testing.T) {
+	geoms := []matrix.Steric{
+		matrix.Matrix{116.3912757, 39.906217},
+		matrix.LineMatrix{{116.3912757, 39.906217}, {116.4, 39.91}},
+		matrix.PolygonMatrix{{{116.39, 39.90}, {116.40, 39.90}, {116.40, 39.91}, {116.39, 39.90}}},
+		matrix.MultiPolygonMatrix{{{{116.39, 39.90}, {116.40, 39.90}, {116.40, 39.91}, {116.39, 39.90}}}},
+		matrix.Collection{matrix.Matrix{116.3912757, 39.906217}, matrix.LineMatrix{{116.39, 39.90}, {116.4, 39.91}}},
+	}
+	for _, geom := range geoms {
+		origin := matrix.TransMatrixes(geom)
+		want := []matrix.Matrix{}
+		for _, v := range origin {
+			want = append(want, matrix.Matrix{v[0], v[1]})
+		}
+		toBD := NewTransformer(WGS84TOBD09)
+		got, err := toBD.TransformGeometry(geom)
+		if err != nil {
+			t.Fatalf("TransformGeometry() error = %v", err)
+		}
+		toWGS := NewTransformer(BD09TOWGS84)
+		got, _ = toWGS.TransformGeometry(got)
+		for i, v := range matrix.TransMatrixes(got) {
+			if !v.EqualsExact(want[i], 0.00001) {
+				t.Errorf("TransformGeometry() got = %v, want %v", v, want[i])
+			}
+		}
+	}
+}
+
+func TestTransformer_TransformGeometries(t *testing.T) {
+	geoms := []matrix.Steric{}
+	for i := 0; i < 100; i++ {
+		geoms = append(geoms, matrix.LineMatrix{{116 + float64(i)*0.01, 39.9}, {116.4, 39.91 + float64(i)*0.01}})
+	}
+	geoms = append(geoms, nil)
+	origin := matrix.Clone(matrix.Collection(geoms[:100]))
+	toBD := NewTransformer(WGS84TOBD09)
+	got, err := toBD.TransformGeometries(geoms, 4)
+	if err != nil {
+		t.Fatalf("TransformGeometries() error = %v", err)
+	}
+	if !matrix.Collection(geoms[:100]).Equals(origin) {
+		t.Errorf("TransformGeometries() changed the input geometries")
+	}
+	if got[100] != nil {
+		t.Errorf("TransformGeometries() got = %v, want nil", got[100])
+	}
+	for i := range geoms[:100] {
+		want, _ := toBD.TransformGeometry(geoms[i])
+		if !got[i].Equals(want) {
+			t.Errorf("TransformGeometries() got = %v, want %v", got[i], want)
+		}
+	}
+	if _, err := toBD.TransformGeometries([]matrix.Steric{matrix.Matrix{1, 2}, matrix.Collection{nil}}, 0); err != ErrGeometryType {
+		t.Errorf("TransformGeometries() error = %v, want %v", err, ErrGeometryType)
+	}
+}
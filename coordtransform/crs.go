@@ -0,0 +1,217 @@
+package coordtransform
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// const EPSG codes of the coordinate reference systems registered by default.
+const (
+	EPSGWGS84          = 4326
+	EPSGPseudoMercator = 3857
+	EPSGCGCS2000       = 4490
+
+	// EPSGUTMNorth + zone is WGS 84 / UTM zone N, e.g. 32650.
+	EPSGUTMNorth = 32600
+	// EPSGUTMSouth + zone is WGS 84 / UTM zone S, e.g. 32750.
+	EPSGUTMSouth = 32700
+
+	// EPSGGaussKruger6Zone + zone - 13 is CGCS2000 / Gauss-Kruger zone 13-23, easting with zone prefix.
+	EPSGGaussKruger6Zone = 4491
+	// EPSGGaussKruger6CM + zone - 13 is CGCS2000 / Gauss-Kruger CM 75E-135E.
+	EPSGGaussKruger6CM = 4502
+	// EPSGGaussKruger3Zone + zone - 25 is CGCS2000 / 3-degree Gauss-Kruger zone 25-45, easting with zone prefix.
+	EPSGGaussKruger3Zone = 4513
+	// EPSGGaussKruger3CM + zone - 25 is CGCS2000 / 3-degree Gauss-Kruger CM 75E-135E.
+	EPSGGaussKruger3CM = 4534
+
+	EPSGLambertEurope  = 3034
+	EPSGAlbersConus    = 5070
+	EPSGNSIDCNorth     = 3413
+	EPSGAntarcticPolar = 3031
+	EPSGArcticPolar    = 3995
+	EPSGUPSNorth       = 32661
+	EPSGUPSSouth       = 32761
+)
+
+// Defined errors of the coordinate reference system registry.
+var (
+	ErrUnknownSRID  = errors.New("unknown srid of coordinate reference system")
+	ErrNilCRS       = errors.New("coordinate reference system is nil")
+	ErrInvalidDatum = errors.New("datum shift of coordinate reference system must define both directions")
+)
+
+// DatumShift converts geographic coordinates(unit degree) between datums.
+type DatumShift func(lng, lat float64) (float64, float64)
+
+// CRS describes a coordinate reference system.
+// A CRS is geographic when Projection is nil, otherwise its coordinates are projected.
+// Geographic coordinates of the CRS are converted to WGS84 by ToWGS84 and back by FromWGS84,
+// nil shifts mean the datum is considered identical to WGS84.
+type CRS struct {
+	SRID       int
+	Name       string
+	Ellipsoid  Ellipsoid
+	Projection Projection
+	ToWGS84    DatumShift
+	FromWGS84  DatumShift
+}
+
+// IsProjection returns true if the CRS is projected.
+func (c *CRS) IsProjection() bool {
+	return c.Projection != nil
+}
+
+// Registry is a goroutine-safe set of coordinate reference systems keyed by SRID.
+type Registry struct {
+	mu  sync.RWMutex
+	crs map[int]*CRS
+}
+
+// NewRegistry Creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{crs: map[int]*CRS{}}
+}
+
+// Register adds or replaces the crs keyed by its SRID.
+func (r *Registry) Register(crs *CRS) error {
+	if crs == nil {
+		return ErrNilCRS
+	}
+	if (crs.ToWGS84 == nil) != (crs.FromWGS84 == nil) {
+		return ErrInvalidDatum
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.crs[crs.SRID] = crs
+	return nil
+}
+
+// Lookup returns the crs of srid.
+func (r *Registry) Lookup(srid int) (*CRS, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if crs, ok := r.crs[srid]; ok {
+		return crs, nil
+	}
+	return nil, fmt.Errorf("%w: %d", ErrUnknownSRID, srid)
+}
+
+// SRIDs returns all registered srids.
+func (r *Registry) SRIDs() []int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	srids := make([]int, 0, len(r.crs))
+	for k := range r.crs {
+		srids = append(srids, k)
+	}
+	return srids
+}
+
+// Transformer returns a Transformer which reprojects coordinates from fromSRID to toSRID.
+func (r *Registry) Transformer(fromSRID, toSRID int) (*Transformer, error) {
+	from, err := r.Lookup(fromSRID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := r.Lookup(toSRID)
+	if err != nil {
+		return nil, err
+	}
+	return NewTransformerFunc(fmt.Sprintf("%dTO%d", fromSRID, toSRID), crsTransform(from, to)), nil
+}
+
+// crsTransform builds the pipeline: unproject, datum shift to WGS84, datum shift to target, project.
+func crsTransform(from, to *CRS) func(x, y float64) (float64, float64) {
+	if from.SRID == to.SRID {
+		return func(x, y float64) (float64, float64) {
+			return x, y
+		}
+	}
+	return func(x, y float64) (float64, float64) {
+		if from.Projection != nil {
+			x, y = from.Projection.Inverse(x, y)
+		}
+		if from.ToWGS84 != nil {
+			x, y = from.ToWGS84(x, y)
+		}
+		if to.FromWGS84 != nil {
+			x, y = to.FromWGS84(x, y)
+		}
+		if to.Projection != nil {
+			x, y = to.Projection.Forward(x, y)
+		}
+		return x, y
+	}
+}
+
+var defaultRegistry = newDefaultRegistry()
+
+// DefaultRegistry returns the process-wide registry, it is preloaded with
+// WGS84, Pseudo-Mercator, CGCS2000, Beijing 1954, UTM, CGCS2000 Gauss-Kruger and several conic and polar projections.
+// Xian 1980 has no published transformation to WGS84, register it by HelmertCRS
+// with parameters estimated from local control points.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register adds or replaces the crs in the default registry.
+func Register(crs *CRS) error {
+	return defaultRegistry.Register(crs)
+}
+
+// Lookup returns the crs of srid in the default registry.
+func Lookup(srid int) (*CRS, error) {
+	return defaultRegistry.Lookup(srid)
+}
+
+// NewTransformerWithSRID returns a Transformer of the default registry
+// which reprojects coordinates from fromSRID to toSRID.
+func NewTransformerWithSRID(fromSRID, toSRID int) (*Transformer, error) {
+	return defaultRegistry.Transformer(fromSRID, toSRID)
+}
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	add := func(srid int, name string, ellipsoid Ellipsoid, proj Projection) {
+		_ = r.Register(&CRS{SRID: srid, Name: name, Ellipsoid: ellipsoid, Projection: proj})
+	}
+	add(EPSGWGS84, "WGS 84", WGS84Ellipsoid, nil)
+	add(EPSGPseudoMercator, "WGS 84 / Pseudo-Mercator", WGS84Ellipsoid, WebMercator{})
+	add(EPSGCGCS2000, "China Geodetic Coordinate System 2000", CGCS2000Ellipsoid, nil)
+	_ = r.Register(HelmertCRS(EPSGBeijing1954, "Beijing 1954", Krasovsky1940Ellipsoid, Beijing1954ToWGS84))
+
+	for zone := 1; zone <= 60; zone++ {
+		add(EPSGUTMNorth+zone, fmt.Sprintf("WGS 84 / UTM zone %dN", zone), WGS84Ellipsoid, NewUTM(zone, false))
+		add(EPSGUTMSouth+zone, fmt.Sprintf("WGS 84 / UTM zone %dS", zone), WGS84Ellipsoid, NewUTM(zone, true))
+	}
+	for zone := 13; zone <= 23; zone++ {
+		add(EPSGGaussKruger6Zone+zone-13, fmt.Sprintf("CGCS2000 / Gauss-Kruger zone %d", zone),
+			CGCS2000Ellipsoid, NewGaussKruger6(zone, true))
+		add(EPSGGaussKruger6CM+zone-13, fmt.Sprintf("CGCS2000 / Gauss-Kruger CM %dE", zone*6-3),
+			CGCS2000Ellipsoid, NewGaussKruger6(zone, false))
+	}
+	for zone := 25; zone <= 45; zone++ {
+		add(EPSGGaussKruger3Zone+zone-25, fmt.Sprintf("CGCS2000 / 3-degree Gauss-Kruger zone %d", zone),
+			CGCS2000Ellipsoid, NewGaussKruger3(zone, true))
+		add(EPSGGaussKruger3CM+zone-25, fmt.Sprintf("CGCS2000 / 3-degree Gauss-Kruger CM %dE", zone*3),
+			CGCS2000Ellipsoid, NewGaussKruger3(zone, false))
+	}
+
+	add(EPSGLambertEurope, "ETRS89-extended / LCC Europe", GRS80Ellipsoid,
+		NewLambertConformalConic(GRS80Ellipsoid, 10, 52, 35, 65, 4000000, 2800000))
+	add(EPSGAlbersConus, "NAD83 / Conus Albers", GRS80Ellipsoid,
+		NewAlbersEqualArea(GRS80Ellipsoid, -96, 23, 29.5, 45.5, 0, 0))
+	add(EPSGNSIDCNorth, "WGS 84 / NSIDC Sea Ice Polar Stereographic North", WGS84Ellipsoid,
+		NewPolarStereographic(WGS84Ellipsoid, -45, 70, 1, 0, 0))
+	add(EPSGAntarcticPolar, "WGS 84 / Antarctic Polar Stereographic", WGS84Ellipsoid,
+		NewPolarStereographic(WGS84Ellipsoid, 0, -71, 1, 0, 0))
+	add(EPSGArcticPolar, "WGS 84 / Arctic Polar Stereographic", WGS84Ellipsoid,
+		NewPolarStereographic(WGS84Ellipsoid, 0, 71, 1, 0, 0))
+	add(EPSGUPSNorth, "WGS 84 / UPS North (N,E)", WGS84Ellipsoid,
+		NewPolarStereographic(WGS84Ellipsoid, 0, 90, 0.994, 2000000, 2000000))
+	add(EPSGUPSSouth, "WGS 84 / UPS South (N,E)", WGS84Ellipsoid,
+		NewPolarStereographic(WGS84Ellipsoid, 0, -90, 0.994, 2000000, 2000000))
+	return r
+}
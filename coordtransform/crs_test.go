@@ -0,0 +1,82 @@
+package coordtransform
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestRegistry_Transformer(t *testing.T) {
+	tests := []struct {
+		name      string
+		toSRID    int
+		lng, lat  float64
+		want      matrix.Matrix
+		tolerance float64
+	}{
+		{name: "utm 18N", toSRID: EPSGUTMNorth + 18, lng: -74.0060, lat: 40.7128,
+			want: matrix.Matrix{583959.372, 4507350.998}, tolerance: 0.001},
+		{name: "utm central meridian", toSRID: EPSGUTMNorth + 31, lng: 3, lat: 45,
+			want: matrix.Matrix{500000, 4982950.400}, tolerance: 0.001},
+		{name: "utm 50S", toSRID: EPSGUTMSouth + 50, lng: 117, lat: -30,
+			want: matrix.Matrix{500000, 6681214.647}, tolerance: 0.001},
+		{name: "gauss kruger 6 zone", toSRID: EPSGGaussKruger6Zone + 20 - 13, lng: 117, lat: 31,
+			want: matrix.Matrix{20500000, 3430974.323}, tolerance: 0.001},
+		{name: "gauss kruger 3 cm", toSRID: EPSGGaussKruger3CM + 39 - 25, lng: 117.1, lat: 31.2,
+			want: matrix.Matrix{509530.438, 3453152.869}, tolerance: 0.001},
+		{name: "lambert origin", toSRID: EPSGLambertEurope, lng: 10, lat: 52,
+			want: matrix.Matrix{4000000, 2800000}, tolerance: 0.001},
+		{name: "albers origin", toSRID: EPSGAlbersConus, lng: -96, lat: 23,
+			want: matrix.Matrix{0, 0}, tolerance: 0.001},
+		{name: "polar north", toSRID: EPSGNSIDCNorth, lng: -45, lat: 70,
+			want: matrix.Matrix{0, -2187927.649}, tolerance: 0.001},
+		{name: "polar south", toSRID: EPSGAntarcticPolar, lng: 0, lat: -71,
+			want: matrix.Matrix{0, 2082760.109}, tolerance: 0.001},
+		{name: "ups north pole", toSRID: EPSGUPSNorth, lng: 0, lat: 90,
+			want: matrix.Matrix{2000000, 2000000}, tolerance: 0.001},
+		{name: "pseudo mercator", toSRID: EPSGPseudoMercator, lng: 110, lat: 40,
+			want: matrix.Matrix{12245143.99, 4865942.28}, tolerance: 0.1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forward, err := NewTransformerWithSRID(EPSGWGS84, tt.toSRID)
+			if err != nil {
+				t.Fatalf("NewTransformerWithSRID() error = %v", err)
+			}
+			x, y := forward.TransformLatLng(tt.lng, tt.lat)
+			if got := (matrix.Matrix{x, y}); !got.EqualsExact(tt.want, tt.tolerance) {
+				t.Errorf("Forward got = %v, want %v", got, tt.want)
+			}
+			inverse, _ := NewTransformerWithSRID(tt.toSRID, EPSGWGS84)
+			lng, lat := inverse.TransformLatLng(x, y)
+			if tt.lat != 90 && !(matrix.Matrix{lng, lat}).EqualsExact(matrix.Matrix{tt.lng, tt.lat}, 1e-9) {
+				t.Errorf("Inverse got = %v %v, want %v %v", lng, lat, tt.lng, tt.lat)
+			}
+		})
+	}
+}
+
+func TestRegistry_Register(t *testing.T) {
+	r := NewRegistry()
+	if _, err := r.Transformer(EPSGWGS84, EPSGPseudoMercator); !errors.Is(err, ErrUnknownSRID) {
+		t.Errorf("Transformer() error = %v, want %v", err, ErrUnknownSRID)
+	}
+	if err := r.Register(&CRS{SRID: 1, ToWGS84: WGS84ToGCJ02}); err != ErrInvalidDatum {
+		t.Errorf("Register() error = %v, want %v", err, ErrInvalidDatum)
+	}
+	_ = r.Register(&CRS{SRID: EPSGWGS84, Ellipsoid: WGS84Ellipsoid})
+	_ = r.Register(&CRS{SRID: 900913, Ellipsoid: WGS84Ellipsoid, Projection: NewUTM(50, false),
+		ToWGS84: GCJ02ToWGS84, FromWGS84: WGS84ToGCJ02})
+	tr, err := r.Transformer(EPSGWGS84, 900913)
+	if err != nil {
+		t.Fatalf("Transformer() error = %v", err)
+	}
+	got, _ := tr.TransformGeometry(matrix.LineMatrix{{116.3912757, 39.906217}, {116.4, 39.91}})
+	back, _ := r.Transformer(900913, EPSGWGS84)
+	got, _ = back.TransformGeometry(got)
+	want := matrix.LineMatrix{{116.3912757, 39.906217}, {116.4, 39.91}}
+	if !got.EqualsExact(want, 1e-8) {
+		t.Errorf("TransformGeometry() got = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,90 @@
+package coordtransform
+
+import "math"
+
+// Ellipsoid describes a reference ellipsoid by its semi-major axis and flattening.
+type Ellipsoid struct {
+	Name string
+	// A semi-major axis, unit m.
+	A float64
+	// F flattening.
+	F float64
+}
+
+// Defined reference ellipsoids.
+var (
+	// WGS84Ellipsoid World Geodetic System 1984.
+	WGS84Ellipsoid = Ellipsoid{Name: "WGS84", A: 6378137.0, F: 1 / 298.257223563}
+
+	// CGCS2000Ellipsoid China Geodetic Coordinate System 2000.
+	CGCS2000Ellipsoid = Ellipsoid{Name: "CGCS2000", A: 6378137.0, F: 1 / 298.257222101}
+
+	// GRS80Ellipsoid Geodetic Reference System 1980.
+	GRS80Ellipsoid = Ellipsoid{Name: "GRS80", A: 6378137.0, F: 1 / 298.257222101}
+
+	// Krasovsky1940Ellipsoid used by Beijing 1954.
+	Krasovsky1940Ellipsoid = Ellipsoid{Name: "Krasovsky1940", A: 6378245.0, F: 1 / 298.3}
+
+	// IAG1975Ellipsoid used by Xian 1980.
+	IAG1975Ellipsoid = Ellipsoid{Name: "IAG1975", A: 6378140.0, F: 1 / 298.257}
+)
+
+// B returns the semi-minor axis.
+func (e Ellipsoid) B() float64 {
+	return e.A * (1 - e.F)
+}
+
+// E2 returns the square of first eccentricity.
+func (e Ellipsoid) E2() float64 {
+	return e.F * (2 - e.F)
+}
+
+// Eccentricity returns the first eccentricity.
+func (e Ellipsoid) Eccentricity() float64 {
+	return math.Sqrt(e.E2())
+}
+
+// N returns the third flattening.
+func (e Ellipsoid) N() float64 {
+	return e.F / (2 - e.F)
+}
+
+// ToECEF converts geodetic coordinates to earth-centered earth-fixed coordinates,
+// lng lat unit degree, h ellipsoidal height unit m.
+func (e Ellipsoid) ToECEF(lng, lat, h float64) (x, y, z float64) {
+	e2 := e.E2()
+	phi, lam := lat*degToRad, lng*degToRad
+	sinPhi := math.Sin(phi)
+	n := e.A / math.Sqrt(1-e2*sinPhi*sinPhi)
+	x = (n + h) * math.Cos(phi) * math.Cos(lam)
+	y = (n + h) * math.Cos(phi) * math.Sin(lam)
+	z = (n*(1-e2) + h) * sinPhi
+	return x, y, z
+}
+
+// FromECEF converts earth-centered earth-fixed coordinates to geodetic coordinates,
+// lng lat unit degree, h ellipsoidal height unit m.
+func (e Ellipsoid) FromECEF(x, y, z float64) (lng, lat, h float64) {
+	e2 := e.E2()
+	p := math.Hypot(x, y)
+	lam := math.Atan2(y, x)
+	phi := math.Atan2(z, p*(1-e2))
+	for i := 0; i < projectionMaxIterations; i++ {
+		sinPhi := math.Sin(phi)
+		n := e.A / math.Sqrt(1-e2*sinPhi*sinPhi)
+		next := math.Atan2(z+e2*n*sinPhi, p)
+		if math.Abs(next-phi) < projectionTolerance {
+			phi = next
+			break
+		}
+		phi = next
+	}
+	sinPhi, cosPhi := math.Sin(phi), math.Cos(phi)
+	n := e.A / math.Sqrt(1-e2*sinPhi*sinPhi)
+	if math.Abs(cosPhi) > 1e-10 {
+		h = p/cosPhi - n
+	} else {
+		h = math.Abs(z) - e.B()
+	}
+	return lam * radToDeg, phi * radToDeg, h
+}
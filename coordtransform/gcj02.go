@@ -0,0 +1,106 @@
+package coordtransform
+
+import "math"
+
+// Krasovsky 1940 ellipsoid parameters used by the GCJ02 offset algorithm.
+const (
+	gcjA  = 6378245.0
+	gcjEE = 0.00669342162296594323
+
+	// bdPi is the constant used by the BD09 offset algorithm.
+	bdPi = math.Pi * 3000.0 / 180.0
+
+	// gcjInverseTolerance iteration tolerance (degree) of the GCJ02 inverse transform.
+	gcjInverseTolerance = 1e-10
+	// gcjInverseMaxIterations max iterations of the GCJ02 inverse transform.
+	gcjInverseMaxIterations = 30
+)
+
+// OutOfChina returns true if the lng lat is out of china,
+// the GCJ02 and BD09 offset are not applied to those coordinates.
+func OutOfChina(lng, lat float64) bool {
+	return !(lng > 73.66 && lng < 135.05 && lat > 3.86 && lat < 53.55)
+}
+
+// WGS84ToGCJ02 transform WGS84 to GCJ02 ,unit degree.
+func WGS84ToGCJ02(lng, lat float64) (float64, float64) {
+	if OutOfChina(lng, lat) {
+		return lng, lat
+	}
+	dLng, dLat := gcjDelta(lng, lat)
+	return lng + dLng, lat + dLat
+}
+
+// GCJ02ToWGS84 transform GCJ02 to WGS84 ,unit degree.
+// The offset has no closed form inverse, the result is refined by iteration.
+func GCJ02ToWGS84(lng, lat float64) (float64, float64) {
+	if OutOfChina(lng, lat) {
+		return lng, lat
+	}
+	wgsLng, wgsLat := lng, lat
+	for i := 0; i < gcjInverseMaxIterations; i++ {
+		gcjLng, gcjLat := WGS84ToGCJ02(wgsLng, wgsLat)
+		dLng, dLat := gcjLng-lng, gcjLat-lat
+		wgsLng -= dLng
+		wgsLat -= dLat
+		if math.Abs(dLng) < gcjInverseTolerance && math.Abs(dLat) < gcjInverseTolerance {
+			break
+		}
+	}
+	return wgsLng, wgsLat
+}
+
+// GCJ02ToBD09 transform GCJ02 to BD09 ,unit degree.
+func GCJ02ToBD09(lng, lat float64) (float64, float64) {
+	z := math.Sqrt(lng*lng+lat*lat) + 0.00002*math.Sin(lat*bdPi)
+	theta := math.Atan2(lat, lng) + 0.000003*math.Cos(lng*bdPi)
+	return z*math.Cos(theta) + 0.0065, z*math.Sin(theta) + 0.006
+}
+
+// BD09ToGCJ02 transform BD09 to GCJ02 ,unit degree.
+func BD09ToGCJ02(lng, lat float64) (float64, float64) {
+	x := lng - 0.0065
+	y := lat - 0.006
+	z := math.Sqrt(x*x+y*y) - 0.00002*math.Sin(y*bdPi)
+	theta := math.Atan2(y, x) - 0.000003*math.Cos(x*bdPi)
+	return z * math.Cos(theta), z * math.Sin(theta)
+}
+
+// WGS84ToBD09 transform WGS84 to BD09 ,unit degree.
+func WGS84ToBD09(lng, lat float64) (float64, float64) {
+	return GCJ02ToBD09(WGS84ToGCJ02(lng, lat))
+}
+
+// BD09ToWGS84 transform BD09 to WGS84 ,unit degree.
+func BD09ToWGS84(lng, lat float64) (float64, float64) {
+	return GCJ02ToWGS84(BD09ToGCJ02(lng, lat))
+}
+
+// gcjDelta returns the GCJ02 offset of the WGS84 lng lat.
+func gcjDelta(lng, lat float64) (float64, float64) {
+	dLat := transformLat(lng-105.0, lat-35.0)
+	dLng := transformLng(lng-105.0, lat-35.0)
+	radLat := lat / 180.0 * math.Pi
+	magic := math.Sin(radLat)
+	magic = 1 - gcjEE*magic*magic
+	sqrtMagic := math.Sqrt(magic)
+	dLat = (dLat * 180.0) / ((gcjA * (1 - gcjEE)) / (magic * sqrtMagic) * math.Pi)
+	dLng = (dLng * 180.0) / (gcjA / sqrtMagic * math.Cos(radLat) * math.Pi)
+	return dLng, dLat
+}
+
+func transformLat(x, y float64) float64 {
+	ret := -100.0 + 2.0*x + 3.0*y + 0.2*y*y + 0.1*x*y + 0.2*math.Sqrt(math.Abs(x))
+	ret += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	ret += (20.0*math.Sin(y*math.Pi) + 40.0*math.Sin(y/3.0*math.Pi)) * 2.0 / 3.0
+	ret += (160.0*math.Sin(y/12.0*math.Pi) + 320*math.Sin(y*math.Pi/30.0)) * 2.0 / 3.0
+	return ret
+}
+
+func transformLng(x, y float64) float64 {
+	ret := 300.0 + x + 2.0*y + 0.1*x*x + 0.1*x*y + 0.1*math.Sqrt(math.Abs(x))
+	ret += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	ret += (20.0*math.Sin(x*math.Pi) + 40.0*math.Sin(x/3.0*math.Pi)) * 2.0 / 3.0
+	ret += (150.0*math.Sin(x/12.0*math.Pi) + 300.0*math.Sin(x/30.0*math.Pi)) * 2.0 / 3.0
+	return ret
+}
@@ -0,0 +1,226 @@
+package coordtransform
+
+import (
+	"errors"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+const (
+	// arc-second to radian.
+	secToRad = math.Pi / (180.0 * 3600.0)
+
+	// EPSGBeijing1954 geographic Beijing 1954.
+	EPSGBeijing1954 = 4214
+	// EPSGXian1980 geographic Xian 1980.
+	EPSGXian1980 = 4610
+)
+
+// Defined errors of helmert parameters estimation.
+var (
+	ErrControlPointsNotMatch   = errors.New("source and target control points do not match")
+	ErrControlPointsNotEnough  = errors.New("at least 3 control points are required")
+	ErrControlPointsDegenerate = errors.New("control points are degenerate, e.g. collinear")
+)
+
+// Beijing1954ToWGS84 parameters of Beijing 1954 to WGS 84 (EPSG:15921), accuracy about metres.
+var Beijing1954ToWGS84 = HelmertParameters{DX: 15.8, DY: -154.4, DZ: -82.3}
+
+// HelmertParameters seven parameters of the Bursa-Wolf (Helmert) transformation between ECEF coordinates.
+// Rotations follow the position vector convention (EPSG:9606),
+// set CoordinateFrame for parameters published in the coordinate frame convention (EPSG:9607).
+type HelmertParameters struct {
+	// DX DY DZ translations, unit m.
+	DX, DY, DZ float64
+	// RX RY RZ rotations, unit arc-second.
+	RX, RY, RZ float64
+	// DS scale difference, unit ppm.
+	DS float64
+
+	CoordinateFrame bool
+}
+
+// rotations returns the position vector rotations, unit radian.
+func (p HelmertParameters) rotations() (float64, float64, float64) {
+	rx, ry, rz := p.RX*secToRad, p.RY*secToRad, p.RZ*secToRad
+	if p.CoordinateFrame {
+		return -rx, -ry, -rz
+	}
+	return rx, ry, rz
+}
+
+// Transform applies the transformation to ECEF coordinates.
+func (p HelmertParameters) Transform(x, y, z float64) (float64, float64, float64) {
+	rx, ry, rz := p.rotations()
+	m := 1 + p.DS*1e-6
+	return p.DX + m*(x-rz*y+ry*z),
+		p.DY + m*(rz*x+y-rx*z),
+		p.DZ + m*(-ry*x+rx*y+z)
+}
+
+// InverseTransform applies the exact inverse of the transformation to ECEF coordinates.
+func (p HelmertParameters) InverseTransform(x, y, z float64) (float64, float64, float64) {
+	rx, ry, rz := p.rotations()
+	m := 1 + p.DS*1e-6
+	x, y, z = (x-p.DX)/m, (y-p.DY)/m, (z-p.DZ)/m
+	r := [3][3]float64{
+		{1, -rz, ry},
+		{rz, 1, -rx},
+		{-ry, rx, 1},
+	}
+	sol, _ := solve([][]float64{
+		{r[0][0], r[0][1], r[0][2], x},
+		{r[1][0], r[1][1], r[1][2], y},
+		{r[2][0], r[2][1], r[2][2], z},
+	})
+	return sol[0], sol[1], sol[2]
+}
+
+// Inverse returns the approximate reverse parameters, which are the negated parameters.
+// Use InverseTransform for the exact reverse transformation.
+func (p HelmertParameters) Inverse() HelmertParameters {
+	return HelmertParameters{
+		DX: -p.DX, DY: -p.DY, DZ: -p.DZ,
+		RX: -p.RX, RY: -p.RY, RZ: -p.RZ,
+		DS:              -p.DS,
+		CoordinateFrame: p.CoordinateFrame,
+	}
+}
+
+// HelmertShift returns the datum shifts between geographic coordinates on the ellipsoid and WGS84,
+// params transform the ECEF coordinates of the ellipsoid datum to WGS84. Heights are taken as 0.
+func HelmertShift(ellipsoid Ellipsoid, params HelmertParameters) (toWGS84, fromWGS84 DatumShift) {
+	toWGS84 = func(lng, lat float64) (float64, float64) {
+		x, y, z := ellipsoid.ToECEF(lng, lat, 0)
+		x, y, z = params.Transform(x, y, z)
+		lng, lat, _ = WGS84Ellipsoid.FromECEF(x, y, z)
+		return lng, lat
+	}
+	fromWGS84 = func(lng, lat float64) (float64, float64) {
+		x, y, z := WGS84Ellipsoid.ToECEF(lng, lat, 0)
+		x, y, z = params.InverseTransform(x, y, z)
+		lng, lat, _ = ellipsoid.FromECEF(x, y, z)
+		return lng, lat
+	}
+	return
+}
+
+// HelmertCRS Creates a geographic CRS whose datum is shifted to WGS84 by the helmert params,
+// register it to make the datum available to Transformer.
+func HelmertCRS(srid int, name string, ellipsoid Ellipsoid, params HelmertParameters) *CRS {
+	toWGS84, fromWGS84 := HelmertShift(ellipsoid, params)
+	return &CRS{SRID: srid, Name: name, Ellipsoid: ellipsoid, ToWGS84: toWGS84, FromWGS84: fromWGS84}
+}
+
+// EstimateHelmert estimates position vector helmert parameters transforming
+// the source ECEF control points to the target ECEF control points by least squares.
+// It returns the parameters and the root mean square of the residuals, unit m.
+func EstimateHelmert(source, target []matrix.Matrix) (HelmertParameters, float64, error) {
+	if len(source) != len(target) {
+		return HelmertParameters{}, 0, ErrControlPointsNotMatch
+	}
+	if len(source) < 3 {
+		return HelmertParameters{}, 0, ErrControlPointsNotEnough
+	}
+	// reduce to centroid for a well conditioned normal matrix.
+	c := [3]float64{}
+	for _, v := range source {
+		for k := 0; k < 3; k++ {
+			c[k] += v[k] / float64(len(source))
+		}
+	}
+	normal := make([][]float64, 7)
+	for i := range normal {
+		normal[i] = make([]float64, 8)
+	}
+	for i, s := range source {
+		x, y, z := s[0]-c[0], s[1]-c[1], s[2]-c[2]
+		rows := [3][8]float64{
+			{1, 0, 0, 0, z, -y, x, target[i][0] - s[0]},
+			{0, 1, 0, -z, 0, x, y, target[i][1] - s[1]},
+			{0, 0, 1, y, -x, 0, z, target[i][2] - s[2]},
+		}
+		for _, row := range rows {
+			for j := 0; j < 7; j++ {
+				for k := 0; k < 8; k++ {
+					normal[j][k] += row[j] * row[k]
+				}
+			}
+		}
+	}
+	sol, err := solve(normal)
+	if err != nil {
+		return HelmertParameters{}, 0, err
+	}
+	rx, ry, rz, s := sol[3], sol[4], sol[5], sol[6]
+	params := HelmertParameters{
+		// recover translation of the unreduced model: T = T' - (s + R)c.
+		DX: sol[0] - (s*c[0] - rz*c[1] + ry*c[2]),
+		DY: sol[1] - (rz*c[0] + s*c[1] - rx*c[2]),
+		DZ: sol[2] - (-ry*c[0] + rx*c[1] + s*c[2]),
+		RX: rx / secToRad, RY: ry / secToRad, RZ: rz / secToRad,
+		DS: s * 1e6,
+	}
+	sum := 0.0
+	for i, v := range source {
+		x, y, z := params.Transform(v[0], v[1], v[2])
+		dx, dy, dz := x-target[i][0], y-target[i][1], z-target[i][2]
+		sum += dx*dx + dy*dy + dz*dz
+	}
+	return params, math.Sqrt(sum / float64(len(source))), nil
+}
+
+// EstimateHelmertGeodetic estimates helmert parameters from geodetic control points {lng, lat[, h]}
+// of the source ellipsoid and the target ellipsoid.
+func EstimateHelmertGeodetic(sourceEllipsoid, targetEllipsoid Ellipsoid,
+	source, target []matrix.Matrix) (HelmertParameters, float64, error) {
+	if len(source) != len(target) {
+		return HelmertParameters{}, 0, ErrControlPointsNotMatch
+	}
+	toECEF := func(e Ellipsoid, pts []matrix.Matrix) []matrix.Matrix {
+		ecef := make([]matrix.Matrix, len(pts))
+		for i, v := range pts {
+			h := 0.0
+			if len(v) > 2 {
+				h = v[2]
+			}
+			x, y, z := e.ToECEF(v[0], v[1], h)
+			ecef[i] = matrix.Matrix{x, y, z}
+		}
+		return ecef
+	}
+	return EstimateHelmert(toECEF(sourceEllipsoid, source), toECEF(targetEllipsoid, target))
+}
+
+// solve solves the linear equations of the augmented matrix by gaussian elimination with partial pivoting.
+func solve(aug [][]float64) ([]float64, error) {
+	n := len(aug)
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(aug[row][col]) > math.Abs(aug[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(aug[pivot][col]) < 1e-12 {
+			return nil, ErrControlPointsDegenerate
+		}
+		aug[col], aug[pivot] = aug[pivot], aug[col]
+		for row := col + 1; row < n; row++ {
+			f := aug[row][col] / aug[col][col]
+			for k := col; k <= n; k++ {
+				aug[row][k] -= f * aug[col][k]
+			}
+		}
+	}
+	sol := make([]float64, n)
+	for row := n - 1; row >= 0; row-- {
+		sum := aug[row][n]
+		for k := row + 1; k < n; k++ {
+			sum -= aug[row][k] * sol[k]
+		}
+		sol[row] = sum / aug[row][row]
+	}
+	return sol, nil
+}
@@ -0,0 +1,95 @@
+package coordtransform
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestEllipsoid_ECEF(t *testing.T) {
+	tests := []struct {
+		name        string
+		lng, lat, h float64
+		want        matrix.Matrix
+	}{
+		{name: "equator", lng: 0, lat: 0, h: 0, want: matrix.Matrix{6378137, 0, 0}},
+		{name: "north pole", lng: 0, lat: 90, h: 100, want: matrix.Matrix{0, 0, 6356752.314245 + 100}},
+		{name: "beijing", lng: 116.39, lat: 39.9, h: 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			x, y, z := WGS84Ellipsoid.ToECEF(tt.lng, tt.lat, tt.h)
+			if tt.want != nil && math.Abs(x-tt.want[0])+math.Abs(y-tt.want[1])+math.Abs(z-tt.want[2]) > 1e-6 {
+				t.Errorf("ToECEF() got = %v %v %v, want %v", x, y, z, tt.want)
+			}
+			lng, lat, h := WGS84Ellipsoid.FromECEF(x, y, z)
+			if math.Abs(lng-tt.lng) > 1e-10 || math.Abs(lat-tt.lat) > 1e-10 || math.Abs(h-tt.h) > 1e-6 {
+				t.Errorf("FromECEF() got = %v %v %v, want %v %v %v", lng, lat, h, tt.lng, tt.lat, tt.h)
+			}
+		})
+	}
+}
+
+func TestHelmertParameters_Transform(t *testing.T) {
+	params := HelmertParameters{DX: -24.0, DY: 123.0, DZ: 94.0, RX: 0.02, RY: -0.25, RZ: -0.13, DS: 1.1}
+	x, y, z := Krasovsky1940Ellipsoid.ToECEF(116.39, 39.9, 0)
+	tx, ty, tz := params.Transform(x, y, z)
+	bx, by, bz := params.InverseTransform(tx, ty, tz)
+	if math.Abs(bx-x)+math.Abs(by-y)+math.Abs(bz-z) > 1e-6 {
+		t.Errorf("InverseTransform() got = %v %v %v, want %v %v %v", bx, by, bz, x, y, z)
+	}
+
+	frame := params
+	frame.RX, frame.RY, frame.RZ, frame.CoordinateFrame = -params.RX, -params.RY, -params.RZ, true
+	fx, fy, fz := frame.Transform(x, y, z)
+	if math.Abs(fx-tx)+math.Abs(fy-ty)+math.Abs(fz-tz) > 1e-9 {
+		t.Errorf("Transform() coordinate frame got = %v %v %v, want %v %v %v", fx, fy, fz, tx, ty, tz)
+	}
+}
+
+func TestEstimateHelmert(t *testing.T) {
+	want := HelmertParameters{DX: -24.0, DY: 123.0, DZ: 94.0, RX: 0.02, RY: -0.25, RZ: -0.13, DS: 1.1}
+	source, target := []matrix.Matrix{}, []matrix.Matrix{}
+	for _, v := range []matrix.Matrix{{115.9, 39.5, 20}, {117.2, 40.3, 80}, {116.1, 40.9, 500}, {117.0, 39.2, 5}, {116.5, 39.9, 60}} {
+		source = append(source, v)
+		x, y, z := IAG1975Ellipsoid.ToECEF(v[0], v[1], v[2])
+		x, y, z = want.Transform(x, y, z)
+		lng, lat, h := WGS84Ellipsoid.FromECEF(x, y, z)
+		target = append(target, matrix.Matrix{lng, lat, h})
+	}
+	got, rms, err := EstimateHelmertGeodetic(IAG1975Ellipsoid, WGS84Ellipsoid, source, target)
+	if err != nil {
+		t.Fatalf("EstimateHelmertGeodetic() error = %v", err)
+	}
+	if rms > 0.001 {
+		t.Errorf("EstimateHelmertGeodetic() rms = %v", rms)
+	}
+	if math.Abs(got.DX-want.DX) > 0.01 || math.Abs(got.RZ-want.RZ) > 0.001 || math.Abs(got.DS-want.DS) > 0.001 {
+		t.Errorf("EstimateHelmertGeodetic() got = %+v, want %+v", got, want)
+	}
+
+	if _, _, err := EstimateHelmert(source[:2], target[:2]); err != ErrControlPointsNotEnough {
+		t.Errorf("EstimateHelmert() error = %v, want %v", err, ErrControlPointsNotEnough)
+	}
+	collinear := []matrix.Matrix{{1, 0, 0}, {2, 0, 0}, {3, 0, 0}}
+	if _, _, err := EstimateHelmert(collinear, collinear); err != ErrControlPointsDegenerate {
+		t.Errorf("EstimateHelmert() error = %v, want %v", err, ErrControlPointsDegenerate)
+	}
+}
+
+func TestHelmertCRS(t *testing.T) {
+	tr, err := NewTransformerWithSRID(EPSGBeijing1954, EPSGWGS84)
+	if err != nil {
+		t.Fatalf("NewTransformerWithSRID() error = %v", err)
+	}
+	lng, lat := tr.TransformLatLng(116.39, 39.9)
+	if d := math.Hypot((lng-116.39)*85000, (lat-39.9)*111000); d < 10 || d > 300 {
+		t.Errorf("TransformLatLng() shift = %v m", d)
+	}
+	back, _ := NewTransformerWithSRID(EPSGWGS84, EPSGBeijing1954)
+	bLng, bLat := back.TransformLatLng(lng, lat)
+	if !(matrix.Matrix{bLng, bLat}).EqualsExact(matrix.Matrix{116.39, 39.9}, 1e-7) {
+		t.Errorf("TransformLatLng() back = %v %v", bLng, bLat)
+	}
+}
@@ -0,0 +1,85 @@
+package coordtransform
+
+import "math"
+
+const (
+	// degree to radian.
+	degToRad = math.Pi / 180.0
+	// radian to degree.
+	radToDeg = 180.0 / math.Pi
+
+	// projectionTolerance iteration tolerance (radian) of projection inverse.
+	projectionTolerance = 1e-12
+	// projectionMaxIterations max iterations of projection inverse.
+	projectionMaxIterations = 15
+)
+
+// Projection is the interface implemented by a map projection,
+// lng lat are geographic coordinates in degree, x y are projected coordinates in m.
+type Projection interface {
+	// Forward projects the geographic coordinates.
+	Forward(lng, lat float64) (x, y float64)
+
+	// Inverse unprojects the projected coordinates.
+	Inverse(x, y float64) (lng, lat float64)
+}
+
+// WebMercator Pseudo-Mercator projection used by web maps.
+type WebMercator struct{}
+
+// Forward projects the geographic coordinates.
+func (WebMercator) Forward(lng, lat float64) (float64, float64) {
+	return LLToMercator(lng, lat)
+}
+
+// Inverse unprojects the projected coordinates.
+func (WebMercator) Inverse(x, y float64) (float64, float64) {
+	return MercatorToLL(x, y)
+}
+
+// normalizeLng returns lng-centralMeridian normalized to [-pi, pi], unit radian.
+func normalizeLng(lng, centralMeridian float64) float64 {
+	lam := (lng - centralMeridian) * degToRad
+	for lam > math.Pi {
+		lam -= 2 * math.Pi
+	}
+	for lam < -math.Pi {
+		lam += 2 * math.Pi
+	}
+	return lam
+}
+
+// conformalT the t function of conformal latitude(Snyder 15-9).
+func conformalT(phi, e float64) float64 {
+	sinPhi := e * math.Sin(phi)
+	return math.Tan(math.Pi/4-phi/2) / math.Pow((1-sinPhi)/(1+sinPhi), e/2)
+}
+
+// conformalM the m function of parallel radius(Snyder 14-15).
+func conformalM(phi, e2 float64) float64 {
+	sinPhi := math.Sin(phi)
+	return math.Cos(phi) / math.Sqrt(1-e2*sinPhi*sinPhi)
+}
+
+// phiFromT computes latitude from the t function by iteration(Snyder 7-9).
+func phiFromT(t, e float64) float64 {
+	phi := math.Pi/2 - 2*math.Atan(t)
+	for i := 0; i < projectionMaxIterations; i++ {
+		sinPhi := e * math.Sin(phi)
+		next := math.Pi/2 - 2*math.Atan(t*math.Pow((1-sinPhi)/(1+sinPhi), e/2))
+		if math.Abs(next-phi) < projectionTolerance {
+			return next
+		}
+		phi = next
+	}
+	return phi
+}
+
+// compile time checks
+var (
+	_ Projection = WebMercator{}
+	_ Projection = &TransverseMercator{}
+	_ Projection = &LambertConformalConic{}
+	_ Projection = &AlbersEqualArea{}
+	_ Projection = &PolarStereographic{}
+)
@@ -0,0 +1,165 @@
+package coordtransform
+
+import (
+	"math"
+)
+
+// TransverseMercator Transverse Mercator projection on the ellipsoid,
+// computed by the Krüger series (6th order of the third flattening),
+// which keeps millimetre accuracy within a few thousand kilometres of the central meridian.
+// Use NewTransverseMercator to create it.
+type TransverseMercator struct {
+	Ellipsoid       Ellipsoid
+	CentralMeridian float64
+	LatOrigin       float64
+	Scale           float64
+	FalseEasting    float64
+	FalseNorthing   float64
+
+	e, rectA, northingOrigin float64
+	alpha, beta              [6]float64
+}
+
+// NewTransverseMercator Creates a Transverse Mercator projection,
+// centralMeridian and latOrigin unit degree.
+func NewTransverseMercator(ellipsoid Ellipsoid, centralMeridian, latOrigin, scale, falseEasting, falseNorthing float64) *TransverseMercator {
+	tm := &TransverseMercator{
+		Ellipsoid:       ellipsoid,
+		CentralMeridian: centralMeridian,
+		LatOrigin:       latOrigin,
+		Scale:           scale,
+		FalseEasting:    falseEasting,
+		FalseNorthing:   falseNorthing,
+	}
+	n := ellipsoid.N()
+	n2, n3 := n*n, n*n*n
+	n4, n5, n6 := n3*n, n3*n2, n3*n3
+
+	tm.e = ellipsoid.Eccentricity()
+	tm.rectA = ellipsoid.A / (1 + n) * (1 + n2/4 + n4/64 + n6/256)
+	tm.alpha = [6]float64{
+		n/2 - 2*n2/3 + 5*n3/16 + 41*n4/180 - 127*n5/288 + 7891*n6/37800,
+		13*n2/48 - 3*n3/5 + 557*n4/1440 + 281*n5/630 - 1983433*n6/1935360,
+		61*n3/240 - 103*n4/140 + 15061*n5/26880 + 167603*n6/181440,
+		49561*n4/161280 - 179*n5/168 + 6601661*n6/7257600,
+		34729*n5/80640 - 3418889*n6/1995840,
+		212378941 * n6 / 319334400,
+	}
+	tm.beta = [6]float64{
+		n/2 - 2*n2/3 + 37*n3/96 - n4/360 - 81*n5/512 + 96199*n6/604800,
+		n2/48 + n3/15 - 437*n4/1440 + 46*n5/105 - 1118711*n6/3870720,
+		17*n3/480 - 37*n4/840 - 209*n5/4480 + 5569*n6/90720,
+		4397*n4/161280 - 11*n5/504 - 830251*n6/7257600,
+		4583*n5/161280 - 108847*n6/3991680,
+		20648693 * n6 / 638668800,
+	}
+	if latOrigin != 0 {
+		_, tm.northingOrigin = tm.project(latOrigin*degToRad, 0)
+	}
+	return tm
+}
+
+// NewUTM Creates a Universal Transverse Mercator projection of zone(1-60) on WGS84.
+func NewUTM(zone int, south bool) *TransverseMercator {
+	falseNorthing := 0.0
+	if south {
+		falseNorthing = 10000000.0
+	}
+	return NewTransverseMercator(WGS84Ellipsoid, float64(zone*6-183), 0, 0.9996, 500000.0, falseNorthing)
+}
+
+// NewGaussKruger6 Creates a 6 degree zone(1-60) Gauss-Krüger projection on CGCS2000.
+// If withZonePrefix the zone number is prefixed to the easting, e.g. 20500000 for zone 20.
+func NewGaussKruger6(zone int, withZonePrefix bool) *TransverseMercator {
+	return NewTransverseMercator(CGCS2000Ellipsoid, float64(zone*6-3), 0, 1.0, gaussKrugerFalseEasting(zone, withZonePrefix), 0)
+}
+
+// NewGaussKruger3 Creates a 3 degree zone(1-120) Gauss-Krüger projection on CGCS2000.
+// If withZonePrefix the zone number is prefixed to the easting, e.g. 39500000 for zone 39.
+func NewGaussKruger3(zone int, withZonePrefix bool) *TransverseMercator {
+	return NewTransverseMercator(CGCS2000Ellipsoid, float64(zone*3), 0, 1.0, gaussKrugerFalseEasting(zone, withZonePrefix), 0)
+}
+
+// UTMZone returns the UTM zone of the longitude.
+func UTMZone(lng float64) int {
+	return zoneOf(lng+180, 6, 60)
+}
+
+// GaussKrugerZone6 returns the 6 degree Gauss-Krüger zone of the longitude.
+func GaussKrugerZone6(lng float64) int {
+	return zoneOf(lng, 6, 60)
+}
+
+// GaussKrugerZone3 returns the 3 degree Gauss-Krüger zone of the longitude.
+func GaussKrugerZone3(lng float64) int {
+	return zoneOf(lng-1.5, 3, 120)
+}
+
+func zoneOf(lng, width float64, maxZone int) int {
+	zone := int(math.Floor(lng/width)) + 1
+	zone = ((zone-1)%maxZone+maxZone)%maxZone + 1
+	return zone
+}
+
+func gaussKrugerFalseEasting(zone int, withZonePrefix bool) float64 {
+	if withZonePrefix {
+		return float64(zone)*1000000.0 + 500000.0
+	}
+	return 500000.0
+}
+
+// Forward projects the geographic coordinates.
+func (tm *TransverseMercator) Forward(lng, lat float64) (float64, float64) {
+	x, y := tm.project(lat*degToRad, normalizeLng(lng, tm.CentralMeridian))
+	return x + tm.FalseEasting, y - tm.northingOrigin + tm.FalseNorthing
+}
+
+// Inverse unprojects the projected coordinates.
+func (tm *TransverseMercator) Inverse(x, y float64) (float64, float64) {
+	eta := (x - tm.FalseEasting) / (tm.Scale * tm.rectA)
+	xi := (y - tm.FalseNorthing + tm.northingOrigin) / (tm.Scale * tm.rectA)
+
+	xiPrime, etaPrime := xi, eta
+	for j := 1; j <= 6; j++ {
+		xiPrime -= tm.beta[j-1] * math.Sin(2*float64(j)*xi) * math.Cosh(2*float64(j)*eta)
+		etaPrime -= tm.beta[j-1] * math.Cos(2*float64(j)*xi) * math.Sinh(2*float64(j)*eta)
+	}
+
+	sinhEtaPrime := math.Sinh(etaPrime)
+	sinXiPrime, cosXiPrime := math.Sin(xiPrime), math.Cos(xiPrime)
+	tauPrime := sinXiPrime / math.Sqrt(sinhEtaPrime*sinhEtaPrime+cosXiPrime*cosXiPrime)
+
+	e2 := tm.e * tm.e
+	tau := tauPrime
+	for i := 0; i < projectionMaxIterations; i++ {
+		sigma := math.Sinh(tm.e * math.Atanh(tm.e*tau/math.Sqrt(1+tau*tau)))
+		tauI := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+		dTau := (tauPrime - tauI) / math.Sqrt(1+tauI*tauI) *
+			(1 + (1-e2)*tau*tau) / ((1 - e2) * math.Sqrt(1+tau*tau))
+		tau += dTau
+		if math.Abs(dTau) < projectionTolerance {
+			break
+		}
+	}
+	phi := math.Atan(tau)
+	lam := math.Atan2(sinhEtaPrime, cosXiPrime)
+	return tm.CentralMeridian + lam*radToDeg, phi * radToDeg
+}
+
+// project computes the unshifted easting and northing, phi and lam unit radian.
+func (tm *TransverseMercator) project(phi, lam float64) (float64, float64) {
+	cosLam, sinLam := math.Cos(lam), math.Sin(lam)
+	tau := math.Tan(phi)
+	sigma := math.Sinh(tm.e * math.Atanh(tm.e*tau/math.Sqrt(1+tau*tau)))
+	tauPrime := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+
+	xiPrime := math.Atan2(tauPrime, cosLam)
+	etaPrime := math.Asinh(sinLam / math.Sqrt(tauPrime*tauPrime+cosLam*cosLam))
+
+	xi, eta := xiPrime, etaPrime
+	for j := 1; j <= 6; j++ {
+		xi += tm.alpha[j-1] * math.Sin(2*float64(j)*xiPrime) * math.Cosh(2*float64(j)*etaPrime)
+		eta += tm.alpha[j-1] * math.Cos(2*float64(j)*xiPrime) * math.Sinh(2*float64(j)*etaPrime)
+	}
+	return tm.Scale * tm.rectA * eta, tm.Scale * tm.rectA * xi
+}
@@ -30,8 +30,8 @@ func readCollection(r io.Reader, order byteOrder, buf []byte) (space.Collection,
 	return result, nil
 }
 
-func (e *Encoder) writeCollection(c space.Collection) error {
-	e.order.PutUint32(e.buf, geometryCollectionType)
+func (e *Encoder) writeCollection(c space.Collection, layout space.CoordLayout) error {
+	e.order.PutUint32(e.buf, isoGeometryType(geometryCollectionType, layout))
 	e.order.PutUint32(e.buf[4:], uint32(len(c)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
@@ -39,7 +39,7 @@ func (e *Encoder) writeCollection(c space.Collection) error {
 	}
 
 	for _, geom := range c {
-		err := e.Encode(geom)
+		err := e.EncodeLayout(geom, layout)
 		if err != nil {
 			return err
 		}
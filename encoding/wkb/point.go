@@ -61,34 +61,18 @@ func unmarshalPoint(order byteOrder, buf []byte) (space.Point, error) {
 	return p, nil
 }
 
-func readPoint(r io.Reader, order byteOrder, buf []byte) (space.Point, error) {
-	var p space.Point
-
-	for i := 0; i < 2; i++ {
-		if _, err := io.ReadFull(r, buf); err != nil {
-			return space.Point{}, err
-		}
-		if order == littleEndian {
-			p = append(p, math.Float64frombits(binary.LittleEndian.Uint64(buf)))
-		} else {
-			p = append(p, math.Float64frombits(binary.BigEndian.Uint64(buf)))
-		}
-	}
-
-	return p, nil
+func readPoint(r io.Reader, order byteOrder, buf []byte, layout space.CoordLayout) (space.Point, error) {
+	return readCoords(r, order, buf, layout)
 }
 
-func (e *Encoder) writePoint(p space.Point) error {
-	e.order.PutUint32(e.buf, pointType)
+func (e *Encoder) writePoint(p space.Point, layout space.CoordLayout) error {
+	e.order.PutUint32(e.buf, isoGeometryType(pointType, layout))
 	_, err := e.w.Write(e.buf[:4])
 	if err != nil {
 		return err
 	}
 
-	e.order.PutUint64(e.buf, math.Float64bits(p[0]))
-	e.order.PutUint64(e.buf[8:], math.Float64bits(p[1]))
-	_, err = e.w.Write(e.buf)
-	return err
+	return e.writeCoords(p, layout)
 }
 
 func unmarshalMultiPoint(order byteOrder, data []byte) (space.MultiPoint, error) {
@@ -132,7 +116,7 @@ func readMultiPoint(r io.Reader, order byteOrder, buf []byte) (space.MultiPoint,
 	result := make(space.MultiPoint, 0, alloc)
 
 	for i := 0; i < int(num); i++ {
-		pOrder, typ, err := readByteOrderType(r, buf)
+		pOrder, typ, layout, err := readGeometryType(r, buf)
 		if err != nil {
 			return nil, err
 		}
@@ -141,7 +125,7 @@ func readMultiPoint(r io.Reader, order byteOrder, buf []byte) (space.MultiPoint,
 			return nil, errors.New("expect multipoint to contains points, did not find a point")
 		}
 
-		p, err := readPoint(r, pOrder, buf)
+		p, err := readPoint(r, pOrder, buf, layout)
 		if err != nil {
 			return nil, err
 		}
@@ -152,8 +136,8 @@ func readMultiPoint(r io.Reader, order byteOrder, buf []byte) (space.MultiPoint,
 	return result, nil
 }
 
-func (e *Encoder) writeMultiPoint(mp space.MultiPoint) error {
-	e.order.PutUint32(e.buf, multiPointType)
+func (e *Encoder) writeMultiPoint(mp space.MultiPoint, layout space.CoordLayout) error {
+	e.order.PutUint32(e.buf, isoGeometryType(multiPointType, layout))
 	e.order.PutUint32(e.buf[4:], uint32(len(mp)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
@@ -161,7 +145,7 @@ func (e *Encoder) writeMultiPoint(mp space.MultiPoint) error {
 	}
 
 	for _, p := range mp {
-		err := e.Encode(space.Point(p))
+		err := e.EncodeLayout(space.Point(p), layout)
 		if err != nil {
 			return err
 		}
@@ -48,7 +48,8 @@ var (
 type GeometryScanner struct {
 	g        interface{}
 	Geometry space.Geometry
-	Valid    bool // Valid is true if the geometry is not NULL
+	Layout   space.CoordLayout // Layout is the layout of the coordinates of the geometry
+	Valid    bool              // Valid is true if the geometry is not NULL
 }
 
 // Scanner will return a GeometryScanner that can scan sql query results.
@@ -88,6 +89,7 @@ func Scanner(g interface{}) *GeometryScanner {
 func (s *GeometryScanner) Scan(d interface{}) error {
 	s.Geometry = nil
 	s.Valid = false
+	s.Layout = space.LayoutXY
 
 	if d == nil {
 		return nil
@@ -113,6 +115,10 @@ func (s *GeometryScanner) Scan(d interface{}) error {
 		data = data[:n]
 	}
 
+	if _, typ, _, err := unmarshalByteOrderType(data); err == nil && typ > geometryCollectionType {
+		return s.scanLayout(data)
+	}
+
 	switch g := s.g.(type) {
 	case nil:
 		m, err := Unmarshal(data)
@@ -223,6 +229,43 @@ func (s *GeometryScanner) Scan(d interface{}) error {
 	return ErrIncorrectGeometry
 }
 
+// scanLayout scans the data of a geometry with Z or M coordinates into a geometry of the same type.
+func (s *GeometryScanner) scanLayout(data []byte) error {
+	m, layout, err := UnmarshalLayout(data)
+	if err != nil {
+		return err
+	}
+
+	ok := true
+	switch g := s.g.(type) {
+	case nil:
+	case *space.Point:
+		*g, ok = m.(space.Point)
+	case *space.MultiPoint:
+		*g, ok = m.(space.MultiPoint)
+	case *space.LineString:
+		*g, ok = m.(space.LineString)
+	case *space.MultiLineString:
+		*g, ok = m.(space.MultiLineString)
+	case *space.Polygon:
+		*g, ok = m.(space.Polygon)
+	case *space.MultiPolygon:
+		*g, ok = m.(space.MultiPolygon)
+	case *space.Collection:
+		*g, ok = m.(space.Collection)
+	default:
+		ok = false
+	}
+	if !ok {
+		return ErrIncorrectGeometry
+	}
+
+	s.Geometry = m
+	s.Layout = layout
+	s.Valid = true
+	return nil
+}
+
 func scanPoint(data []byte) (space.Point, error) {
 	order, typ, data, err := unmarshalByteOrderType(data)
 	if err != nil {
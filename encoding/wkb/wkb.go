@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"io"
+	"math"
 
 	"github.com/spatial-go/geoos/space"
 )
@@ -29,6 +30,13 @@ const (
 	geometryCollectionType uint32 = 7
 )
 
+// The flags of the geometry type of EWKB.
+const (
+	ewkbZFlag    uint32 = 0x80000000
+	ewkbMFlag    uint32 = 0x40000000
+	ewkbSRIDFlag uint32 = 0x20000000
+)
+
 const (
 	// limits so that bad data can't come in and preallocate tons of memory.
 	// Well formed data with less elements will allocate the correct amount just fine.
@@ -92,6 +100,11 @@ func MustMarshal(geom space.Geometry, bo ...byteOrder) []byte {
 
 // Marshal encodes the geometry with the given byte order.
 func Marshal(geom space.Geometry, bo ...byteOrder) ([]byte, error) {
+	return MarshalLayout(geom, space.GeometryLayout(geom), bo...)
+}
+
+// MarshalLayout encodes the geometry with the coordinates of the layout with the given byte order.
+func MarshalLayout(geom space.Geometry, layout space.CoordLayout, bo ...byteOrder) ([]byte, error) {
 	buf := bytes.NewBuffer(make([]byte, 0, geomLength(geom)))
 
 	e := NewEncoder(buf)
@@ -99,7 +112,7 @@ func Marshal(geom space.Geometry, bo ...byteOrder) ([]byte, error) {
 		e.order = bo[0]
 	}
 
-	err := e.Encode(geom)
+	err := e.EncodeLayout(geom, layout)
 	if err != nil {
 		return nil, err
 	}
@@ -121,6 +134,11 @@ func NewEncoder(w io.Writer) *Encoder {
 
 // Encode will write the geometry encoded as WKB to the given writer.
 func (e *Encoder) Encode(geom space.Geometry) error {
+	return e.EncodeLayout(geom, space.GeometryLayout(geom))
+}
+
+// EncodeLayout will write the geometry encoded as WKB with the coordinates of the layout to the given writer.
+func (e *Encoder) EncodeLayout(geom space.Geometry, layout space.CoordLayout) error {
 	if geom == nil || geom.IsEmpty() {
 		return nil
 	}
@@ -153,19 +171,19 @@ func (e *Encoder) Encode(geom space.Geometry) error {
 
 	switch g := geom.(type) {
 	case space.Point:
-		return e.writePoint(g)
+		return e.writePoint(g, layout)
 	case space.MultiPoint:
-		return e.writeMultiPoint(g)
+		return e.writeMultiPoint(g, layout)
 	case space.LineString:
-		return e.writeLineString(g)
+		return e.writeLineString(g, layout)
 	case space.MultiLineString:
-		return e.writeMultiLineString(g)
+		return e.writeMultiLineString(g, layout)
 	case space.Polygon:
-		return e.writePolygon(g)
+		return e.writePolygon(g, layout)
 	case space.MultiPolygon:
-		return e.writeMultiPolygon(g)
+		return e.writeMultiPolygon(g, layout)
 	case space.Collection:
-		return e.writeCollection(g)
+		return e.writeCollection(g, layout)
 	}
 
 	return ErrUnknownWKBType
@@ -178,11 +196,32 @@ type Decoder struct {
 
 // Unmarshal will decode the type into a Geometry.
 func Unmarshal(data []byte) (space.Geometry, error) {
+	geom, _, err := UnmarshalLayout(data)
+	return geom, err
+}
+
+// UnmarshalLayout will decode the type into a Geometry and returns the layout of its coordinates.
+func UnmarshalLayout(data []byte) (space.Geometry, space.CoordLayout, error) {
 	order, typ, data, err := unmarshalByteOrderType(data)
 	if err != nil {
-		return nil, err
+		return nil, space.LayoutXY, err
 	}
 
+	if typ > geometryCollectionType {
+		// the geometries with Z or M coordinates are read by the decoder.
+		g, layout, err := NewDecoder(bytes.NewReader(data)).DecodeLayout()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, space.LayoutXY, ErrNotWKB
+		}
+		return g, layout, err
+	}
+
+	g, err := unmarshal(order, typ, data)
+	return g, space.LayoutXY, err
+}
+
+// unmarshal decodes the data of the geometry type with coordinates x and y.
+func unmarshal(order byteOrder, typ uint32, data []byte) (space.Geometry, error) {
 	switch typ {
 	case pointType:
 		return unmarshalPoint(order, data[5:])
@@ -217,30 +256,39 @@ func NewDecoder(r io.Reader) *Decoder {
 
 // Decode will decode the next geometry off of the stream.
 func (d *Decoder) Decode() (space.Geometry, error) {
+	geom, _, err := d.DecodeLayout()
+	return geom, err
+}
+
+// DecodeLayout will decode the next geometry off of the stream and returns the layout of its coordinates.
+func (d *Decoder) DecodeLayout() (space.Geometry, space.CoordLayout, error) {
 	buf := make([]byte, 8)
-	order, typ, err := readByteOrderType(d.r, buf)
+	order, typ, layout, err := readGeometryType(d.r, buf)
 	if err != nil {
-		return nil, err
+		return nil, space.LayoutXY, err
 	}
 
+	var geom space.Geometry
 	switch typ {
 	case pointType:
-		return readPoint(d.r, order, buf)
+		geom, err = readPoint(d.r, order, buf, layout)
 	case multiPointType:
-		return readMultiPoint(d.r, order, buf)
+		geom, err = readMultiPoint(d.r, order, buf)
 	case lineStringType:
-		return readLineString(d.r, order, buf)
+		geom, err = readLineString(d.r, order, buf, layout)
 	case multiLineStringType:
-		return readMultiLineString(d.r, order, buf)
+		geom, err = readMultiLineString(d.r, order, buf)
 	case polygonType:
-		return readPolygon(d.r, order, buf)
+		geom, err = readPolygon(d.r, order, buf, layout)
 	case multiPolygonType:
-		return readMultiPolygon(d.r, order, buf)
+		geom, err = readMultiPolygon(d.r, order, buf)
 	case geometryCollectionType:
-		return readCollection(d.r, order, buf)
+		geom, err = readCollection(d.r, order, buf)
+	default:
+		return nil, space.LayoutXY, ErrUnsupportedGeometry
 	}
 
-	return nil, ErrUnsupportedGeometry
+	return geom, layout, err
 }
 
 func readByteOrderType(r io.Reader, buf []byte) (byteOrder, uint32, error) {
@@ -268,6 +316,81 @@ func readByteOrderType(r io.Reader, buf []byte) (byteOrder, uint32, error) {
 	return order, typ, nil
 }
 
+// readGeometryType reads the byte order and the geometry type of ISO WKB or EWKB,
+// returns the geometry type without its Z and M flags and the layout of its coordinates.
+// The SRID of EWKB is skipped.
+func readGeometryType(r io.Reader, buf []byte) (byteOrder, uint32, space.CoordLayout, error) {
+	order, typ, err := readByteOrderType(r, buf)
+	if err != nil {
+		return 0, 0, space.LayoutXY, err
+	}
+	if typ&ewkbSRIDFlag != 0 {
+		if _, err := readUint32(r, order, buf[:4]); err != nil {
+			return 0, 0, space.LayoutXY, err
+		}
+	}
+	typ, layout := geometryTypeLayout(typ)
+	return order, typ, layout, nil
+}
+
+// geometryTypeLayout returns the geometry type and the layout of its coordinates of a geometry type of
+// ISO WKB, in the 1000 range for Z, the 2000 range for M and the 3000 range for ZM,
+// or of EWKB with the Z and M flags.
+func geometryTypeLayout(typ uint32) (uint32, space.CoordLayout) {
+	hasZ := typ&ewkbZFlag != 0 || (typ&0xffff)/1000 == 1 || (typ&0xffff)/1000 == 3
+	hasM := typ&ewkbMFlag != 0 || (typ&0xffff)/1000 == 2 || (typ&0xffff)/1000 == 3
+	typ = (typ & 0xffff) % 1000
+	switch {
+	case hasZ && hasM:
+		return typ, space.LayoutXYZM
+	case hasZ:
+		return typ, space.LayoutXYZ
+	case hasM:
+		return typ, space.LayoutXYM
+	}
+	return typ, space.LayoutXY
+}
+
+// isoGeometryType returns the ISO WKB geometry type of the geometry type with coordinates of the layout.
+func isoGeometryType(typ uint32, layout space.CoordLayout) uint32 {
+	switch layout {
+	case space.LayoutXYZ:
+		return typ + 1000
+	case space.LayoutXYM:
+		return typ + 2000
+	case space.LayoutXYZM:
+		return typ + 3000
+	}
+	return typ
+}
+
+// readCoords reads a point of the layout.
+func readCoords(r io.Reader, order byteOrder, buf []byte, layout space.CoordLayout) (space.Point, error) {
+	ordinates := make([]float64, 0, layout.Stride())
+	for i := 0; i < layout.Stride(); i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return space.Point{}, err
+		}
+		if order == littleEndian {
+			ordinates = append(ordinates, math.Float64frombits(binary.LittleEndian.Uint64(buf)))
+		} else {
+			ordinates = append(ordinates, math.Float64frombits(binary.BigEndian.Uint64(buf)))
+		}
+	}
+	return layout.Point(ordinates...), nil
+}
+
+// writeCoords writes the ordinates of the point in the layout.
+func (e *Encoder) writeCoords(p space.Point, layout space.CoordLayout) error {
+	for _, v := range layout.Ordinates(p) {
+		e.order.PutUint64(e.buf, math.Float64bits(v))
+		if _, err := e.w.Write(e.buf[:8]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func readUint32(r io.Reader, order byteOrder, buf []byte) (uint32, error) {
 	if _, err := io.ReadFull(r, buf); err != nil {
 		return 0, err
@@ -43,6 +43,7 @@ type EWKBDecoder struct {
 	order          byteOrder
 	inputDimension int
 	Srid           uint32
+	Layout         space.CoordLayout
 }
 
 func (d *EWKBDecoder) readByte() (byte, error) {
@@ -81,26 +82,16 @@ func (d *EWKBDecoder) Decode() (space.Geometry, error) {
 
 	// To get geometry type mask out EWKB flag bits,and use only low 3 digits of type word.
 	// This supports both EWKB and ISO/OGC.
-	geometryType := (typeInt & 0xffff) % 1000
-
-	// handle 3D and 4D WKB geometries
-	// geometries with Z coordinates have the 0x80 flag (postgis EWKB)
-	// or are in the 1000 range (Z) or in the 3000 range (ZM) of geometry type (ISO/OGC 06-103r4)
-	hasZ := ((int64(typeInt)&0x80000000) != 0 || (typeInt&0xffff)/1000 == 1 || (typeInt&0xffff)/1000 == 3)
+	// Geometries with Z coordinates have the 0x80 flag (postgis EWKB)
+	// or are in the 1000 range (Z) or in the 3000 range (ZM) of geometry type (ISO/OGC 06-103r4),
 	// geometries with M coordinates have the 0x40 flag (postgis EWKB)
-	// or are in the 1000 range (M) or in the 3000 range (ZM) of geometry type (ISO/OGC 06-103r4)
-	hasM := ((typeInt&0x40000000) != 0 || (typeInt&0xffff)/1000 == 2 || (typeInt&0xffff)/1000 == 3)
-	//System.out.println(typeInt + " - " + geometryType + " - hasZ:" + hasZ);
-	d.inputDimension = 2
-	if hasZ {
-		d.inputDimension++
-	}
-	if hasM {
-		d.inputDimension++
-	}
+	// or are in the 2000 range (M) or in the 3000 range (ZM) of geometry type (ISO/OGC 06-103r4).
+	geometryType, layout := geometryTypeLayout(typeInt)
+	d.inputDimension = layout.Stride()
+	d.Layout = layout
 
 	// determine if SRID are present (EWKB only)
-	hasSRID := (typeInt & 0x20000000) != 0
+	hasSRID := (typeInt & ewkbSRIDFlag) != 0
 	if hasSRID {
 		d.Srid, _ = d.readInt32()
 		//fmt.Println(srid)
@@ -111,13 +102,13 @@ func (d *EWKBDecoder) Decode() (space.Geometry, error) {
 
 	var geom space.Geometry
 	var err error
-	switch uint32(geometryType) {
+	switch geometryType {
 	case pointType:
-		geom, err = readPoint(d.r, order, buf)
+		geom, err = readPoint(d.r, order, buf, layout)
 	case lineStringType:
-		geom, err = readLineString(d.r, order, buf)
+		geom, err = readLineString(d.r, order, buf, layout)
 	case polygonType:
-		geom, err = readPolygon(d.r, order, buf)
+		geom, err = readPolygon(d.r, order, buf, layout)
 	case multiPointType:
 		geom, err = readMultiPoint(d.r, order, buf)
 	case multiLineStringType:
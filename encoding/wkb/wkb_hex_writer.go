@@ -4,7 +4,6 @@ package wkb
 
 import (
 	"io"
-	"math"
 
 	"github.com/spatial-go/geoos/space"
 )
@@ -28,6 +27,11 @@ type EWKBEncoder struct {
 
 // Encode will write the geometry encoded as WKB to the given writer.
 func (e *EWKBEncoder) Encode(geom space.Geometry) error {
+	return e.EncodeLayout(geom, space.GeometryLayout(geom))
+}
+
+// EncodeLayout will write the geometry encoded as EWKB with the coordinates of the layout to the given writer.
+func (e *EWKBEncoder) EncodeLayout(geom space.Geometry, layout space.CoordLayout) error {
 	if geom == nil || geom.IsEmpty() {
 		return nil
 	}
@@ -60,31 +64,33 @@ func (e *EWKBEncoder) Encode(geom space.Geometry) error {
 
 	switch g := geom.(type) {
 	case space.Point:
-		return e.writePoint(g)
+		return e.writePoint(g, layout)
 	case space.MultiPoint:
-		return e.writeMultiPoint(g)
+		return e.writeMultiPoint(g, layout)
 	case space.LineString:
-		return e.writeLineString(g)
+		return e.writeLineString(g, layout)
 	case space.MultiLineString:
-		return e.writeMultiLineString(g)
+		return e.writeMultiLineString(g, layout)
 	case space.Polygon:
-		return e.writePolygon(g)
+		return e.writePolygon(g, layout)
 	case space.MultiPolygon:
-		return e.writeMultiPolygon(g)
+		return e.writeMultiPolygon(g, layout)
 	case space.Collection:
-		return e.writeCollection(g)
+		return e.writeCollection(g, layout)
 	}
 
 	return ErrUnknownWKBType
 }
-func (e *EWKBEncoder) writeGeometryType(geometryType uint32) {
-	//  flag3D := (outputDimension == 3) ? 0x80000000 : 0;
-	flag3D := uint32(0)
-	typeInt := geometryType | flag3D
+func (e *EWKBEncoder) writeGeometryType(geometryType uint32, layout space.CoordLayout) {
+	typeInt := geometryType
+	if layout.HasZ() {
+		typeInt |= ewkbZFlag
+	}
+	if layout.HasM() {
+		typeInt |= ewkbMFlag
+	}
 	if e.Srid != 0 {
-		typeInt |= 0x20000000
-	} else {
-		typeInt |= 0
+		typeInt |= ewkbSRIDFlag
 	}
 	buf := make([]byte, 4)
 	e.order.PutUint32(buf, uint32(typeInt))
@@ -94,13 +100,10 @@ func (e *EWKBEncoder) writeGeometryType(geometryType uint32) {
 		e.w.Write(buf)
 	}
 }
-func (e *EWKBEncoder) writePoint(p space.Point) (err error) {
-	e.writeGeometryType(pointType)
+func (e *EWKBEncoder) writePoint(p space.Point, layout space.CoordLayout) (err error) {
+	e.writeGeometryType(pointType, layout)
 
-	e.order.PutUint64(e.buf, math.Float64bits(p[0]))
-	e.order.PutUint64(e.buf[8:], math.Float64bits(p[1]))
-	_, err = e.w.Write(e.buf)
-	return err
+	return e.writeCoords(p, layout)
 }
 
 // TODO rewrite others types
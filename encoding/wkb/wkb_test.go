@@ -294,3 +294,92 @@ func TestGeomToWKBHexStr(t *testing.T) {
 		})
 	}
 }
+
+func TestMarshalLayout(t *testing.T) {
+	m := space.LayoutXYM.Point
+	tests := []struct {
+		name   string
+		geom   space.Geometry
+		layout space.CoordLayout
+		typ    uint32
+	}{
+		{name: "point z", geom: space.Point{1, 2, 3}, layout: space.LayoutXYZ, typ: 1001},
+		{name: "point m", geom: m(1, 2, 4), layout: space.LayoutXYM, typ: 2001},
+		{name: "point zm", geom: space.Point{1, 2, 3, 4}, layout: space.LayoutXYZM, typ: 3001},
+		{name: "line m", geom: space.LineString{m(0, 0, 0), m(10, 0, 12.5)}, layout: space.LayoutXYM, typ: 2002},
+		{name: "polygon z", geom: space.Polygon{{{0, 0, 1}, {1, 0, 1}, {1, 1, 1}, {0, 0, 1}}}, layout: space.LayoutXYZ, typ: 1003},
+		{name: "multi point zm", geom: space.MultiPoint{{1, 2, 3, 4}, {5, 6, 7, 8}}, layout: space.LayoutXYZM, typ: 3004},
+		{name: "multi line z", geom: space.MultiLineString{{{0, 0, 1}, {1, 0, 2}}}, layout: space.LayoutXYZ, typ: 1005},
+		{name: "multi polygon m", geom: space.MultiPolygon{{{m(0, 0, 1), m(1, 0, 2), m(1, 1, 3), m(0, 0, 1)}}},
+			layout: space.LayoutXYM, typ: 2006},
+		{name: "collection z", geom: space.Collection{space.Point{1, 2, 3}, space.LineString{{0, 0, 1}, {1, 0, 2}}},
+			layout: space.LayoutXYZ, typ: 1007},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := MarshalLayout(tt.geom, tt.layout)
+			if err != nil {
+				t.Fatalf("MarshalLayout() error = %v", err)
+			}
+			if typ := littleEndian.Uint32(data[1:]); typ != tt.typ {
+				t.Errorf("MarshalLayout() type = %v, want %v", typ, tt.typ)
+			}
+			if marshaled, err := Marshal(tt.geom); err != nil || !bytes.Equal(marshaled, data) {
+				t.Errorf("Marshal() = %v, %v, want %v", marshaled, err, data)
+			}
+			got, layout, err := UnmarshalLayout(data)
+			if err != nil {
+				t.Fatalf("UnmarshalLayout() error = %v", err)
+			}
+			if !got.Equals(tt.geom) || layout != tt.layout {
+				t.Errorf("UnmarshalLayout() = %v, %v, want %v, %v", got, layout, tt.geom, tt.layout)
+			}
+
+			var s GeometryScanner
+			if err := s.Scan(data); err != nil || !s.Geometry.Equals(tt.geom) || s.Layout != tt.layout {
+				t.Errorf("Scan() = %v, %v, %v, want %v, %v", s.Geometry, s.Layout, err, tt.geom, tt.layout)
+			}
+		})
+	}
+}
+
+func TestDecode_EWKBLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		hex    string
+		want   space.Geometry
+		layout space.CoordLayout
+	}{
+		{name: "point zm", hex: "01010000C0000000000000F03F000000000000004000000000000008400000000000001040",
+			want: space.Point{1, 2, 3, 4}, layout: space.LayoutXYZM},
+		{name: "point m with srid", hex: "0101000060E6100000000000000000F03F00000000000000400000000000001040",
+			want: space.LayoutXYM.Point(1, 2, 4), layout: space.LayoutXYM},
+		{name: "line z", hex: "010200008002000000000000000000000000000000000000000000000000000000000000000000F03F00000000000000000000000000000040",
+			want: space.LineString{{0, 0, 0}, {1, 0, 2}}, layout: space.LayoutXYZ},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, layout, err := NewDecoder(bytes.NewReader(HexToBytes(tt.hex))).DecodeLayout()
+			if err != nil || !got.Equals(tt.want) || layout != tt.layout {
+				t.Errorf("DecodeLayout() = %v, %v, %v, want %v, %v", got, layout, err, tt.want, tt.layout)
+			}
+		})
+	}
+}
+
+func TestEWKBEncoder_Layout(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	e := &EWKBEncoder{Encoder: NewEncoder(buf), Srid: space.WGS84}
+	want := space.LayoutXYM.Point(1, 2, 4)
+	if err := e.Encode(want); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if typ := littleEndian.Uint32(buf.Bytes()[1:]); typ != pointType|ewkbMFlag|ewkbSRIDFlag {
+		t.Errorf("Encode() type = %x, want %x", typ, pointType|ewkbMFlag|ewkbSRIDFlag)
+	}
+	d := &EWKBDecoder{r: bytes.NewReader(buf.Bytes())}
+	got, err := d.Decode()
+	if err != nil || !got.Equals(want) || d.Layout != space.LayoutXYM {
+		t.Errorf("Decode() = %v, %v, %v, want %v, %v", got, d.Layout, err, want, space.LayoutXYM)
+	}
+}
@@ -10,33 +10,49 @@ import (
 
 // UnmarshalString encode to geom
 func UnmarshalString(s string) (space.Geometry, error) {
-	p := Parser{NewLexer(strings.NewReader(s))}
-	return p.Parse()
+	geom, _, err := UnmarshalStringLayout(s)
+	return geom, err
+}
+
+// UnmarshalStringLayout encode to geom and returns the layout of its coordinates given by the tag Z, M or ZM.
+func UnmarshalStringLayout(s string) (space.Geometry, space.CoordLayout, error) {
+	p := Parser{Lexer: NewLexer(strings.NewReader(s))}
+	geom, err := p.Parse()
+	return geom, p.Layout(), err
 }
 
 // MarshalString decode to string
 func MarshalString(geom space.Geometry) string {
+	return MarshalStringLayout(geom, space.GeometryLayout(geom))
+}
+
+// MarshalStringLayout decode to string with the coordinates of the layout.
+func MarshalStringLayout(geom space.Geometry, layout space.CoordLayout) string {
 	buf := bytes.NewBuffer(nil)
-	wkt(buf, geom)
+	wkt(buf, geom, layout)
 	return buf.String()
 }
 
-func wkt(buf *bytes.Buffer, geom space.Geometry) {
+func wkt(buf *bytes.Buffer, geom space.Geometry, layout space.CoordLayout) {
 	switch g := geom.(type) {
 	case space.Point:
-		_, _ = fmt.Fprintf(buf, "POINT(%g %g)", g.Lon(), g.Lat())
+		buf.Write([]byte(`POINT` + layoutTag(layout) + `(`))
+		writeCoord(buf, g, layout)
+		buf.WriteByte(')')
 	case space.MultiPoint:
 		if len(g) == 0 {
 			buf.Write([]byte(`MULTIPOINT EMPTY`))
 			return
 		}
 
-		buf.Write([]byte(`MULTIPOINT(`))
+		buf.Write([]byte(`MULTIPOINT` + layoutTag(layout) + `(`))
 		for i, p := range g.ToPointArray() {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			_, _ = fmt.Fprintf(buf, "(%g %g)", p.Lon(), p.Lat())
+			buf.WriteByte('(')
+			writeCoord(buf, p, layout)
+			buf.WriteByte(')')
 		}
 		buf.WriteByte(')')
 	case space.LineString:
@@ -45,36 +61,36 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			return
 		}
 
-		buf.Write([]byte(`LINESTRING`))
-		writeLineString(buf, g)
+		buf.Write([]byte(`LINESTRING` + layoutTag(layout)))
+		writeLineString(buf, g, layout)
 	case space.MultiLineString:
 		if len(g) == 0 {
 			buf.Write([]byte(`MULTILINESTRING EMPTY`))
 			return
 		}
 
-		buf.Write([]byte(`MULTILINESTRING(`))
+		buf.Write([]byte(`MULTILINESTRING` + layoutTag(layout) + `(`))
 		for i, ls := range g {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			writeLineString(buf, ls)
+			writeLineString(buf, ls, layout)
 		}
 		buf.WriteByte(')')
 	case space.Ring:
-		wkt(buf, space.Polygon{g})
+		wkt(buf, space.Polygon{g}, layout)
 	case space.Polygon:
 		if len(g) == 0 {
 			buf.Write([]byte(`POLYGON EMPTY`))
 			return
 		}
 
-		buf.Write([]byte(`POLYGON(`))
+		buf.Write([]byte(`POLYGON` + layoutTag(layout) + `(`))
 		for i, r := range g {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			writeLineString(buf, space.LineString(r))
+			writeLineString(buf, space.LineString(r), layout)
 		}
 		buf.WriteByte(')')
 	case space.MultiPolygon:
@@ -83,7 +99,7 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			return
 		}
 
-		buf.Write([]byte(`MULTIPOLYGON(`))
+		buf.Write([]byte(`MULTIPOLYGON` + layoutTag(layout) + `(`))
 		for i, p := range g {
 			if i != 0 {
 				buf.WriteByte(',')
@@ -93,7 +109,7 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 				if j != 0 {
 					buf.WriteByte(',')
 				}
-				writeLineString(buf, space.LineString(r))
+				writeLineString(buf, space.LineString(r), layout)
 			}
 			buf.WriteByte(')')
 		}
@@ -109,7 +125,7 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			wkt(buf, c)
+			wkt(buf, c, layout)
 		}
 		buf.WriteByte(')')
 	default:
@@ -117,14 +133,37 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 	}
 }
 
-func writeLineString(buf *bytes.Buffer, ls space.LineString) {
+func writeLineString(buf *bytes.Buffer, ls space.LineString, layout space.CoordLayout) {
 	buf.WriteByte('(')
 	for i, p := range ls.ToPointArray() {
 		if i != 0 {
 			buf.WriteByte(',')
 		}
 
-		_, _ = fmt.Fprintf(buf, "%g %g", p.Lon(), p.Lat())
+		writeCoord(buf, p, layout)
 	}
 	buf.WriteByte(')')
 }
+
+// writeCoord writes the ordinates of the point in the layout.
+func writeCoord(buf *bytes.Buffer, p space.Point, layout space.CoordLayout) {
+	for i, v := range layout.Ordinates(p) {
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+		_, _ = fmt.Fprintf(buf, "%g", v)
+	}
+}
+
+// layoutTag returns the tag of the layout following the geometry type, Z, M or ZM.
+func layoutTag(layout space.CoordLayout) string {
+	switch layout {
+	case space.LayoutXYZ:
+		return " Z"
+	case space.LayoutXYM:
+		return " M"
+	case space.LayoutXYZM:
+		return " ZM"
+	}
+	return ""
+}
@@ -10,6 +10,12 @@ import (
 // Parser ...
 type Parser struct {
 	*Lexer
+	layout space.CoordLayout
+}
+
+// Layout returns the layout of the coordinates of the parsed geometry.
+func (p *Parser) Layout() space.CoordLayout {
+	return p.layout
 }
 
 // Parse ...
@@ -64,14 +70,7 @@ func (p *Parser) parsePoint() (point space.Point, err error) {
 		}
 		fallthrough
 	case LeftParen:
-		switch t.ttype { // reswitch on the type because of the fallthrough
-		case Z, M:
-			point, err = p.parseCoordDrop1()
-		case ZM:
-			point, err = p.parseCoordDrop2()
-		default:
-			point, err = p.parseCoord()
-		}
+		point, err = p.parseCoordLayout(t.ttype)
 		if err != nil {
 			return point, err
 		}
@@ -142,15 +141,7 @@ func (p *Parser) parseLineString() (line space.LineString, err error) {
 func (p *Parser) parseLineStringText(ttype tokenType) (line space.LineString, err error) {
 	line = make([][]float64, 0)
 	for {
-		var point space.Point
-		switch ttype {
-		case Z, M:
-			point, err = p.parseCoordDrop1()
-		case ZM:
-			point, err = p.parseCoordDrop2()
-		default:
-			point, err = p.parseCoord()
-		}
+		point, err := p.parseCoordLayout(ttype)
 		if err != nil {
 			return line, err
 		}
@@ -334,41 +325,41 @@ func (p *Parser) parseCoord() (point space.Point, err error) {
 	return space.Point{c1, c2}, nil
 }
 
-func (p *Parser) parseCoordDrop1() (point space.Point, err error) {
+// parseCoordLayout parses the ordinates of a point of the layout of the token Z, M or ZM.
+func (p *Parser) parseCoordLayout(ttype tokenType) (point space.Point, err error) {
 	point, err = p.parseCoord()
 	if err != nil {
 		return point, err
 	}
-
-	// drop the last value Z or M coordinates are not really supported
-	t, err := p.scanToken()
-	if err != nil {
-		return point, err
-	}
-	if t.ttype != Float {
-		return point, fmt.Errorf("parseCoordDrop1 unexpected token %s on pos %d expected Float", t.lexeme, t.pos)
-	}
-
-	return point, nil
-}
-
-func (p *Parser) parseCoordDrop2() (point space.Point, err error) {
-	point, err = p.parseCoord()
-	if err != nil {
-		return point, err
-	}
-
-	// drop the last value M values
-	// and Z coordinates are not really supported
-	for i := 0; i < 2; i++ {
+	layout := layoutOf(ttype)
+	p.layout = layout
+	ordinates := []float64(point)
+	for i := 2; i < layout.Stride(); i++ {
 		t, err := p.scanToken()
 		if err != nil {
 			return point, err
 		}
 		if t.ttype != Float {
-			return point, fmt.Errorf("parseCoordDrop2 unexpected token %s on pos %d expected Float", t.lexeme, t.pos)
+			return point, fmt.Errorf("parse coordinates unexpected token %s on pos %d expected Float", t.lexeme, t.pos)
+		}
+		c, err := strconv.ParseFloat(t.lexeme, 64)
+		if err != nil {
+			return point, fmt.Errorf("invalid lexeme %s for token on pos %d", t.lexeme, t.pos)
 		}
+		ordinates = append(ordinates, c)
 	}
+	return layout.Point(ordinates...), nil
+}
 
-	return point, nil
+// layoutOf returns the coordinate layout of the token Z, M or ZM.
+func layoutOf(ttype tokenType) space.CoordLayout {
+	switch ttype {
+	case Z:
+		return space.LayoutXYZ
+	case M:
+		return space.LayoutXYM
+	case ZM:
+		return space.LayoutXYZM
+	}
+	return space.LayoutXY
 }
@@ -27,3 +27,50 @@ func TestMarshalString(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalStringLayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		wkt    string
+		want   space.Geometry
+		layout space.CoordLayout
+		out    string
+	}{
+		{name: "point", wkt: "POINT(1 2)", want: space.Point{1, 2}, layout: space.LayoutXY, out: "POINT(1 2)"},
+		{name: "point z", wkt: "POINT Z (1 2 3)", want: space.Point{1, 2, 3}, layout: space.LayoutXYZ, out: "POINT Z(1 2 3)"},
+		{name: "point m", wkt: "POINT M(1 2 4)", want: space.LayoutXYM.Point(1, 2, 4), layout: space.LayoutXYM,
+			out: "POINT M(1 2 4)"},
+		{name: "point zm", wkt: "POINT ZM(1 2 3 4)", want: space.Point{1, 2, 3, 4}, layout: space.LayoutXYZM,
+			out: "POINT ZM(1 2 3 4)"},
+		{name: "line m", wkt: "LINESTRING M(0 0 0,10 0 12.5)",
+			want: space.LineString{space.LayoutXYM.Point(0, 0, 0), space.LayoutXYM.Point(10, 0, 12.5)}, layout: space.LayoutXYM,
+			out: "LINESTRING M(0 0 0,10 0 12.5)"},
+		{name: "polygon z", wkt: "POLYGON Z((0 0 1,1 0 1,1 1 1,0 0 1))",
+			want: space.Polygon{{{0, 0, 1}, {1, 0, 1}, {1, 1, 1}, {0, 0, 1}}}, layout: space.LayoutXYZ,
+			out: "POLYGON Z((0 0 1,1 0 1,1 1 1,0 0 1))"},
+		{name: "multi line zm", wkt: "MULTILINESTRING ZM((0 0 1 2,1 0 1 3))",
+			want: space.MultiLineString{{{0, 0, 1, 2}, {1, 0, 1, 3}}}, layout: space.LayoutXYZM,
+			out: "MULTILINESTRING ZM((0 0 1 2,1 0 1 3))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, layout, err := UnmarshalStringLayout(tt.wkt)
+			if err != nil {
+				t.Errorf("UnmarshalStringLayout() error = %v", err)
+				return
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("UnmarshalStringLayout() = %v, want %v", got, tt.want)
+			}
+			if layout != tt.layout {
+				t.Errorf("UnmarshalStringLayout() layout = %v, want %v", layout, tt.layout)
+			}
+			if out := MarshalStringLayout(got, layout); out != tt.out {
+				t.Errorf("MarshalStringLayout() = %v, want %v", out, tt.out)
+			}
+			if out := MarshalString(got); out != tt.out {
+				t.Errorf("MarshalString() = %v, want %v", out, tt.out)
+			}
+		})
+	}
+}
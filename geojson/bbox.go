@@ -34,3 +34,18 @@ func (bb BBox) Bound() space.Bound {
 		Max: space.Point{bb[mid], bb[mid+1]},
 	}
 }
+
+// NewGeoBBox creates a bbox from a geo bound,
+// a bound crossing the antimeridian has the west longitude larger than the east longitude as RFC 7946 section 5.2.
+func NewGeoBBox(b space.GeoBound) BBox {
+	return []float64{
+		b.Min.X(), b.Min.Y(),
+		b.Max.X(), b.Max.Y(),
+	}
+}
+
+// GeoBound returns the space.GeoBound for the BBox, which wraps across the antimeridian if west is larger than east.
+func (bb BBox) GeoBound() space.GeoBound {
+	b := bb.Bound()
+	return space.GeoBound{Min: b.Min, Max: b.Max}
+}
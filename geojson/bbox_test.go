@@ -80,3 +80,14 @@ func TestBBoxBound(t *testing.T) {
 	}
 
 }
+
+func TestBBoxGeoBound(t *testing.T) {
+	bb := NewGeoBBox(space.GeoBoundOf(space.LineString{{170, -10}, {-170, 10}}))
+	if bb[0] != 170 || bb[2] != -170 {
+		t.Errorf("incorrect bbox: %v", bb)
+	}
+	b := bb.GeoBound()
+	if !b.IsWrapped() || !b.Contains(space.Point{180, 0}) || b.Contains(space.Point{0, 0}) {
+		t.Errorf("incorrect geo bound: %v", b)
+	}
+}
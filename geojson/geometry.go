@@ -46,6 +46,21 @@ func NewGeometry(g space.Geometry) *Geometry {
 	return jg
 }
 
+// NewGeometryLayout will create a Geometry object of the geometry with coordinates of the layout,
+// a missing Z or M is 0 and the points of the layout XYM have no Z.
+func NewGeometryLayout(g space.Geometry, layout space.CoordLayout) *Geometry {
+	if c, ok := g.(space.Collection); ok {
+		jg := &Geometry{Type: c.GeoJSONType()}
+		for _, v := range c {
+			jg.Geometries = append(jg.Geometries, NewGeometryLayout(v, layout))
+		}
+		return jg
+	}
+	return NewGeometry(positions(g, func(p []float64) []float64 {
+		return layout.Point(layout.Ordinates(p)...)
+	}))
+}
+
 // Geometry returns the space.Geometry for the geojson Geometry.
 // This will convert the "Geometries" into a space.Collection if applicable.
 func (g Geometry) Geometry() space.Geometry {
@@ -67,14 +82,15 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 	}
 
 	ng := &jsonGeometryMarshall{}
+	var coordinates space.Geometry
 	switch g := g.Coordinates.(type) {
 	case space.Ring:
-		ng.Coordinates = space.Polygon{g}
+		coordinates = space.Polygon{g}
 	case space.Bound:
 		if g.IsEmpty() {
-			ng.Coordinates = space.Polygon{{{0, 0}, {0, 0}, {0, 0}, {0, 0}}}
+			coordinates = space.Polygon{{{0, 0}, {0, 0}, {0, 0}, {0, 0}}}
 		} else {
-			ng.Coordinates = g.ToPolygon()
+			coordinates = g.ToPolygon()
 		}
 	case space.Collection:
 		ng.Geometries = make([]*Geometry, 0, len(g))
@@ -83,11 +99,15 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 		}
 		ng.Type = g.GeoJSONType()
 	default:
-		ng.Coordinates = g
+		coordinates = g
 	}
 
-	if ng.Coordinates != nil {
-		ng.Type = ng.Coordinates.GeoJSONType()
+	if coordinates != nil {
+		ng.Type = coordinates.GeoJSONType()
+		ng.Coordinates = positions(coordinates, jsonPosition)
+		if space.GeometryLayout(coordinates) == space.LayoutXYM {
+			ng.Layout = layoutXYM
+		}
 	}
 
 	if len(g.Geometries) > 0 {
@@ -147,6 +167,14 @@ func (g *Geometry) UnmarshalJSON(data []byte) error {
 	default:
 		return ErrInvalidGeometry
 	}
+	if jg.Layout == layoutXYM && g.Coordinates != nil {
+		g.Coordinates = positions(g.Coordinates, func(p []float64) []float64 {
+			if len(p) > 3 {
+				return space.LayoutXYM.Point(p[0], p[1], p[3])
+			}
+			return p
+		})
+	}
 
 	g.Type = g.Geometry().GeoJSONType()
 
@@ -331,16 +359,80 @@ func (mp *MultiPolygon) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// layoutXYM is the foreign member layout of a geometry whose positions x, y, 0, m have no Z.
+const layoutXYM = "XYM"
+
 type jsonGeometry struct {
 	Type        string           `json:"type"`
 	Coordinates nocopyRawMessage `json:"coordinates"`
 	Geometries  []*Geometry      `json:"geometries,omitempty"`
+	Layout      string           `json:"layout,omitempty"`
 }
 
 type jsonGeometryMarshall struct {
 	Type        string         `json:"type"`
 	Coordinates space.Geometry `json:"coordinates,omitempty"`
 	Geometries  []*Geometry    `json:"geometries,omitempty"`
+	Layout      string         `json:"layout,omitempty"`
+}
+
+// jsonPosition returns the GeoJSON position of the point, x, y, z and m.
+// A position has no missing Z, so the Z of a point of the layout XYM is written as 0,
+// and the geometry has the foreign member layout XYM.
+func jsonPosition(p []float64) []float64 {
+	if space.Point(p).Layout() == space.LayoutXYM {
+		return space.LayoutXYZM.Ordinates(p)
+	}
+	return p
+}
+
+// positions returns the geometry with the positions of its points.
+func positions(geom space.Geometry, position func([]float64) []float64) space.Geometry {
+	switch g := geom.(type) {
+	case space.Point:
+		return space.Point(position(g))
+	case space.MultiPoint:
+		c := make(space.MultiPoint, 0, len(g))
+		for _, v := range g {
+			c = append(c, position(v))
+		}
+		return c
+	case space.LineString:
+		return space.LineString(linePositions(g, position))
+	case space.MultiLineString:
+		c := make(space.MultiLineString, 0, len(g))
+		for _, v := range g {
+			c = append(c, linePositions(v, position))
+		}
+		return c
+	case space.Polygon:
+		return space.Polygon(polygonPositions(g, position))
+	case space.MultiPolygon:
+		c := make(space.MultiPolygon, 0, len(g))
+		for _, v := range g {
+			c = append(c, polygonPositions(v, position))
+		}
+		return c
+	}
+	return geom
+}
+
+// linePositions returns the positions of the points of the line.
+func linePositions(line [][]float64, position func([]float64) []float64) [][]float64 {
+	c := make([][]float64, 0, len(line))
+	for _, v := range line {
+		c = append(c, position(v))
+	}
+	return c
+}
+
+// polygonPositions returns the positions of the points of the rings of the polygon.
+func polygonPositions(polygon [][][]float64, position func([]float64) []float64) [][][]float64 {
+	c := make([][][]float64, 0, len(polygon))
+	for _, v := range polygon {
+		c = append(c, linePositions(v, position))
+	}
+	return c
 }
 
 type nocopyRawMessage []byte
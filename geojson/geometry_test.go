@@ -189,6 +189,59 @@ func TestGeometryUnmarshal(t *testing.T) {
 	}
 }
 
+func TestGeometryMarshal_Layout(t *testing.T) {
+	m := space.LayoutXYM.Point
+	cases := []struct {
+		name string
+		geom space.Geometry
+		json string
+	}{
+		{name: "point z", geom: space.Point{1, 2, 3}, json: `{"type":"Point","coordinates":[1,2,3]}`},
+		{name: "point m", geom: m(1, 2, 4), json: `{"type":"Point","coordinates":[1,2,0,4],"layout":"XYM"}`},
+		{name: "point zm", geom: space.Point{1, 2, 3, 4}, json: `{"type":"Point","coordinates":[1,2,3,4]}`},
+		{name: "multi point m", geom: space.MultiPoint{m(1, 2, 4)},
+			json: `{"type":"MultiPoint","coordinates":[[1,2,0,4]],"layout":"XYM"}`},
+		{name: "line string m", geom: space.LineString{m(0, 0, 0), m(10, 0, 12.5)},
+			json: `{"type":"LineString","coordinates":[[0,0,0,0],[10,0,0,12.5]],"layout":"XYM"}`},
+		{name: "multi line string zm", geom: space.MultiLineString{{{0, 0, 1, 0}, {10, 0, 2, 1}}},
+			json: `{"type":"MultiLineString","coordinates":[[[0,0,1,0],[10,0,2,1]]]}`},
+		{name: "polygon m", geom: space.Polygon{{m(0, 0, 0), m(1, 0, 1), m(1, 1, 2), m(0, 0, 0)}},
+			json: `{"type":"Polygon","coordinates":[[[0,0,0,0],[1,0,0,1],[1,1,0,2],[0,0,0,0]]],"layout":"XYM"}`},
+		{name: "multi polygon zm", geom: space.MultiPolygon{{{{0, 0, 1, 0}, {1, 0, 1, 1}, {1, 1, 1, 2}, {0, 0, 1, 0}}}},
+			json: `{"type":"MultiPolygon","coordinates":[[[[0,0,1,0],[1,0,1,1],[1,1,1,2],[0,0,1,0]]]]}`},
+		{name: "collection m", geom: space.Collection{m(1, 2, 4)},
+			json: `{"type":"GeometryCollection","geometries":[{"type":"Point","coordinates":[1,2,0,4],"layout":"XYM"}]}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := NewGeometry(tc.geom).MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if string(data) != tc.json {
+				t.Errorf("marshal = %s, want %s", data, tc.json)
+			}
+			if layout, _ := NewGeometryLayout(tc.geom, space.GeometryLayout(tc.geom)).MarshalJSON(); string(layout) != tc.json {
+				t.Errorf("marshal layout = %s, want %s", layout, tc.json)
+			}
+
+			g, err := UnmarshalGeometry(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+			if !g.Geometry().Equals(tc.geom) || space.GeometryLayout(g.Geometry()) != space.GeometryLayout(tc.geom) {
+				t.Errorf("unmarshal = %v, want %v", g.Geometry(), tc.geom)
+			}
+		})
+	}
+
+	// the ordinates missing in the layout are dropped.
+	if data, _ := NewGeometryLayout(space.Point{1, 2, 3, 4}, space.LayoutXYZ).MarshalJSON(); string(data) != `{"type":"Point","coordinates":[1,2,3]}` {
+		t.Errorf("marshal = %s, want %s", data, `{"type":"Point","coordinates":[1,2,3]}`)
+	}
+}
+
 func TestHelperTypes(t *testing.T) {
 	// This test makes sure the marshal-unmarshal loop does the same thing.
 	// The code and types here are complicated to avoid duplicate code.
@@ -214,14 +214,9 @@ func (n *Node) IsEmpty() bool {
 		isEmpty = false
 	} else {
 		for i := 0; i < 4; i++ {
-			if n.Subnode[i] == nil {
-				continue
-			}
-			if !n.Subnode[i].HasItems() {
-				if !n.Subnode[i].IsEmpty() {
-					isEmpty = false
-					break
-				}
+			if !n.Subnode[i].IsEmpty() {
+				isEmpty = false
+				break
 			}
 		}
 	}
@@ -104,3 +104,33 @@ func TestQuadtree_Query(t *testing.T) {
 		})
 	}
 }
+
+func TestQuadtree_QueryRoot(t *testing.T) {
+	tree := NewQuadtree()
+	crossing := &matrix.LineSegment{P0: matrix.Matrix{-1, -1}, P1: matrix.Matrix{1, 1}}
+	inside := &matrix.LineSegment{P0: matrix.Matrix{5, 5}, P1: matrix.Matrix{6, 6}}
+	tree.Insert(envelope.TwoMatrix(crossing.P0, crossing.P1), crossing)
+	tree.Insert(envelope.TwoMatrix(inside.P0, inside.P1), inside)
+	tests := []struct {
+		name      string
+		searchEnv *envelope.Envelope
+		want      interface{}
+	}{
+		{name: "item crossing the axes", searchEnv: envelope.TwoMatrix(crossing.P0, crossing.P1), want: crossing},
+		{name: "item in a quadrant", searchEnv: envelope.TwoMatrix(inside.P0, inside.P1), want: inside},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tree.Query(tt.searchEnv)
+			has := false
+			for _, v := range got.([]interface{}) {
+				if v == tt.want {
+					has = true
+				}
+			}
+			if !has {
+				t.Errorf("Quadtree.Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -85,9 +85,10 @@ func (r *Root) Visit(searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
 	if !r.IsSearchMatch(searchEnv) {
 		return
 	}
-	// this node may have items as well as subnodes (since items may not
-	// be wholely contained in any single subnode
-	r.VisitItems(searchEnv, r.Env, visitor)
+	// the root has no extent, so the items crossing the axes are always visited.
+	for _, v := range r.Items {
+		visitor.VisitItem(v)
+	}
 
 	for i := 0; i < 4; i++ {
 		if !r.Subnode[i].IsEmpty() {
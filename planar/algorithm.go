@@ -4,6 +4,8 @@ package planar
 import (
 	"errors"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -21,18 +23,36 @@ type Algorithm interface {
 
 	BufferInMeter(geom space.Geometry, width float64, quadsegs int) space.Geometry
 
+	BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) space.Geometry
+
+	GeodesicBuffer(geom space.Geometry, width float64, quadsegs int) space.Geometry
+
+	Densify(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error)
+
+	GeodesicDensify(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error)
+
+	OffsetCurve(geom space.Geometry, distance float64, params *buffer.CurveParameters) (space.Geometry, error)
+
 	Centroid(geom space.Geometry) (space.Geometry, error)
 
 	Contains(geom1, geom2 space.Geometry) (bool, error)
 
 	ConvexHull(geom space.Geometry) (space.Geometry, error)
 
+	ConcaveHull(geom space.Geometry, lengthRatio float64, allowHoles bool) (space.Geometry, error)
+
+	ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, allowHoles bool) (space.Geometry, error)
+
 	CoveredBy(geom1, geom2 space.Geometry) (bool, error)
 
 	Covers(geom1, geom2 space.Geometry) (bool, error)
 
 	Crosses(geom1, geom2 space.Geometry) (bool, error)
 
+	DelaunayTriangulation(geom space.Geometry, tolerance float64, onlyEdges bool) (space.Geometry, error)
+
+	ConstrainedDelaunayTriangulation(geom space.Geometry, tolerance float64, conforming bool) (space.Geometry, error)
+
 	Difference(geom1, geom2 space.Geometry) (space.Geometry, error)
 
 	Disjoint(geom1, geom2 space.Geometry) (bool, error)
@@ -45,6 +65,14 @@ type Algorithm interface {
 
 	Equals(geom1, geom2 space.Geometry) (bool, error)
 
+	GeodesicArea(geom space.Geometry) (float64, error)
+
+	GeodesicDistance(geom1, geom2 space.Geometry) (float64, error)
+
+	GeodesicLength(geom space.Geometry) (float64, error)
+
+	GeodesicPerimeter(geom space.Geometry) (float64, error)
+
 	EqualsExact(geom1, geom2 space.Geometry, tolerance float64) (bool, error)
 
 	HausdorffDistance(geom1, geom2 space.Geometry) (float64, error)
@@ -65,14 +93,44 @@ type Algorithm interface {
 
 	Length(geom space.Geometry) (float64, error)
 
+	LineInterpolatePoint(geom space.Geometry, fraction float64) (space.Point, error)
+
+	LineInterpolateDistance(geom space.Geometry, distance float64) (space.Point, error)
+
+	LineLocatePoint(geom space.Geometry, point space.Point) (float64, error)
+
+	LineLocateDistance(geom space.Geometry, point space.Point) (float64, error)
+
+	LineSubstring(geom space.Geometry, startFraction, endFraction float64) (space.Geometry, error)
+
+	LineSubstringDistance(geom space.Geometry, startDistance, endDistance float64) (space.Geometry, error)
+
+	LineOffsetPoint(geom space.Geometry, distance, offset float64) (space.Point, error)
+
+	LocateAlong(geom space.Geometry, measure, offset float64) (space.Geometry, error)
+
+	LocateBetween(geom space.Geometry, from, to float64) (space.Geometry, error)
+
 	LineMerge(geom space.Geometry) (space.Geometry, error)
 
+	Polygonize(geom space.Geometry) (space.Geometry, error)
+
+	PolygonizeFull(geom space.Geometry) (polygons, dangles, cutEdges, invalidRingLines space.Geometry, err error)
+
+	ValidateDetail(geom space.Geometry) (*operation.ValidationError, error)
+
+	MakeValid(geom space.Geometry) (space.Geometry, error)
+
 	NGeometry(geom space.Geometry) (int, error)
 
 	Overlaps(geom1, geom2 space.Geometry) (bool, error)
 
 	PointOnSurface(geom space.Geometry) (space.Geometry, error)
 
+	MaximumInscribedCircle(geom space.Geometry, tolerance float64) (*space.Circle, error)
+
+	LargestEmptyCircle(obstacles, boundary space.Geometry, tolerance float64) (*space.Circle, error)
+
 	Relate(s, d space.Geometry) (string, error)
 
 	SharedPaths(geom1, geom2 space.Geometry) (string, error)
@@ -81,18 +139,32 @@ type Algorithm interface {
 
 	SimplifyP(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
+	SimplifyVW(geom space.Geometry, tolerance float64) (space.Geometry, error)
+
+	SimplifyCoverage(geom space.Geometry, tolerance float64) (space.Geometry, error)
+
+	SmoothChaikin(geom space.Geometry, iterations int) (space.Geometry, error)
+
+	SmoothBezier(geom space.Geometry, alpha float64) (space.Geometry, error)
+
 	Snap(input, reference space.Geometry, tolerance float64) (space.Geometry, error)
 
+	Split(geom, blade space.Geometry) (space.Geometry, error)
+
 	SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error)
 
 	Touches(geom1, geom2 space.Geometry) (bool, error)
 
+	TriangulatePolygon(geom space.Geometry) (space.Geometry, error)
+
 	UnaryUnion(geom space.Geometry) (space.Geometry, error)
 
 	Union(geom1, geom2 space.Geometry) (space.Geometry, error)
 
 	UniquePoints(geom space.Geometry) (space.Geometry, error)
 
+	VoronoiDiagram(geom space.Geometry, tolerance float64, bound space.Bound) (space.Geometry, error)
+
 	Within(geom1, geom2 space.Geometry) (bool, error)
 }
 
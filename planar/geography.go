@@ -0,0 +1,306 @@
+package planar
+
+import (
+	"errors"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space"
+)
+
+// ErrNotInHemisphere geometries can not be projected by gnomonic.
+var ErrNotInHemisphere = errors.New("geometries do not lie in an open hemisphere")
+
+// geographyAlgorithm algorithm implement on the sphere of radius measure.R.
+// Coordinates are lng lat, edges are great circle arcs, distances unit m and areas unit m².
+// Relations and overlays are computed in the gnomonic projection centered on the geometries,
+// which projects great circle arcs to straight lines, so the geometries must lie in an open hemisphere.
+// Algorithms not related to the sphere are the planar algorithms.
+type geographyAlgorithm struct {
+	megrezAlgorithm
+}
+
+// Area returns the area of a polygonal geometry on the sphere.
+func (g *geographyAlgorithm) Area(geom space.Geometry) (float64, error) {
+	if geom == nil || geom.IsEmpty() {
+		return 0, nil
+	}
+	area, _ := measure.SphereGeodesic.AreaPerimeter(geom.ToMatrix())
+	return area, nil
+}
+
+// Length returns the length of the geometry on the sphere, it is the length of lines or the length of polygon rings.
+func (g *geographyAlgorithm) Length(geom space.Geometry) (float64, error) {
+	if geom == nil || geom.IsEmpty() {
+		return 0, nil
+	}
+	return measure.SphereGeodesic.Length(geom.ToMatrix()), nil
+}
+
+// Distance returns the minimum great circle distance between two geometries.
+func (g *geographyAlgorithm) Distance(geom1, geom2 space.Geometry) (float64, error) {
+	if geom1 == nil || geom1.IsEmpty() || geom2 == nil || geom2.IsEmpty() {
+		return 0, nil
+	}
+	if intersects, err := g.Intersects(geom1, geom2); err != nil {
+		return 0, err
+	} else if intersects {
+		return 0, nil
+	}
+	// the geometries do not intersect, so the minimum distance is reached at a vertex.
+	dist := math.Pi
+	for _, a := range arcsOf(geom1.ToMatrix()) {
+		for _, b := range arcsOf(geom2.ToMatrix()) {
+			dist = math.Min(dist, math.Min(
+				math.Min(arcDistance(a[0], b[0], b[1]), arcDistance(a[1], b[0], b[1])),
+				math.Min(arcDistance(b[0], a[0], a[1]), arcDistance(b[1], a[0], a[1]))))
+		}
+	}
+	return dist * measure.R, nil
+}
+
+// SphericalDistance returns the minimum great circle distance between two geometries.
+func (g *geographyAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float64, error) {
+	return g.Distance(geom1, geom2)
+}
+
+// Buffer returns a geometry that represents all points whose great circle distance
+// from this geometry is less than or equal to width, unit m.
+// It is computed in the azimuthal equidistant projection centered on the centroid of geometry.
+func (g *geographyAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs int) space.Geometry {
+	params := buffer.DefaultCurveParameters()
+	params.QuadrantSegments = quadsegs
+	return g.BufferWithParams(geom, width, params)
+}
+
+// BufferWithParams returns the same as Buffer with the end cap style, join style, mitre limit
+// and single side given by the parameters, it is computed in the same projection.
+func (g *geographyAlgorithm) BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) space.Geometry {
+	if geom == nil || geom.IsEmpty() {
+		return nil
+	}
+	buffer, err := g.azimuthal(geom, func(projected space.Geometry) (space.Geometry, error) {
+		return g.megrezAlgorithm.BufferWithParams(projected, width, params), nil
+	})
+	if err != nil {
+		return nil
+	}
+	return buffer
+}
+
+// BufferInMeter returns the same as Buffer, the width of Buffer is unit m.
+func (g *geographyAlgorithm) BufferInMeter(geom space.Geometry, width float64, quadsegs int) space.Geometry {
+	return g.Buffer(geom, width, quadsegs)
+}
+
+// Centroid computes the centroid on the sphere, it is the normalized first moment
+// of the points, the great circle arcs or the area of the geometry by its highest dimension.
+func (g *geographyAlgorithm) Centroid(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return nil, nil
+	}
+	v, dim := sphericalCentroid(geom.ToMatrix())
+	if dim < 0 || v.norm() < 1e-15 {
+		return nil, nil
+	}
+	return space.Point(v.normalize().toPoint()), nil
+}
+
+// ConvexHull computes the spherical convex hull of a geometry.
+func (g *geographyAlgorithm) ConvexHull(geom space.Geometry) (space.Geometry, error) {
+	return g.unary(geom, g.megrezAlgorithm.ConvexHull)
+}
+
+// ConcaveHull computes the concave hull of a geometry in the azimuthal equidistant projection centered on it.
+func (g *geographyAlgorithm) ConcaveHull(geom space.Geometry, lengthRatio float64, allowHoles bool) (space.Geometry, error) {
+	return g.azimuthal(geom, func(projected space.Geometry) (space.Geometry, error) {
+		return g.megrezAlgorithm.ConcaveHull(projected, lengthRatio, allowHoles)
+	})
+}
+
+// ConcaveHullByLength computes the concave hull of a geometry in the azimuthal equidistant projection centered on it,
+// maxEdgeLength unit m.
+func (g *geographyAlgorithm) ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, allowHoles bool) (space.Geometry, error) {
+	return g.azimuthal(geom, func(projected space.Geometry) (space.Geometry, error) {
+		return g.megrezAlgorithm.ConcaveHullByLength(projected, maxEdgeLength, allowHoles)
+	})
+}
+
+// Contains returns TRUE if geometry B is completely inside geometry A.
+func (g *geographyAlgorithm) Contains(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Contains)
+}
+
+// CoveredBy returns TRUE if no point in space.Geometry A is outside space.Geometry B
+func (g *geographyAlgorithm) CoveredBy(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.CoveredBy)
+}
+
+// Covers returns TRUE if no point in space.Geometry B is outside space.Geometry A
+func (g *geographyAlgorithm) Covers(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Covers)
+}
+
+// Crosses returns TRUE if the intersection of geometries "spatially cross".
+func (g *geographyAlgorithm) Crosses(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Crosses)
+}
+
+// Disjoint returns TRUE if the geometries do not share any portion of space.
+func (g *geographyAlgorithm) Disjoint(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, true, g.megrezAlgorithm.Disjoint)
+}
+
+// Intersects returns TRUE if the geometries share any portion of space.
+func (g *geographyAlgorithm) Intersects(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Intersects)
+}
+
+// Overlaps returns TRUE if the Geometries "spatially overlap".
+func (g *geographyAlgorithm) Overlaps(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Overlaps)
+}
+
+// Touches returns TRUE if the only points in common between A and B lie in the union of the boundaries of A and B.
+func (g *geographyAlgorithm) Touches(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Touches)
+}
+
+// Within returns TRUE if geometry A is completely inside geometry B.
+func (g *geographyAlgorithm) Within(A, B space.Geometry) (bool, error) {
+	return g.relate(A, B, false, g.megrezAlgorithm.Within)
+}
+
+// Relate computes the intersection matrix (DE-9IM) for the spatial relationship between the two geometries.
+func (g *geographyAlgorithm) Relate(s, d space.Geometry) (string, error) {
+	plane, err := gnomonicPlane(s, d)
+	if err != nil {
+		return "", err
+	}
+	a, b, err := g.project(plane, s, d)
+	if err != nil {
+		return "", err
+	}
+	return g.megrezAlgorithm.Relate(a, b)
+}
+
+// Difference returns a geometry that represents that part of geometry A that does not intersect with geometry B.
+func (g *geographyAlgorithm) Difference(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return g.overlay(geom1, geom2, g.megrezAlgorithm.Difference)
+}
+
+// Intersection returns a geometry that represents the point set intersection of the Geometries.
+func (g *geographyAlgorithm) Intersection(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return g.overlay(geom1, geom2, g.megrezAlgorithm.Intersection)
+}
+
+// SymDifference returns a geometry that represents the portions of A and B that do not intersect.
+func (g *geographyAlgorithm) SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return g.overlay(geom1, geom2, g.megrezAlgorithm.SymDifference)
+}
+
+// Union returns a geometry that represents the point set union of the Geometries.
+func (g *geographyAlgorithm) Union(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return g.overlay(geom1, geom2, g.megrezAlgorithm.Union)
+}
+
+// relate computes the predicate in the gnomonic projection,
+// it returns disjoint when the geometries are too far apart to share any portion of space.
+func (g *geographyAlgorithm) relate(A, B space.Geometry, disjoint bool,
+	predicate func(A, B space.Geometry) (bool, error)) (bool, error) {
+	if A == nil || B == nil {
+		return false, nil
+	}
+	capA, capB := capOf(vectorsOf(A.ToMatrix())), capOf(vectorsOf(B.ToMatrix()))
+	if capA.center.angle(capB.center) > capA.radius+capB.radius+1e-12 {
+		return disjoint, nil
+	}
+	plane, err := gnomonicPlane(A, B)
+	if err != nil {
+		return false, err
+	}
+	a, b, err := g.project(plane, A, B)
+	if err != nil {
+		return false, err
+	}
+	return predicate(a, b)
+}
+
+// overlay computes the overlay in the gnomonic projection.
+func (g *geographyAlgorithm) overlay(geom1, geom2 space.Geometry,
+	f func(geom1, geom2 space.Geometry) (space.Geometry, error)) (space.Geometry, error) {
+	plane, err := gnomonicPlane(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	a, b, err := g.project(plane, geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	result, err := f(a, b)
+	if err != nil || result == nil {
+		return result, err
+	}
+	return transformGeometry(plane.inverseGnomonic(), result)
+}
+
+// unary computes the operation of a geometry in the gnomonic projection.
+func (g *geographyAlgorithm) unary(geom space.Geometry,
+	f func(geom space.Geometry) (space.Geometry, error)) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return f(geom)
+	}
+	plane, err := gnomonicPlane(geom)
+	if err != nil {
+		return nil, err
+	}
+	projected, err := transformGeometry(plane.gnomonic(), geom)
+	if err != nil {
+		return nil, err
+	}
+	result, err := f(projected)
+	if err != nil || result == nil {
+		return result, err
+	}
+	return transformGeometry(plane.inverseGnomonic(), result)
+}
+
+// azimuthal returns the result of f on the geometry projected by the azimuthal equidistant centered on it,
+// which keeps the distances from the center, and projects the result back.
+func (g *geographyAlgorithm) azimuthal(geom space.Geometry,
+	f func(geom space.Geometry) (space.Geometry, error)) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return f(geom)
+	}
+	center, _ := sphericalCentroid(geom.ToMatrix())
+	if center.norm() < 1e-12 {
+		center = capOf(vectorsOf(geom.ToMatrix())).center
+	}
+	plane := newTangentPlane(center.normalize())
+	projected, err := transformGeometry(plane.azimuthalEquidistant(), geom)
+	if err != nil {
+		return nil, err
+	}
+	result, err := f(projected)
+	if err != nil || result == nil {
+		return result, err
+	}
+	return transformGeometry(plane.inverseAzimuthalEquidistant(), result)
+}
+
+// project returns the geometries projected by the gnomonic of plane.
+func (g *geographyAlgorithm) project(plane *tangentPlane, geom1, geom2 space.Geometry) (space.Geometry, space.Geometry, error) {
+	transformer := plane.gnomonic()
+	a, err := transformGeometry(transformer, geom1)
+	if err != nil {
+		return nil, nil, err
+	}
+	b, err := transformGeometry(transformer, geom2)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, b, nil
+}
+
+var _ Algorithm = &geographyAlgorithm{}
@@ -0,0 +1,290 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/coordtransform"
+	"github.com/spatial-go/geoos/space"
+)
+
+// minimum cosine of the angle between the tangent point and the points projected by gnomonic,
+// about 89.4 degree.
+const hemisphereTolerance = 0.01
+
+// vector3 is a unit vector of a point on the sphere.
+type vector3 [3]float64
+
+// toVector returns the unit vector of the point lng lat.
+func toVector(p matrix.Matrix) vector3 {
+	lng, lat := p[0]*math.Pi/180, p[1]*math.Pi/180
+	return vector3{math.Cos(lat) * math.Cos(lng), math.Cos(lat) * math.Sin(lng), math.Sin(lat)}
+}
+
+// toPoint returns the point lng lat of the vector.
+func (v vector3) toPoint() matrix.Matrix {
+	return matrix.Matrix{math.Atan2(v[1], v[0]) * 180 / math.Pi, math.Atan2(v[2], math.Hypot(v[0], v[1])) * 180 / math.Pi}
+}
+
+func (v vector3) dot(u vector3) float64 {
+	return v[0]*u[0] + v[1]*u[1] + v[2]*u[2]
+}
+
+func (v vector3) cross(u vector3) vector3 {
+	return vector3{v[1]*u[2] - v[2]*u[1], v[2]*u[0] - v[0]*u[2], v[0]*u[1] - v[1]*u[0]}
+}
+
+func (v vector3) add(u vector3) vector3 {
+	return vector3{v[0] + u[0], v[1] + u[1], v[2] + u[2]}
+}
+
+func (v vector3) scale(f float64) vector3 {
+	return vector3{v[0] * f, v[1] * f, v[2] * f}
+}
+
+func (v vector3) norm() float64 {
+	return math.Sqrt(v.dot(v))
+}
+
+func (v vector3) normalize() vector3 {
+	if n := v.norm(); n > 0 {
+		return v.scale(1 / n)
+	}
+	return v
+}
+
+// angle returns the central angle between the unit vectors, unit radian.
+func (v vector3) angle(u vector3) float64 {
+	return math.Atan2(v.cross(u).norm(), v.dot(u))
+}
+
+// arcDistance returns the central angle between p and the great circle arc ab.
+func arcDistance(p, a, b vector3) float64 {
+	n := a.cross(b)
+	if n.norm() < 1e-15 {
+		return p.angle(a)
+	}
+	n = n.normalize()
+	q := p.add(n.scale(-p.dot(n)))
+	if a.cross(q).dot(n) >= 0 && q.cross(b).dot(n) >= 0 {
+		return math.Asin(math.Min(1, math.Abs(p.dot(n))))
+	}
+	return math.Min(p.angle(a), p.angle(b))
+}
+
+// vectorsOf returns the unit vectors of all points of the steric.
+func vectorsOf(steric matrix.Steric) []vector3 {
+	points := matrix.TransMatrixes(steric)
+	vectors := make([]vector3, len(points))
+	for i, v := range points {
+		vectors[i] = toVector(v)
+	}
+	return vectors
+}
+
+// arcsOf returns the great circle arcs of the steric, a point is an arc of zero length.
+func arcsOf(steric matrix.Steric) [][2]vector3 {
+	arcs := [][2]vector3{}
+	line := func(l matrix.LineMatrix) {
+		if len(l) == 1 {
+			v := toVector(l[0])
+			arcs = append(arcs, [2]vector3{v, v})
+		}
+		for i := 1; i < len(l); i++ {
+			arcs = append(arcs, [2]vector3{toVector(l[i-1]), toVector(l[i])})
+		}
+	}
+	switch m := steric.(type) {
+	case matrix.Matrix:
+		line(matrix.LineMatrix{m})
+	case matrix.LineMatrix:
+		line(m)
+	case matrix.PolygonMatrix:
+		for _, v := range m {
+			line(v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			arcs = append(arcs, arcsOf(matrix.PolygonMatrix(v))...)
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			arcs = append(arcs, arcsOf(v)...)
+		}
+	}
+	return arcs
+}
+
+// sphereCap is the circle around the mean center of vectors containing all of them.
+type sphereCap struct {
+	center vector3
+	radius float64
+}
+
+func capOf(vectors []vector3) sphereCap {
+	sum := vector3{}
+	for _, v := range vectors {
+		sum = sum.add(v)
+	}
+	c := sphereCap{center: sum.normalize()}
+	if sum.norm() < 1e-12 {
+		return sphereCap{center: vector3{0, 0, 1}, radius: math.Pi}
+	}
+	for _, v := range vectors {
+		c.radius = math.Max(c.radius, c.center.angle(v))
+	}
+	return c
+}
+
+// tangentPlane is the plane tangent to the sphere at center, with east and north axes.
+type tangentPlane struct {
+	center, east, north vector3
+}
+
+func newTangentPlane(center vector3) *tangentPlane {
+	east := vector3{0, 0, 1}.cross(center)
+	if east.norm() < 1e-12 {
+		east = vector3{0, 1, 0}
+	}
+	east = east.normalize()
+	return &tangentPlane{center: center, east: east, north: center.cross(east)}
+}
+
+// gnomonic returns the transformer of the gnomonic projection, great circles are projected to straight lines.
+func (t *tangentPlane) gnomonic() *coordtransform.Transformer {
+	return coordtransform.NewTransformerFunc("LLTOGNOMONIC", func(x, y float64) (float64, float64) {
+		p := toVector(matrix.Matrix{x, y})
+		d := p.dot(t.center)
+		return measure.R * p.dot(t.east) / d, measure.R * p.dot(t.north) / d
+	})
+}
+
+// inverseGnomonic returns the transformer of the inverse gnomonic projection.
+func (t *tangentPlane) inverseGnomonic() *coordtransform.Transformer {
+	return coordtransform.NewTransformerFunc("GNOMONICTOLL", func(x, y float64) (float64, float64) {
+		p := t.center.add(t.east.scale(x / measure.R)).add(t.north.scale(y / measure.R))
+		lnglat := p.normalize().toPoint()
+		return lnglat[0], lnglat[1]
+	})
+}
+
+// azimuthalEquidistant returns the transformer of the azimuthal equidistant projection,
+// distances and directions from the center are preserved.
+func (t *tangentPlane) azimuthalEquidistant() *coordtransform.Transformer {
+	return coordtransform.NewTransformerFunc("LLTOAEQD", func(x, y float64) (float64, float64) {
+		p := toVector(matrix.Matrix{x, y})
+		e, n := p.dot(t.east), p.dot(t.north)
+		s := math.Hypot(e, n)
+		if s == 0 {
+			return 0, 0
+		}
+		rho := measure.R * math.Atan2(s, p.dot(t.center))
+		return rho * e / s, rho * n / s
+	})
+}
+
+// inverseAzimuthalEquidistant returns the transformer of the inverse azimuthal equidistant projection.
+func (t *tangentPlane) inverseAzimuthalEquidistant() *coordtransform.Transformer {
+	return coordtransform.NewTransformerFunc("AEQDTOLL", func(x, y float64) (float64, float64) {
+		rho := math.Hypot(x, y)
+		if rho == 0 {
+			lnglat := t.center.toPoint()
+			return lnglat[0], lnglat[1]
+		}
+		c := rho / measure.R
+		dir := t.east.scale(x / rho).add(t.north.scale(y / rho))
+		lnglat := t.center.scale(math.Cos(c)).add(dir.scale(math.Sin(c))).toPoint()
+		return lnglat[0], lnglat[1]
+	})
+}
+
+// gnomonicPlane returns the tangent plane to project geometries by gnomonic,
+// all points of the geometries must lie in the open hemisphere around the tangent point.
+func gnomonicPlane(geoms ...space.Geometry) (*tangentPlane, error) {
+	vectors := []vector3{}
+	for _, v := range geoms {
+		vectors = append(vectors, vectorsOf(v.ToMatrix())...)
+	}
+	c := capOf(vectors)
+	for _, v := range vectors {
+		if v.dot(c.center) < hemisphereTolerance {
+			return nil, ErrNotInHemisphere
+		}
+	}
+	return newTangentPlane(c.center), nil
+}
+
+// transformGeometry returns the geometry transformed by the transformer.
+func transformGeometry(transformer *coordtransform.Transformer, geom space.Geometry) (space.Geometry, error) {
+	if geom == nil || geom.IsEmpty() {
+		return geom, nil
+	}
+	steric, err := transformer.TransformGeometry(geom.ToMatrix())
+	if err != nil {
+		return nil, err
+	}
+	return space.TransGeometry(steric), nil
+}
+
+// sphericalCentroid returns the centroid of the steric on the sphere,
+// it is the normalized first moment of the points, the arcs or the area, by the highest dimension.
+func sphericalCentroid(steric matrix.Steric) (vector3, int) {
+	sum, dim := vector3{}, -1
+	merge := func(v vector3, d int) {
+		if d > dim {
+			sum, dim = v, d
+		} else if d == dim {
+			sum = sum.add(v)
+		}
+	}
+	lineMoment := func(line matrix.LineMatrix) vector3 {
+		moment := vector3{}
+		for i := 1; i < len(line); i++ {
+			a, b := toVector(line[i-1]), toVector(line[i])
+			// integral of the unit vector along the arc.
+			moment = moment.add(a.add(b).normalize().scale(2 * math.Sin(a.angle(b)/2)))
+		}
+		return moment
+	}
+	ringMoment := func(ring matrix.LineMatrix, around vector3) vector3 {
+		moment := vector3{}
+		for i := 1; i < len(ring); i++ {
+			a, b := toVector(ring[i-1]), toVector(ring[i])
+			n := a.cross(b)
+			if n.norm() > 0 {
+				// integral of the unit vector over the area is half of the sum of the arc angles times the arc normals.
+				moment = moment.add(n.normalize().scale(a.angle(b) / 2))
+			}
+		}
+		if moment.dot(around) < 0 {
+			moment = moment.scale(-1)
+		}
+		return moment
+	}
+	switch m := steric.(type) {
+	case matrix.Matrix:
+		merge(toVector(m), 0)
+	case matrix.LineMatrix:
+		merge(lineMoment(m), 1)
+	case matrix.PolygonMatrix:
+		if len(m) == 0 {
+			break
+		}
+		around := capOf(vectorsOf(matrix.LineMatrix(m[0]))).center
+		moment := ringMoment(m[0], around)
+		for _, v := range m[1:] {
+			moment = moment.add(ringMoment(v, around).scale(-1))
+		}
+		merge(moment, 2)
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			merge(sphericalCentroid(matrix.PolygonMatrix(v)))
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			merge(sphericalCentroid(v))
+		}
+	}
+	return sum, dim
+}
@@ -0,0 +1,163 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestGeography_Measure(t *testing.T) {
+	G := GeographyStrategy()
+	octant := space.Polygon{{{0, 0}, {90, 0}, {0, 90}, {0, 0}}}
+	if got, _ := G.Area(octant); math.Abs(got-math.Pi*measure.R*measure.R/2) > 1 {
+		t.Errorf("Area() got = %v, want %v", got, math.Pi*measure.R*measure.R/2)
+	}
+	if got, _ := G.Length(space.LineString{{0, 0}, {90, 0}}); math.Abs(got-math.Pi*measure.R/2) > 1e-6 {
+		t.Errorf("Length() got = %v, want %v", got, math.Pi*measure.R/2)
+	}
+	tests := []struct {
+		name         string
+		geom1, geom2 space.Geometry
+		want         float64
+	}{
+		{name: "point point", geom1: space.Point{0, 0}, geom2: space.Point{180, 0}, want: math.Pi * measure.R},
+		{name: "point line antimeridian", geom1: space.Point{180, 1},
+			geom2: space.LineString{{170, 0}, {-170, 0}}, want: math.Pi / 180 * measure.R},
+		{name: "point in polygon", geom1: space.Point{180, 1},
+			geom2: space.Polygon{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}}}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.Distance(tt.geom1, tt.geom2)
+			if err != nil {
+				t.Fatalf("Distance() error = %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("Distance() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeography_Relate(t *testing.T) {
+	G := GeographyStrategy()
+	antimeridian := space.Polygon{{{170, -10}, {-170, -10}, {-170, 10}, {170, 10}, {170, -10}}}
+	// the bottom edge is a great circle arc bulging to latitude 67.79.
+	arctic := space.Polygon{{{0, 60}, {90, 60}, {90, 80}, {0, 80}, {0, 60}}}
+	tests := []struct {
+		name         string
+		f            func(A, B space.Geometry) (bool, error)
+		geom1, geom2 space.Geometry
+		want         bool
+	}{
+		{name: "contains antimeridian", f: G.Contains, geom1: antimeridian, geom2: space.Point{180, 0}, want: true},
+		{name: "not contains prime meridian", f: G.Contains, geom1: antimeridian, geom2: space.Point{0, 0}, want: false},
+		{name: "intersects great circle edge", f: G.Intersects, geom1: arctic, geom2: space.Point{45, 62}, want: false},
+		{name: "intersects inside", f: G.Intersects, geom1: arctic, geom2: space.Point{45, 70}, want: true},
+		{name: "within", f: G.Within, geom1: space.LineString{{175, 0}, {-175, 0}}, geom2: antimeridian, want: true},
+		{name: "disjoint antipodal", f: G.Disjoint, geom1: space.Point{0, 0}, geom2: space.Point{180, 0}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.f(tt.geom1, tt.geom2)
+			if err != nil {
+				t.Fatalf("error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	world := space.Polygon{{{-170, -80}, {-10, -80}, {100, 80}, {170, 80}, {-170, -80}}}
+	if _, err := G.Intersects(world, space.Point{0, 0}); err != ErrNotInHemisphere {
+		t.Errorf("Intersects() error = %v, want %v", err, ErrNotInHemisphere)
+	}
+}
+
+func TestGeography_Centroid(t *testing.T) {
+	G := GeographyStrategy()
+	tests := []struct {
+		name string
+		geom space.Geometry
+		want space.Point
+	}{
+		{name: "points antimeridian", geom: space.MultiPoint{{179, 0}, {-179, 0}}, want: space.Point{180, 0}},
+		{name: "line antimeridian", geom: space.LineString{{170, 0}, {-170, 0}}, want: space.Point{180, 0}},
+		{name: "polygon pole", geom: space.Polygon{{{0, 80}, {90, 80}, {180, 80}, {-90, 80}, {0, 80}}}, want: space.Point{0, 90}},
+		{name: "polygon", geom: space.Polygon{{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}}}, want: space.Point{0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := G.Centroid(tt.geom)
+			p := got.(space.Point)
+			if math.Abs(p.Lat()-tt.want.Lat()) > 1e-9 || (math.Abs(p.Lat()) < 90-1e-9 &&
+				math.Abs(math.Remainder(p.Lon()-tt.want.Lon(), 360)) > 1e-9) {
+				t.Errorf("Centroid() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGeography_Buffer(t *testing.T) {
+	G := GeographyStrategy()
+	buffer := G.Buffer(space.Point{180, 60}, 10000, 16)
+	area, _ := G.Area(buffer)
+	if math.Abs(area-math.Pi*1e8)/(math.Pi*1e8) > 0.01 {
+		t.Errorf("Buffer() area = %v, want %v", area, math.Pi*1e8)
+	}
+	for _, v := range []space.Point{{-179.9, 60}, {179.9, 60}, {180, 60.08}} {
+		if ok, _ := G.Contains(buffer, v); !ok {
+			t.Errorf("Buffer() not contains %v", v)
+		}
+	}
+	if ok, _ := G.Contains(buffer, space.Point{180, 60.1}); ok {
+		t.Errorf("Buffer() contains %v", space.Point{180, 60.1})
+	}
+}
+
+func TestGeography_BufferWithParams(t *testing.T) {
+	G := GeographyStrategy()
+	road := space.LineString{{116, 40}, {116.1, 40}}
+	length, _ := G.Length(road)
+	params := buffer.DefaultCurveParameters()
+	params.EndCapStyle = calc.CapFlat
+	corridor := G.BufferWithParams(road, 100, params)
+	area, _ := G.Area(corridor)
+	if math.Abs(area-200*length)/(200*length) > 0.01 {
+		t.Errorf("BufferWithParams() area = %v, want %v", area, 200*length)
+	}
+	params.IsSingleSided = true
+	north, south := G.BufferWithParams(road, 100, params), G.BufferWithParams(road, -100, params)
+	if ok, _ := G.Contains(north, space.Point{116.05, 40.0005}); !ok {
+		t.Errorf("BufferWithParams() left side not contains %v", space.Point{116.05, 40.0005})
+	}
+	if ok, _ := G.Contains(south, space.Point{116.05, 40.0005}); ok {
+		t.Errorf("BufferWithParams() right side contains %v", space.Point{116.05, 40.0005})
+	}
+}
+
+func TestGeography_ConcaveHullByLength(t *testing.T) {
+	G := GeographyStrategy()
+	// GPS points about 100 m apart along two streets meeting at a corner.
+	points := space.MultiPoint{}
+	for i := 0; i <= 10; i++ {
+		points = append(points, space.Point{116 + float64(i)*0.001, 40}, space.Point{116, 40 + float64(i)*0.001},
+			space.Point{116 + float64(i)*0.001, 40.0005}, space.Point{116.0005, 40 + float64(i)*0.001})
+	}
+	hull, err := G.ConcaveHullByLength(points, 200, false)
+	if err != nil {
+		t.Fatalf("ConcaveHullByLength() error = %v", err)
+	}
+	area, _ := G.Area(hull)
+	// the two streets are about 850 m x 55 m and 1110 m x 43 m.
+	if area < 90000 || area > 100000 {
+		t.Errorf("ConcaveHullByLength() area = %v, want about %v", area, 95000)
+	}
+	if ok, _ := G.Contains(hull, space.Point{116.005, 40.005}); ok {
+		t.Errorf("ConcaveHullByLength() contains %v", space.Point{116.005, 40.005})
+	}
+}
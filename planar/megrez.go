@@ -1,7 +1,9 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // megrezAlgorithm algorithm implement
@@ -41,3 +43,29 @@ func (g *megrezAlgorithm) IsRing(geom space.Geometry) (bool, error) {
 func (g *megrezAlgorithm) IsSimple(geom space.Geometry) (bool, error) {
 	return geom.IsSimple(), nil
 }
+
+// ValidateDetail returns the reason why geometry is not valid, with the type of the error
+// (self-intersection, ring not closed, too few points, hole outside shell, nested shells, disconnected interior,
+// invalid coordinate and so on) and the coordinate where it is found. It returns nil if geometry is valid.
+func (g *megrezAlgorithm) ValidateDetail(geom space.Geometry) (*operation.ValidationError, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.Validate(geom), nil
+}
+
+// MakeValid returns a valid geometry of geometry without losing its vertices.
+// The area of a polygon is the part of the plane inside an odd number of its rings,
+// self-intersecting rings and bow-ties are split, overlapping holes cancel, a hole outside the shell is a polygon,
+// the overlapping polygons of a MultiPolygon are merged. Repeated points are removed and
+// the collapsed rings and lines become LineStrings or Points.
+// It returns a Polygon or MultiPolygon for polygons, a Collection of the valid elements for collections.
+func (g *megrezAlgorithm) MakeValid(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if geom.IsEmpty() {
+		return geom, nil
+	}
+	return space.TransGeometry(operation.MakeValid(geom.ToMatrix())), nil
+}
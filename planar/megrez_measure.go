@@ -1,8 +1,12 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/linref"
+	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Area returns the area of a polygonal geometry.
@@ -28,6 +32,26 @@ func (g *megrezAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float6
 	return geom1.SpheroidDistance(geom2)
 }
 
+// GeodesicArea returns the ellipsoidal area of a polygonal geometry whose coordinates are lng lat, unit m².
+func (g *megrezAlgorithm) GeodesicArea(geom space.Geometry) (float64, error) {
+	return space.GeodesicArea(geom), nil
+}
+
+// GeodesicDistance returns the minimum ellipsoidal distance between two geometries whose coordinates are lng lat, unit m.
+func (g *megrezAlgorithm) GeodesicDistance(geom1, geom2 space.Geometry) (float64, error) {
+	return space.GeodesicDistance(geom1, geom2)
+}
+
+// GeodesicLength returns the ellipsoidal length of the geometry whose coordinates are lng lat, unit m.
+func (g *megrezAlgorithm) GeodesicLength(geom space.Geometry) (float64, error) {
+	return space.GeodesicLength(geom), nil
+}
+
+// GeodesicPerimeter returns the ellipsoidal perimeter of a polygonal geometry whose coordinates are lng lat, unit m.
+func (g *megrezAlgorithm) GeodesicPerimeter(geom space.Geometry) (float64, error) {
+	return space.GeodesicPerimeter(geom), nil
+}
+
 // HausdorffDistance returns the Hausdorff distance between two geometries, a measure of how similar
 // or dissimilar 2 geometries are. Implements algorithm for computing a distance metric which can be
 // thought of as the "Discrete Hausdorff Distance". This is the Hausdorff distance restricted
@@ -50,3 +74,143 @@ func (g *megrezAlgorithm) Length(geom space.Geometry) (float64, error) {
 func (g *megrezAlgorithm) NGeometry(geom space.Geometry) (int, error) {
 	return geom.Nums(), nil
 }
+
+// LineInterpolatePoint returns the point at fraction in [0,1] of the length of a LineString or MultiLineString,
+// its Z and M are interpolated.
+func (g *megrezAlgorithm) LineInterpolatePoint(geom space.Geometry, fraction float64) (space.Point, error) {
+	if fraction < 0 || fraction > 1 {
+		return nil, algorithm.ErrWrongFractionRange
+	}
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return nil, err
+	}
+	return space.Point(line.ExtractPoint(fraction * line.Length())), nil
+}
+
+// LineInterpolateDistance returns the point at distance along a LineString or MultiLineString from its start,
+// a negative distance is measured back from its end. The distance is clamped to the line.
+func (g *megrezAlgorithm) LineInterpolateDistance(geom space.Geometry, distance float64) (space.Point, error) {
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return nil, err
+	}
+	return space.Point(line.ExtractPoint(distance)), nil
+}
+
+// LineLocatePoint returns the fraction in [0,1] of the length of a LineString or MultiLineString
+// where the point of the line closest to point is.
+func (g *megrezAlgorithm) LineLocatePoint(geom space.Geometry, point space.Point) (float64, error) {
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return 0, err
+	}
+	if line.Length() == 0 {
+		return 0, nil
+	}
+	return line.Project(point.ToMatrix().(matrix.Matrix)) / line.Length(), nil
+}
+
+// LineLocateDistance returns the distance along a LineString or MultiLineString from its start
+// to the point of the line closest to point.
+func (g *megrezAlgorithm) LineLocateDistance(geom space.Geometry, point space.Point) (float64, error) {
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return 0, err
+	}
+	return line.Project(point.ToMatrix().(matrix.Matrix)), nil
+}
+
+// LineSubstring returns the part of a LineString or MultiLineString between the start and end fractions
+// in [0,1] of its length. It is reversed if startFraction is greater than endFraction.
+func (g *megrezAlgorithm) LineSubstring(geom space.Geometry, startFraction, endFraction float64) (space.Geometry, error) {
+	if startFraction < 0 || startFraction > 1 || endFraction < 0 || endFraction > 1 {
+		return nil, algorithm.ErrWrongFractionRange
+	}
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return nil, err
+	}
+	return space.TransGeometry(line.ExtractLine(startFraction*line.Length(), endFraction*line.Length())), nil
+}
+
+// LineSubstringDistance returns the part of a LineString or MultiLineString between the start and end distances
+// along it, a negative distance is measured back from its end. It is reversed if start is after end.
+func (g *megrezAlgorithm) LineSubstringDistance(geom space.Geometry, startDistance, endDistance float64) (space.Geometry, error) {
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return nil, err
+	}
+	return space.TransGeometry(line.ExtractLine(startDistance, endDistance)), nil
+}
+
+// LineOffsetPoint returns the point at offset from the point at distance along a LineString or MultiLineString,
+// perpendicular to the line. A positive offset is on the left of the line, a negative offset on the right.
+func (g *megrezAlgorithm) LineOffsetPoint(geom space.Geometry, distance, offset float64) (space.Point, error) {
+	line, err := lengthIndexedLine(geom)
+	if err != nil {
+		return nil, err
+	}
+	point, err := line.ExtractPointOffset(distance, offset)
+	if err != nil {
+		return nil, err
+	}
+	return space.Point(point), nil
+}
+
+// LocateAlong returns the MultiPoint of the points of a LineString or MultiLineString whose measure M is measure,
+// at offset from the line, a positive offset is on the left of the line.
+func (g *megrezAlgorithm) LocateAlong(geom space.Geometry, measure, offset float64) (space.Geometry, error) {
+	if err := checkLineal(geom); err != nil {
+		return nil, err
+	}
+	points, err := linref.LocateAlong(geom.ToMatrix(), measure, offset)
+	if err != nil {
+		return nil, err
+	}
+	if len(points) == 0 {
+		return space.MultiPoint{}, nil
+	}
+	return space.TransGeometry(points), nil
+}
+
+// LocateBetween returns the parts of a LineString or MultiLineString whose measures M are between from and to,
+// a MultiLineString, or a Collection if the line only touches the range at some points.
+func (g *megrezAlgorithm) LocateBetween(geom space.Geometry, from, to float64) (space.Geometry, error) {
+	if err := checkLineal(geom); err != nil {
+		return nil, err
+	}
+	parts, err := linref.LocateBetween(geom.ToMatrix(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	if len(parts) == 0 {
+		return space.MultiLineString{}, nil
+	}
+	result := space.TransGeometry(parts)
+	if line, ok := result.(space.LineString); ok {
+		return space.MultiLineString{line}, nil
+	}
+	return result, nil
+}
+
+// lengthIndexedLine returns the length indexed line of a LineString or MultiLineString.
+func lengthIndexedLine(geom space.Geometry) (*linref.LengthIndexedLine, error) {
+	if err := checkLineal(geom); err != nil {
+		return nil, err
+	}
+	return linref.NewLengthIndexedLine(geom.ToMatrix())
+}
+
+// checkLineal returns an error if the geometry is not a LineString or MultiLineString.
+func checkLineal(geom space.Geometry) error {
+	if geom == nil {
+		return spaceerr.ErrNilGeometry
+	}
+	switch geom.GeoJSONType() {
+	case space.TypeLineString, space.TypeMultiLineString:
+		return nil
+	default:
+		return spaceerr.ErrNotSupportGeometry
+	}
+}
@@ -1,10 +1,13 @@
 package planar
 
 import (
+	"math"
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Area(t *testing.T) {
@@ -251,3 +254,120 @@ func TestMegrezAlgorithm_HausdorffDistanceDensify(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_Geodesic(t *testing.T) {
+	octant := space.Polygon{{{0, 0}, {90, 0}, {0, 90}, {0, 0}}}
+	hole := space.Polygon{{{0, 0}, {90, 0}, {0, 90}, {0, 0}}, {{10, 10}, {10, 20}, {20, 10}, {10, 10}}}
+	line := space.LineString{{-73.8, 40.6}, {-0.5, 51.6}}
+	tests := []struct {
+		name      string
+		g         space.Geometry
+		area      float64
+		perimeter float64
+		length    float64
+	}{
+		{name: "octant", g: octant, area: 63758202715511.055, perimeter: 30022685.630020, length: 30022685.630020},
+		{name: "multi polygon", g: space.MultiPolygon{octant, octant}, area: 2 * 63758202715511.055,
+			perimeter: 2 * 30022685.630020, length: 2 * 30022685.630020},
+		{name: "line", g: line, length: 5551759.400319},
+		{name: "polygon with hole", g: hole, area: 63758202715511.055 - 609456892669.085,
+			perimeter: 30022685.630020 + 3744719.409457, length: 30022685.630020 + 3744719.409457},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			area, _ := G.GeodesicArea(tt.g)
+			perimeter, _ := G.GeodesicPerimeter(tt.g)
+			length, _ := G.GeodesicLength(tt.g)
+			if math.Abs(area-tt.area) > 1 || math.Abs(perimeter-tt.perimeter) > 1e-3 || math.Abs(length-tt.length) > 1e-3 {
+				t.Errorf("Geodesic() got = %v %v %v, want %v %v %v", area, perimeter, length, tt.area, tt.perimeter, tt.length)
+			}
+		})
+	}
+	distances := []struct {
+		name     string
+		from, to space.Geometry
+		want     float64
+	}{
+		{name: "point point", from: space.Point{-73.8, 40.6}, to: space.Point{-0.5, 51.6}, want: 5551759.400319},
+		{name: "point line", from: space.Point{0, 0}, to: space.LineString{{-1, 1}, {1, 1}}, want: 110591.342226},
+		{name: "line line", from: space.LineString{{-1, 0}, {1, 0}}, to: space.LineString{{-1, 1}, {1, 1}}, want: 110574.388558},
+		{name: "point polygon", from: space.Point{0, 0}, to: space.Polygon{{{-1, 1}, {1, 1}, {1, 2}, {-1, 2}, {-1, 1}}}, want: 110591.342226},
+		{name: "point in polygon", from: space.Point{0, 1.5}, to: space.Polygon{{{-1, 1}, {1, 1}, {1, 2}, {-1, 2}, {-1, 1}}}, want: 0},
+	}
+	for _, tt := range distances {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := G.GeodesicDistance(tt.from, tt.to); err != nil || math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("GeodesicDistance() got = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_LinearReferencing(t *testing.T) {
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0,10 10)`)
+	multiLine, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0),(20 0,20 10))`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 0))`)
+	G := NormalStrategy()
+
+	point, err := G.LineInterpolatePoint(line, 0.75)
+	if err != nil || !point.Equals(space.Point{10, 5}) {
+		t.Errorf("LineInterpolatePoint() got = %v, %v, want %v", point, err, space.Point{10, 5})
+	}
+	point, err = G.LineInterpolateDistance(multiLine, 15)
+	if err != nil || !point.Equals(space.Point{20, 5}) {
+		t.Errorf("LineInterpolateDistance() got = %v, %v, want %v", point, err, space.Point{20, 5})
+	}
+	fraction, err := G.LineLocatePoint(line, space.Point{12, 4})
+	if err != nil || fraction != 0.7 {
+		t.Errorf("LineLocatePoint() got = %v, %v, want %v", fraction, err, 0.7)
+	}
+	distance, err := G.LineLocateDistance(multiLine, space.Point{21, 3})
+	if err != nil || distance != 13 {
+		t.Errorf("LineLocateDistance() got = %v, %v, want %v", distance, err, 13)
+	}
+	substring, err := G.LineSubstring(line, 0.25, 0.75)
+	if want, _ := wkt.UnmarshalString(`LINESTRING(5 0,10 0,10 5)`); err != nil || !substring.Equals(want) {
+		t.Errorf("LineSubstring() got = %v, %v, want %v", substring, err, want)
+	}
+	substring, err = G.LineSubstringDistance(multiLine, 5, 15)
+	if want, _ := wkt.UnmarshalString(`MULTILINESTRING((5 0,10 0),(20 0,20 5))`); err != nil || !substring.Equals(want) {
+		t.Errorf("LineSubstringDistance() got = %v, %v, want %v", substring, err, want)
+	}
+	point, err = G.LineOffsetPoint(line, 5, -2)
+	if err != nil || !point.Equals(space.Point{5, -2}) {
+		t.Errorf("LineOffsetPoint() got = %v, %v, want %v", point, err, space.Point{5, -2})
+	}
+
+	if _, err := G.LineInterpolatePoint(line, 1.5); err != algorithm.ErrWrongFractionRange {
+		t.Errorf("LineInterpolatePoint() error = %v, want %v", err, algorithm.ErrWrongFractionRange)
+	}
+	if _, err := G.LineLocatePoint(polygon, space.Point{1, 1}); err != spaceerr.ErrNotSupportGeometry {
+		t.Errorf("LineLocatePoint() error = %v, want %v", err, spaceerr.ErrNotSupportGeometry)
+	}
+	if _, err := G.LineSubstringDistance(nil, 0, 1); err != spaceerr.ErrNilGeometry {
+		t.Errorf("LineSubstringDistance() error = %v, want %v", err, spaceerr.ErrNilGeometry)
+	}
+}
+
+func TestAlgorithm_LocateMeasure(t *testing.T) {
+	track, _ := wkt.UnmarshalString(`LINESTRING M(0 0 0,10 0 10,10 10 20)`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0)`)
+	G := NormalStrategy()
+
+	points, err := G.LocateAlong(track, 15, 0)
+	if want, _ := wkt.UnmarshalString(`MULTIPOINT M(10 5 15)`); err != nil || !points.Equals(want) {
+		t.Errorf("LocateAlong() got = %v, %v, want %v", wkt.MarshalString(points), err, wkt.MarshalString(want))
+	}
+	parts, err := G.LocateBetween(track, 5, 15)
+	if want, _ := wkt.UnmarshalString(`MULTILINESTRING M((5 0 5,10 0 10,10 5 15))`); err != nil || !parts.Equals(want) {
+		t.Errorf("LocateBetween() got = %v, %v, want %v", wkt.MarshalString(parts), err, wkt.MarshalString(want))
+	}
+	point, err := G.LineInterpolatePoint(track, 0.25)
+	if err != nil || wkt.MarshalString(point) != "POINT M(5 0 5)" {
+		t.Errorf("LineInterpolatePoint() got = %v, %v, want %v", wkt.MarshalString(point), err, "POINT M(5 0 5)")
+	}
+	if _, err := G.LocateAlong(line, 5, 0); err != algorithm.ErrNoMeasure {
+		t.Errorf("LocateAlong() error = %v, want %v", err, algorithm.ErrNoMeasure)
+	}
+}
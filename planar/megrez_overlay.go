@@ -3,7 +3,9 @@ package planar
 import (
 	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/algorithm/overlay"
+	"github.com/spatial-go/geoos/algorithm/polygonize"
 	"github.com/spatial-go/geoos/algorithm/sharedpaths"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
@@ -68,6 +70,36 @@ func (g *megrezAlgorithm) LineMerge(geom space.Geometry) (space.Geometry, error)
 	return lm, nil
 }
 
+// Polygonize returns the polygons formed by the lines and polygon rings of geometry as a Collection of Polygons.
+// The lines are noded at their intersections, the faces inside a polygon are its holes.
+func (g *megrezAlgorithm) Polygonize(geom space.Geometry) (space.Geometry, error) {
+	polygons, _, _, _, err := g.PolygonizeFull(geom)
+	return polygons, err
+}
+
+// PolygonizeFull returns the polygons formed by the lines and polygon rings of geometry as Polygonize,
+// and the lines which do not bound a polygon as MultiLineStrings:
+// the dangles with an end not connected to other lines, the cut edges with the same polygon on both sides,
+// and the invalid ring lines, closed rings which touch themselves.
+func (g *megrezAlgorithm) PolygonizeFull(geom space.Geometry) (polygons, dangles, cutEdges, invalidRingLines space.Geometry, err error) {
+	if geom == nil || geom.IsEmpty() {
+		return space.Collection{}, space.MultiLineString{}, space.MultiLineString{}, space.MultiLineString{}, nil
+	}
+	p := polygonize.Polygonize(geom.ToMatrix())
+	coll := space.Collection{}
+	for _, v := range p.Polygons() {
+		coll = append(coll, space.Polygon(v.(matrix.PolygonMatrix)))
+	}
+	lines := func(c matrix.Collection) space.MultiLineString {
+		ml := space.MultiLineString{}
+		for _, v := range c {
+			ml = append(ml, space.LineString(v.(matrix.LineMatrix)))
+		}
+		return ml
+	}
+	return coll, lines(p.Dangles()), lines(p.CutEdges()), lines(p.InvalidRingLines()), nil
+}
+
 // SharedPaths returns a collection containing paths shared by the two input geometries.
 // Those going in the same direction are in the first element of the collection,
 // those going in the opposite direction are in the second element.
@@ -90,6 +122,73 @@ func (g *megrezAlgorithm) SharedPaths(geom1, geom2 space.Geometry) (string, erro
 	return wkt.MarshalString(coll), nil
 }
 
+// Split returns the parts of geom cut by blade as a Collection, in order along the lines or
+// ordered by the lower left corner of the bound of the polygons.
+// A LineString or MultiLineString is cut by a Point, MultiPoint, LineString or MultiLineString,
+// a Polygon or MultiPolygon is cut by a LineString or MultiLineString.
+func (g *megrezAlgorithm) Split(geom, blade space.Geometry) (space.Geometry, error) {
+	if geom == nil || blade == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	parts := matrix.Collection{}
+	switch geom.GeoJSONType() {
+	case space.TypeLineString, space.TypeMultiLineString:
+		lines := splitLines(geom)
+		switch blade.GeoJSONType() {
+		case space.TypePoint, space.TypeMultiPoint:
+			points := []matrix.Matrix{}
+			for _, v := range blade.UniquePoints() {
+				points = append(points, v.ToMatrix().(matrix.Matrix))
+			}
+			for _, line := range lines {
+				parts = append(parts, operation.SplitLine(line, points)...)
+			}
+		case space.TypeLineString, space.TypeMultiLineString:
+			blades := splitLines(blade)
+			for _, line := range lines {
+				parts = append(parts, operation.SplitLineByLines(line, blades)...)
+			}
+		default:
+			return nil, spaceerr.ErrNotSupportGeometry
+		}
+	case space.TypePolygon, space.TypeMultiPolygon:
+		if blade.GeoJSONType() != space.TypeLineString && blade.GeoJSONType() != space.TypeMultiLineString {
+			return nil, spaceerr.ErrNotSupportGeometry
+		}
+		blades := splitLines(blade)
+		switch m := geom.ToMatrix().(type) {
+		case matrix.PolygonMatrix:
+			parts = append(parts, operation.SplitPolygon(m, blades)...)
+		case matrix.Collection:
+			for _, v := range m {
+				parts = append(parts, operation.SplitPolygon(v.(matrix.PolygonMatrix), blades)...)
+			}
+		}
+	default:
+		return nil, spaceerr.ErrNotSupportGeometry
+	}
+	coll := space.Collection{}
+	for _, v := range parts {
+		coll = append(coll, space.TransGeometry(v))
+	}
+	return coll, nil
+}
+
+// splitLines returns the lines of a LineString or MultiLineString.
+func splitLines(geom space.Geometry) []matrix.LineMatrix {
+	switch m := geom.ToMatrix().(type) {
+	case matrix.LineMatrix:
+		return []matrix.LineMatrix{m}
+	case matrix.Collection:
+		lines := []matrix.LineMatrix{}
+		for _, v := range m {
+			lines = append(lines, v.(matrix.LineMatrix))
+		}
+		return lines
+	}
+	return nil
+}
+
 // SymDifference returns a geometry that represents the portions of A and B that do not intersect.
 // It is called a symmetric difference because SymDifference(A,B) = SymDifference(B,A).
 // One can think of this as Union(geomA,geomB) - Intersection(A,B).
@@ -7,6 +7,7 @@ import (
 	"github.com/spatial-go/geoos"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Difference(t *testing.T) {
@@ -123,6 +124,32 @@ func TestAlgorithm_LineMerge(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_PolygonizeFull(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0,10 10,0 10,0 0),(2 2,8 2,8 8,2 8,2 2),(5 -5,5 5))`)
+	polygon0, _ := wkt.UnmarshalString(`POLYGON((5 0,10 0,10 10,0 10,0 0,5 0),(2 2,2 8,8 8,8 2,5 2,2 2))`)
+	polygon1, _ := wkt.UnmarshalString(`POLYGON((5 2,8 2,8 8,2 8,2 2,5 2))`)
+	expectPolygons := space.Collection{polygon0, polygon1}
+	expectDangles, _ := wkt.UnmarshalString(`MULTILINESTRING((5 5,5 2),(5 -5,5 0))`)
+	expectCutEdges, _ := wkt.UnmarshalString(`MULTILINESTRING((5 0,5 2))`)
+
+	G := NormalStrategy()
+	polygons, dangles, cutEdges, invalidRingLines, err := G.PolygonizeFull(lines)
+	if err != nil {
+		t.Fatalf("PolygonizeFull() error = %v", err)
+	}
+	for _, v := range [][2]space.Geometry{{polygons, expectPolygons}, {dangles, expectDangles}, {cutEdges, expectCutEdges}} {
+		if !v[0].Equals(v[1]) {
+			t.Errorf("PolygonizeFull() got = %v, want %v", wkt.MarshalString(v[0]), wkt.MarshalString(v[1]))
+		}
+	}
+	if !invalidRingLines.IsEmpty() {
+		t.Errorf("PolygonizeFull() invalid ring lines = %v, want empty", wkt.MarshalString(invalidRingLines))
+	}
+	if got, _ := G.Polygonize(lines); !got.Equals(expectPolygons) {
+		t.Errorf("Polygonize() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(expectPolygons))
+	}
+}
+
 func TestAlgorithm_SymDifference(t *testing.T) {
 	line01, _ := wkt.UnmarshalString(`LINESTRING(50 100, 50 200)`)
 	line02, _ := wkt.UnmarshalString(`LINESTRING(50 50, 50 150)`)
@@ -194,6 +221,62 @@ func TestAlgorithm_SharedPaths(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_Split(t *testing.T) {
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0,10 10)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	multiPolygon, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0,10 0,10 10,0 10,0 0)),((20 0,30 0,30 10,20 10,20 0)))`)
+	points, _ := wkt.UnmarshalString(`MULTIPOINT(10 5,5 0)`)
+	blade, _ := wkt.UnmarshalString(`LINESTRING(5 -5,5 15)`)
+
+	tests := []struct {
+		name    string
+		geom    space.Geometry
+		blade   space.Geometry
+		want    []space.Geometry
+		wantErr error
+	}{
+		{name: "line by points", geom: line, blade: points, want: []space.Geometry{
+			space.LineString{{0, 0}, {5, 0}}, space.LineString{{5, 0}, {10, 0}, {10, 5}}, space.LineString{{10, 5}, {10, 10}},
+		}},
+		{name: "line by line", geom: line, blade: blade, want: []space.Geometry{
+			space.LineString{{0, 0}, {5, 0}}, space.LineString{{5, 0}, {10, 0}, {10, 10}},
+		}},
+		{name: "polygon by line", geom: polygon, blade: blade, want: []space.Geometry{
+			space.Polygon{{{5, 0}, {5, 10}, {0, 10}, {0, 0}, {5, 0}}},
+			space.Polygon{{{10, 0}, {10, 10}, {5, 10}, {5, 0}, {10, 0}}},
+		}},
+		{name: "multi polygon by line", geom: multiPolygon, blade: blade, want: []space.Geometry{
+			space.Polygon{{{5, 0}, {5, 10}, {0, 10}, {0, 0}, {5, 0}}},
+			space.Polygon{{{10, 0}, {10, 10}, {5, 10}, {5, 0}, {10, 0}}},
+			space.Polygon{{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}},
+		}},
+		{name: "polygon by point", geom: polygon, blade: points, wantErr: spaceerr.ErrNotSupportGeometry},
+		{name: "nil", geom: nil, blade: blade, wantErr: spaceerr.ErrNilGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.Split(tt.geom, tt.blade)
+			if err != tt.wantErr {
+				t.Errorf("Split() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			parts := got.(space.Collection)
+			if len(parts) != len(tt.want) {
+				t.Fatalf("Split() got = %v, want %v", wkt.MarshalString(got), tt.want)
+			}
+			for i, v := range parts {
+				if !v.Equals(tt.want[i]) {
+					t.Errorf("Split() part %v got = %v, want %v", i, wkt.MarshalString(v), wkt.MarshalString(tt.want[i]))
+				}
+			}
+		})
+	}
+}
+
 func TestAlgorithm_UnaryUnion(t *testing.T) {
 
 	type args struct {
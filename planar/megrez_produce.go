@@ -1,13 +1,17 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm"
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/buffer/simplify"
 	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/algorithm/overlay/snap"
+	"github.com/spatial-go/geoos/algorithm/triangulate"
 	"github.com/spatial-go/geoos/coordtransform"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Boundary returns the closure of the combinatorial boundary of this space.Geometry.
@@ -28,6 +32,58 @@ func (g *megrezAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs in
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (g *megrezAlgorithm) BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) (geometry space.Geometry) {
+	buff := buffer.BufferWithParams(geom.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return space.LineString(b)
+	case matrix.PolygonMatrix:
+		return space.Polygon(b)
+	}
+	return nil
+}
+
+// OffsetCurve returns the lines parallel to a LineString or MultiLineString at distance,
+// on the left for a positive distance and on the right for a negative one,
+// the joins are given by the join style, mitre limit and quadrant segments of the parameters.
+// The parts of the offset which overlap the line are removed, a closed line is offset as a ring.
+// It returns a LineString, or a MultiLineString if there are several lines or none.
+func (g *megrezAlgorithm) OffsetCurve(geom space.Geometry, distance float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	lines := []matrix.LineMatrix{}
+	switch m := geom.ToMatrix().(type) {
+	case matrix.LineMatrix:
+		if geom.GeoJSONType() != space.TypeLineString {
+			return nil, spaceerr.ErrNotSupportGeometry
+		}
+		lines = append(lines, m)
+	case matrix.Collection:
+		if geom.GeoJSONType() != space.TypeMultiLineString {
+			return nil, spaceerr.ErrNotSupportGeometry
+		}
+		for _, v := range m {
+			lines = append(lines, v.(matrix.LineMatrix))
+		}
+	default:
+		return nil, spaceerr.ErrNotSupportGeometry
+	}
+	ml := space.MultiLineString{}
+	for _, line := range lines {
+		for _, v := range buffer.OffsetCurve(line, distance, params) {
+			ml = append(ml, space.LineString(v.(matrix.LineMatrix)))
+		}
+	}
+	if len(ml) == 1 {
+		return ml[0], nil
+	}
+	return ml, nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (g *megrezAlgorithm) BufferInMeter(geom space.Geometry, width float64, quadsegs int) (geometry space.Geometry) {
@@ -41,13 +97,43 @@ func (g *megrezAlgorithm) BufferInMeter(geom space.Geometry, width float64, quad
 	geom = space.TransGeometry(geomMatrix)
 	geometry = g.Buffer(geom, width, quadsegs)
 	if geometry != nil {
-		transformer.CoordType = coordtransform.MERCATORTOLL
+		transformer = coordtransform.NewTransformer(coordtransform.MERCATORTOLL)
 		geomMatrix, _ := transformer.TransformGeometry(geometry.ToMatrix())
 		geometry = space.TransGeometry(geomMatrix)
 	}
 	return
 }
 
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (g *megrezAlgorithm) Densify(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error) {
+	if maxSegmentLength <= 0 {
+		return nil, algorithm.ErrWrongSegmentLength
+	}
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return geom.Densify(maxSegmentLength), nil
+}
+
+// GeodesicDensify returns the geometry of lng lat with points inserted along great circles,
+// so that no segment is longer than maxSegmentLength, unit m.
+func (g *megrezAlgorithm) GeodesicDensify(geom space.Geometry, maxSegmentLength float64) (space.Geometry, error) {
+	if maxSegmentLength <= 0 {
+		return nil, algorithm.ErrWrongSegmentLength
+	}
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.GeodesicDensify(geom, maxSegmentLength), nil
+}
+
+// GeodesicBuffer Returns a geometry that represents all points whose geodesic distance
+// from this space.Geometry of lng lat is less than or equal to distance, unit m.
+func (g *megrezAlgorithm) GeodesicBuffer(geom space.Geometry, width float64, quadsegs int) space.Geometry {
+	return space.GeodesicBuffer(geom, width, quadsegs)
+}
+
 // Centroid  computes the geometric center of a geometry, or equivalently, the center of mass of the geometry as a POINT.
 // For [MULTI]POINTs, this is computed as the arithmetic mean of the input coordinates.
 // For [MULTI]LINESTRINGs, this is computed as the weighted length of each line segment.
@@ -73,6 +159,71 @@ func (g *megrezAlgorithm) ConvexHull(geom space.Geometry) (space.Geometry, error
 	return space.TransGeometry(result), nil
 }
 
+// ConcaveHull computes the concave hull of a geometry, a Polygon containing all its vertices
+// whose border edges are not longer than the edge length of lengthRatio in [0,1] between
+// the shortest and the longest edges of the Delaunay triangulation of the vertices.
+// A lengthRatio of 1 gives the convex hull, a lengthRatio of 0 gives the most concave hull.
+// The hull of polygons contains the polygons and fills the gaps between them.
+// If allowHoles, the hull may have holes where the vertices leave empty areas.
+func (g *megrezAlgorithm) ConcaveHull(geom space.Geometry, lengthRatio float64, allowHoles bool) (space.Geometry, error) {
+	if lengthRatio < 0 || lengthRatio > 1 {
+		return nil, algorithm.ErrWrongFractionRange
+	}
+	if geom == nil || geom.IsEmpty() {
+		return space.Collection{}, nil
+	}
+	result := buffer.ConcaveHullByLengthRatio(geom.ToMatrix(), lengthRatio, allowHoles)
+	return space.TransGeometry(result), nil
+}
+
+// ConcaveHullByLength computes the concave hull of a geometry as ConcaveHull,
+// the border edges of the hull are not longer than maxEdgeLength.
+func (g *megrezAlgorithm) ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, allowHoles bool) (space.Geometry, error) {
+	if maxEdgeLength < 0 {
+		return nil, algorithm.ErrWrongEdgeLength
+	}
+	if geom == nil || geom.IsEmpty() {
+		return space.Collection{}, nil
+	}
+	result := buffer.ConcaveHullByLength(geom.ToMatrix(), maxEdgeLength, allowHoles)
+	return space.TransGeometry(result), nil
+}
+
+// MaximumInscribedCircle computes the largest circle inside a Polygon or MultiPolygon,
+// its centre is the pole of inaccessibility, a visually centred label point.
+// The centre is within tolerance of the pole, a tolerance of 0 is a thousandth of the larger extent of geometry.
+func (g *megrezAlgorithm) MaximumInscribedCircle(geom space.Geometry, tolerance float64) (*space.Circle, error) {
+	if tolerance < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if geom == nil || (geom.GeoJSONType() != space.TypePolygon && geom.GeoJSONType() != space.TypeMultiPolygon) {
+		return nil, ErrNotPolygon
+	}
+	if geom.IsEmpty() {
+		return nil, nil
+	}
+	centre, radius := buffer.MaximumInscribedCircle(geom.ToMatrix(), tolerance)
+	return space.CreateCircle(space.Point(centre), radius)
+}
+
+// LargestEmptyCircle computes the largest circle not overlapping the obstacles with its centre inside the boundary,
+// the convex hull of the obstacles if boundary is nil. The obstacles may be points, lines or polygons.
+// The centre is within tolerance as MaximumInscribedCircle.
+func (g *megrezAlgorithm) LargestEmptyCircle(obstacles, boundary space.Geometry, tolerance float64) (*space.Circle, error) {
+	if tolerance < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if obstacles == nil || obstacles.IsEmpty() {
+		return nil, nil
+	}
+	var bound matrix.Steric
+	if boundary != nil && !boundary.IsEmpty() {
+		bound = boundary.ToMatrix()
+	}
+	centre, radius := buffer.LargestEmptyCircle(obstacles.ToMatrix(), bound, tolerance)
+	return space.CreateCircle(space.Point(centre), radius)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -106,6 +257,69 @@ func (g *megrezAlgorithm) SimplifyP(geom space.Geometry, tolerance float64) (spa
 	return space.TransGeometry(result), nil
 }
 
+// SimplifyVW returns a geometry simplified with the Visvalingam-Whyatt algorithm,
+// the points whose effective area is less than the area tolerance are removed.
+func (g *megrezAlgorithm) SimplifyVW(geom space.Geometry, tolerance float64) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result := simplify.VisvalingamWhyatt(geom.ToMatrix(), tolerance)
+	return space.TransGeometry(result), nil
+}
+
+// SimplifyCoverage returns the polygons of a coverage, a MultiPolygon or a Collection of Polygons
+// which do not overlap and share their boundaries, simplified by amount given by tolerance.
+// The boundaries shared by adjacent polygons are simplified identically, so no gaps or overlaps appear.
+func (g *megrezAlgorithm) SimplifyCoverage(geom space.Geometry, tolerance float64) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	polygons := []matrix.PolygonMatrix{}
+	switch geom.GeoJSONType() {
+	case space.TypeMultiPolygon:
+		for _, v := range geom.(space.MultiPolygon) {
+			polygons = append(polygons, v.ToMatrix().(matrix.PolygonMatrix))
+		}
+		result := space.MultiPolygon{}
+		for _, v := range simplify.CoverageSimplify(polygons, tolerance) {
+			result = append(result, space.Polygon(v))
+		}
+		return result, nil
+	case space.TypeCollection:
+		for _, v := range geom.(space.Collection) {
+			poly, ok := v.(space.Polygon)
+			if !ok {
+				return nil, spaceerr.ErrNotSupportGeometry
+			}
+			polygons = append(polygons, poly.ToMatrix().(matrix.PolygonMatrix))
+		}
+		result := space.Collection{}
+		for _, v := range simplify.CoverageSimplify(polygons, tolerance) {
+			result = append(result, space.Polygon(v))
+		}
+		return result, nil
+	}
+	return nil, spaceerr.ErrNotSupportGeometry
+}
+
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (g *megrezAlgorithm) SmoothChaikin(geom space.Geometry, iterations int) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return geom.SmoothChaikin(iterations), nil
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (g *megrezAlgorithm) SmoothBezier(geom space.Geometry, alpha float64) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return geom.SmoothBezier(alpha), nil
+}
+
 // Snap the vertices and segments of a geometry to another space.Geometry's vertices.
 // A snap distance tolerance is used to control where snapping is performed.
 // The result geometry is the input geometry with the vertices snapped.
@@ -119,3 +333,85 @@ func (g *megrezAlgorithm) Snap(input, reference space.Geometry, tolerance float6
 func (g *megrezAlgorithm) UniquePoints(geom space.Geometry) (space.Geometry, error) {
 	return geom.UniquePoints(), nil
 }
+
+// DelaunayTriangulation returns the Delaunay triangulation of the vertices of geometry,
+// vertices closer than tolerance are merged.
+// It is a Collection of triangle Polygons, or a MultiLineString of the triangle edges if onlyEdges.
+func (g *megrezAlgorithm) DelaunayTriangulation(geom space.Geometry, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	if tolerance < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if geom == nil || geom.IsEmpty() {
+		return space.Collection{}, nil
+	}
+	builder := triangulate.DelaunayWithGeom(geom.ToMatrix(), tolerance)
+	if onlyEdges {
+		multiLine := space.MultiLineString{}
+		for _, v := range builder.Edges() {
+			multiLine = append(multiLine, space.LineString(v.(matrix.LineMatrix)))
+		}
+		return multiLine, nil
+	}
+	coll := space.Collection{}
+	for _, v := range builder.Triangles() {
+		coll = append(coll, space.Polygon(v.(matrix.PolygonMatrix)))
+	}
+	return coll, nil
+}
+
+// ConstrainedDelaunayTriangulation returns the constrained Delaunay triangulation of geometry,
+// the segments of its lines and polygon rings are edges of the triangles, vertices closer than tolerance are merged.
+// If conforming, the segments are split by Steiner points until all triangles are Delaunay triangles.
+// It is a Collection of triangle Polygons, only the triangles inside the polygons of geometry if it has polygons.
+func (g *megrezAlgorithm) ConstrainedDelaunayTriangulation(geom space.Geometry, tolerance float64, conforming bool) (space.Geometry, error) {
+	if tolerance < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if geom == nil || geom.IsEmpty() {
+		return space.Collection{}, nil
+	}
+	builder := triangulate.ConstrainedDelaunayWithGeom(geom.ToMatrix(), tolerance)
+	if conforming {
+		builder = triangulate.ConformingDelaunayWithGeom(geom.ToMatrix(), tolerance)
+	}
+	coll := space.Collection{}
+	for _, v := range builder.Triangles() {
+		coll = append(coll, space.Polygon(v.(matrix.PolygonMatrix)))
+	}
+	return coll, nil
+}
+
+// TriangulatePolygon returns the triangles of the Polygon or MultiPolygon by ear clipping,
+// a Collection of triangle Polygons whose vertices are the vertices of geometry.
+func (g *megrezAlgorithm) TriangulatePolygon(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil || (geom.GeoJSONType() != space.TypePolygon && geom.GeoJSONType() != space.TypeMultiPolygon) {
+		return nil, ErrNotPolygon
+	}
+	coll := space.Collection{}
+	for _, v := range triangulate.PolygonTriangles(geom.ToMatrix()) {
+		coll = append(coll, space.Polygon(v.(matrix.PolygonMatrix)))
+	}
+	return coll, nil
+}
+
+// VoronoiDiagram returns the Voronoi diagram of the vertices of geometry, a Collection of one Polygon per site,
+// vertices closer than tolerance are merged.
+// The diagram is clipped to the larger of the bound and the envelope of the sites expanded by its larger extent,
+// an empty bound clips to the latter.
+func (g *megrezAlgorithm) VoronoiDiagram(geom space.Geometry, tolerance float64, bound space.Bound) (space.Geometry, error) {
+	if tolerance < 0 {
+		return nil, algorithm.ErrWrongTolerance
+	}
+	if geom == nil || geom.IsEmpty() {
+		return space.Collection{}, nil
+	}
+	builder := triangulate.VoronoiWithGeom(geom.ToMatrix(), tolerance)
+	if !bound.IsEmpty() {
+		builder.SetClipEnvelope(envelope.FourFloat(bound.Min.X(), bound.Max.X(), bound.Min.Y(), bound.Max.Y()))
+	}
+	coll := space.Collection{}
+	for _, v := range builder.Diagram() {
+		coll = append(coll, space.Polygon(v.(matrix.PolygonMatrix)))
+	}
+	return coll, nil
+}
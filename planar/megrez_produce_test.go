@@ -1,11 +1,16 @@
 package planar
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm"
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 func TestAlgorithm_Boundary(t *testing.T) {
@@ -148,6 +153,96 @@ func TestAlgorithm_ConvexHull(t *testing.T) {
 	}
 }
 
+func TestMegrezAlgorithm_ConcaveHull(t *testing.T) {
+	points := space.MultiPoint{}
+	for i := 0; i <= 10; i++ {
+		for j := 0; j <= 10; j++ {
+			if i < 3 || j < 3 || j > 7 {
+				points = append(points, space.Point{float64(i), float64(j)})
+			}
+		}
+	}
+	tests := []struct {
+		name        string
+		geom        space.Geometry
+		lengthRatio float64
+		area        float64
+		wantErr     bool
+	}{
+		{name: "concave", geom: points, lengthRatio: 0, area: 52},
+		{name: "convex", geom: points, lengthRatio: 1, area: 100},
+		{name: "polygons", geom: space.MultiPolygon{
+			{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}, {{{6, 0}, {10, 0}, {10, 4}, {6, 4}, {6, 0}}},
+		}, lengthRatio: 0, area: 40},
+		{name: "wrong ratio", geom: points, lengthRatio: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.ConcaveHull(tt.geom, tt.lengthRatio, false)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MegrezAlgorithm.ConcaveHull() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if area, _ := g.Area(got); got.GeoJSONType() != space.TypePolygon || math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("MegrezAlgorithm.ConcaveHull() = %v, area %v, want area %v", got, area, tt.area)
+			}
+		})
+	}
+	g := &megrezAlgorithm{}
+	if _, err := g.ConcaveHullByLength(points, -1, false); err == nil {
+		t.Errorf("MegrezAlgorithm.ConcaveHullByLength() error = nil, want error of negative length")
+	}
+}
+
+func TestMegrezAlgorithm_MaximumInscribedCircle(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    space.Geometry
+		centre  space.Point
+		radius  float64
+		wantErr bool
+	}{
+		{name: "polygon", geom: space.Polygon{{{0, 0}, {20, 0}, {20, 4}, {0, 4}, {0, 0}}}, centre: space.Point{10, 2}, radius: 2},
+		{name: "multi polygon", geom: space.MultiPolygon{{{{0, 0}, {20, 0}, {20, 4}, {0, 4}, {0, 0}}},
+			{{{30, 0}, {40, 0}, {40, 10}, {30, 10}, {30, 0}}}}, centre: space.Point{35, 5}, radius: 5},
+		{name: "line", geom: space.LineString{{0, 0}, {1, 1}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.MaximumInscribedCircle(tt.geom, 0.001)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MegrezAlgorithm.MaximumInscribedCircle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Centre.EqualsExact(tt.centre, 0.01) || math.Abs(got.Radius-tt.radius) > 0.001 {
+				t.Errorf("MegrezAlgorithm.MaximumInscribedCircle() = %v %v, want %v %v", got.Centre, got.Radius, tt.centre, tt.radius)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_LargestEmptyCircle(t *testing.T) {
+	g := &megrezAlgorithm{}
+	obstacles := space.MultiPoint{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {5, 5}}
+	got, err := g.LargestEmptyCircle(obstacles, nil, 0.001)
+	if err != nil || math.Abs(got.Radius-5) > 0.001 {
+		t.Errorf("MegrezAlgorithm.LargestEmptyCircle() = %v, %v, want radius %v", got, err, 5)
+	}
+	got, err = g.LargestEmptyCircle(space.Point{5, 5}, space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, 0.001)
+	if err != nil || math.Abs(got.Radius-5*math.Sqrt2) > 0.001 {
+		t.Errorf("MegrezAlgorithm.LargestEmptyCircle() = %v, %v, want radius %v", got, err, 5*math.Sqrt2)
+	}
+	if _, err := g.LargestEmptyCircle(obstacles, nil, -1); err == nil {
+		t.Errorf("MegrezAlgorithm.LargestEmptyCircle() error = nil, want error of negative tolerance")
+	}
+}
+
 func TestAlgorithm_Envelope(t *testing.T) {
 	point, _ := wkt.UnmarshalString(`POINT(1 3)`)
 	expectPoint, _ := wkt.UnmarshalString(`POINT(1 3)`)
@@ -269,6 +364,9 @@ func TestAlgorithm_Simplify(t *testing.T) {
 func TestAlgorithm_SimplifyP(t *testing.T) {
 	lineString, _ := wkt.UnmarshalString(`LINESTRING(0 0, 1 1, 0 2, 1 3, 0 4, 1 5)`)
 	expectLine, _ := wkt.UnmarshalString(`LINESTRING (0 0, 1 5)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,5 2,10 0,10 10,0 10,0 0),(4 0.5,6 0.5,6 1,4 1,4 0.5))`)
+	polygonFlat, _ := wkt.UnmarshalString(`POLYGON((0 0,5 0.5,10 0,10 10,0 10,0 0))`)
+	expectPolygonFlat, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
 
 	type args struct {
 		g         space.Geometry
@@ -281,6 +379,8 @@ func TestAlgorithm_SimplifyP(t *testing.T) {
 		wantErr bool
 	}{
 		{name: "SimplifyP Point", args: args{g: lineString, tolerance: 1.0}, want: expectLine, wantErr: false},
+		{name: "SimplifyP hole kept inside", args: args{g: polygon, tolerance: 3.0}, want: polygon, wantErr: false},
+		{name: "SimplifyP shell", args: args{g: polygonFlat, tolerance: 1.0}, want: expectPolygonFlat, wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -299,6 +399,103 @@ func TestAlgorithm_SimplifyP(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_SimplifyVW(t *testing.T) {
+	lineString, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 0.1,2 0,3 5,4 0)`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 0,2 0,3 5,4 0)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,1 0.1,2 0,2 2,0 2,0 0))`)
+	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((0 0,2 2,0 2,0 0))`)
+	tests := []struct {
+		name      string
+		g         space.Geometry
+		tolerance float64
+		want      space.Geometry
+	}{
+		{name: "line", g: lineString, tolerance: 1, want: expectLine},
+		{name: "polygon kept as triangle", g: polygon, tolerance: 10, want: expectPolygon},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			gotGeometry, err := G.SimplifyVW(tt.g, tt.tolerance)
+			if err != nil {
+				t.Errorf("GEOAlgorithm.SimplifyVW() error = %v", err)
+				return
+			}
+			if isEqual, _ := G.EqualsExact(gotGeometry, tt.want, 0.000001); !isEqual {
+				t.Errorf("GEOAlgorithm.SimplifyVW() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_SimplifyCoverage(t *testing.T) {
+	coverage, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0,5 0.1,10 0,10.1 5,10 10,5 10.1,0 10,0 0)),
+		((10 0,20 0,20 10,10 10,10.1 5,10 0)))`)
+	expectCoverage, _ := wkt.UnmarshalString(`MULTIPOLYGON(((10 0,10 10,0 10,0 0,10 0)),((10 0,20 0,20 10,10 10,10 0)))`)
+	G := NormalStrategy()
+	got, err := G.SimplifyCoverage(coverage, 1)
+	if err != nil {
+		t.Fatalf("GEOAlgorithm.SimplifyCoverage() error = %v", err)
+	}
+	if isEqual, _ := G.EqualsExact(got, expectCoverage, 0.000001); !isEqual {
+		t.Errorf("GEOAlgorithm.SimplifyCoverage() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(expectCoverage))
+	}
+	if _, err := G.SimplifyCoverage(space.LineString{{0, 0}, {1, 1}}, 1); err != spaceerr.ErrNotSupportGeometry {
+		t.Errorf("GEOAlgorithm.SimplifyCoverage() error = %v, want %v", err, spaceerr.ErrNotSupportGeometry)
+	}
+}
+
+func TestAlgorithm_SmoothChaikin(t *testing.T) {
+	lineString, _ := wkt.UnmarshalString(`LINESTRING(0 0,4 0,4 4)`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 0,3 0,4 1,4 3,4 4)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,4 0,4 4,0 4,0 0))`)
+	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((1 0,3 0,4 1,4 3,3 4,1 4,0 3,0 1,1 0))`)
+	holeNearShell, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0),(0.5 0.5,3 0.5,0.5 3,0.5 0.5))`)
+	tests := []struct {
+		name       string
+		g          space.Geometry
+		iterations int
+		want       space.Geometry
+	}{
+		{name: "line", g: lineString, iterations: 1, want: expectLine},
+		{name: "polygon", g: polygon, iterations: 1, want: expectPolygon},
+		{name: "ring", g: space.Ring(polygon.(space.Polygon)[0]), iterations: 1,
+			want: space.Ring(expectPolygon.(space.Polygon)[0])},
+		{name: "polygon kept valid", g: holeNearShell, iterations: 2, want: holeNearShell},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			gotGeometry, err := G.SmoothChaikin(tt.g, tt.iterations)
+			if err != nil {
+				t.Errorf("GEOAlgorithm.SmoothChaikin() error = %v", err)
+				return
+			}
+			if !gotGeometry.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("GEOAlgorithm.SmoothChaikin() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestAlgorithm_SmoothBezier(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,4 0,4 4,0 4,0 0))`)
+	G := NormalStrategy()
+	got, err := G.SmoothBezier(polygon, 1)
+	if err != nil {
+		t.Fatalf("GEOAlgorithm.SmoothBezier() error = %v", err)
+	}
+	if !got.IsValid() || !got.IsClosed() || got.Nums() != 1 {
+		t.Errorf("GEOAlgorithm.SmoothBezier() = %v, want a valid polygon", wkt.MarshalString(got))
+	}
+	if area, _ := got.Area(); area <= 16 {
+		t.Errorf("GEOAlgorithm.SmoothBezier() area = %v, want more than 16", area)
+	}
+	if _, err := G.SmoothBezier(nil, 1); err != spaceerr.ErrNilGeometry {
+		t.Errorf("GEOAlgorithm.SmoothBezier() error = %v, want %v", err, spaceerr.ErrNilGeometry)
+	}
+}
+
 func TestAlgorithm_Snap(t *testing.T) {
 	type args struct {
 		input     space.Geometry
@@ -401,6 +598,77 @@ func TestMegrezAlgorithm_Buffer(t *testing.T) {
 	}
 }
 
+func TestMegrezAlgorithm_BufferWithParams(t *testing.T) {
+	flat := &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+		MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor}
+	bank := &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+		MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor, IsSingleSided: true}
+	type args struct {
+		geom   space.Geometry
+		width  float64
+		params *buffer.CurveParameters
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantGeometry space.Geometry
+	}{
+		{name: "road corridor", args: args{geom: space.LineString{{0, 0}, {10, 0}, {10, 10}}, width: 1, params: flat},
+			wantGeometry: space.Polygon{{{9, 1}, {9, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 1}, {9, 1}}}},
+		{name: "left bank", args: args{geom: space.LineString{{0, 0}, {10, 0}, {10, 10}}, width: 1, params: bank},
+			wantGeometry: space.Polygon{{{10, 10}, {10, 0}, {0, 0}, {0, 1}, {9, 1}, {9, 10}, {10, 10}}}},
+		{name: "right bank", args: args{geom: space.LineString{{0, 0}, {10, 0}, {10, 10}}, width: -1, params: bank},
+			wantGeometry: space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			if gotGeometry := g.BufferWithParams(tt.args.geom, tt.args.width, tt.args.params); gotGeometry == nil ||
+				!gotGeometry.EqualsExact(tt.wantGeometry, 0.0000001) {
+				t.Errorf("MegrezAlgorithm.BufferWithParams() = %v, \nwant %v", gotGeometry, tt.wantGeometry)
+			}
+			if gotGeometry := tt.args.geom.BufferWithParams(tt.args.width, tt.args.params); gotGeometry == nil ||
+				!gotGeometry.EqualsExact(tt.wantGeometry, 0.0000001) {
+				t.Errorf("Geometry.BufferWithParams() = %v, \nwant %v", gotGeometry, tt.wantGeometry)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_OffsetCurve(t *testing.T) {
+	mitre := &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CapFlat, JoinStyle: calc.JoinMitre,
+		MitreLimit: calc.MitreLimit, SimplifyFactor: calc.SimplifyFactor}
+	centreline := space.LineString{{0, 0}, {10, 0}, {10, 10}}
+	tests := []struct {
+		name     string
+		geom     space.Geometry
+		distance float64
+		want     space.Geometry
+		wantErr  error
+	}{
+		{name: "left lane", geom: centreline, distance: 1, want: space.LineString{{0, 1}, {9, 1}, {9, 10}}},
+		{name: "right lane", geom: centreline, distance: -1, want: space.LineString{{0, -1}, {11, -1}, {11, 10}}},
+		{name: "multi line", geom: space.MultiLineString{{{0, 0}, {10, 0}}, {{0, 5}, {10, 5}}}, distance: 1,
+			want: space.MultiLineString{{{0, 1}, {10, 1}}, {{0, 6}, {10, 6}}}},
+		{name: "collapsed", geom: space.LineString{{0, 0}, {10, 0}, {10, 1}, {0, 1}}, distance: 2, want: space.MultiLineString{}},
+		{name: "polygon", geom: space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 0}}}, distance: 1, wantErr: spaceerr.ErrNotSupportGeometry},
+		{name: "nil", geom: nil, distance: 1, wantErr: spaceerr.ErrNilGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.OffsetCurve(tt.geom, tt.distance, mitre)
+			if err != tt.wantErr {
+				t.Errorf("MegrezAlgorithm.OffsetCurve() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && !got.EqualsExact(tt.want, 0.0000001) {
+				t.Errorf("MegrezAlgorithm.OffsetCurve() = %v, \nwant %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMegrezAlgorithm_BufferInMeter(t *testing.T) {
 	wantGeometry, _ := wkt.UnmarshalString("POLYGON((110.00117265646337 40.00000000000001,110.00115012419823 39.99982474877957,110.00108339330515 39.999656231941024,110.00097502821495 39.99950092556494,110.00082919333724 39.999364798097254,110.00065149302459 39.99925308096896,110.00044875620038 39.99917006753527,110.00022877392705 39.99911894806501,110.00000000000001 39.99910168712361,109.99977122607295 39.99911894806501,109.99955124379962 39.99917006753527,109.9993485069754 39.99925308096896,109.99917080666276 39.999364798097254,109.99902497178505 39.99950092556494,109.99891660669483 39.999656231941024,109.99884987580177 39.99982474877957,109.99882734353663 40.00000000000001,109.99884987580177 40.00017525077067,109.99891660669483 40.00034376632828,109.99902497178505 40.00049907078736,109.99917080666276 40.000635195993794,109.9993485069754 40.00074691086084,109.99955124379962 40.00082992237753,109.99977122607295 40.00088104056688,110.00000000000001 40.00089830105848,110.00022877392705 40.00088104056688,110.00044875620038 40.00082992237753,110.00065149302459 40.00074691086084,110.00082919333724 40.000635195993794,110.00097502821495 40.00049907078736,110.00108339330515 40.00034376632828,110.00115012419823 40.00017525077067,110.00117265646337 40.00000000000001))")
 	wantGeometry2, _ := wkt.UnmarshalString("POLYGON((110.09906815774535 40.10054562342642,110.09922522259059 40.10067419589715,110.09941206169721 40.10077685932706,110.09962149494307 40.10084966854274,110.09984547392598 40.10088982562589,110.1000753912593 40.10089578742074,110.10030241134876 40.100867324827774,110.10051780993972 40.10080553160651,110.10071330938504 40.10071278234935,110.10088139675076 40.10059264124038,110.10101561253364 40.10044972510394,110.10111079889639 40.10028952600239,110.1011632978805 40.10011820019832,110.10117109197948 40.09994233158786,110.1011338816704 40.09976867869421,110.10105309692463 40.09960391494264,110.10093184225462 40.09945437219815,110.00093184225464 39.99945357112295,110.00077477740938 39.99932480757869,110.00058793830277 39.999221991229994,110.00037850505693 39.99914907337598,110.00015452607398 39.99910885630793,109.9999246087407 39.99910288560356,109.9996975886512 39.999131390722596,109.99948219006026 39.999193276187356,109.99928669061492 39.999286163687735,109.99911860324923 39.999406483491484,109.99898438746635 39.999549611644895,109.99888920110361 39.999710047688836,109.99883670211949 39.999881626057096,109.99882890802051 40.00005775303085,109.99886611832957 40.00023166014029,109.99894690307536 40.00039666427477,109.99906815774537 40.000546424504286,110.09906815774535 40.10054562342642)), want POLYGON((110.00117265646337 40.00000000000001,110.00115012419823 39.99982474877957,110.00108339330515 39.999656231941024,110.00097502821495 39.99950092556494,110.00082919333724 39.999364798097254,110.00065149302459 39.99925308096896,110.00044875620038 39.99917006753527,110.00022877392705 39.99911894806501,110.00000000000001 39.99910168712361,109.99977122607295 39.99911894806501,109.99955124379962 39.99917006753527,109.9993485069754 39.99925308096896,109.99917080666276 39.999364798097254,109.99902497178505 39.99950092556494,109.99891660669483 39.999656231941024,109.99884987580177 39.99982474877957,109.99882734353663 40.00000000000001,109.99884987580177 40.00017525077067,109.99891660669483 40.00034376632828,109.99902497178505 40.00049907078736,109.99917080666276 40.000635195993794,109.9993485069754 40.00074691086084,109.99955124379962 40.00082992237753,109.99977122607295 40.00088104056688,110.00000000000001 40.00089830105848,110.00022877392705 40.00088104056688,110.00044875620038 40.00082992237753,110.00065149302459 40.00074691086084,110.00082919333724 40.000635195993794,110.00097502821495 40.00049907078736,110.00108339330515 40.00034376632828,110.00115012419823 40.00017525077067,110.00117265646337 40.00000000000001))")
@@ -428,3 +696,278 @@ func TestMegrezAlgorithm_BufferInMeter(t *testing.T) {
 		})
 	}
 }
+
+func TestMegrezAlgorithm_Densify(t *testing.T) {
+	lineString, _ := wkt.UnmarshalString(`LINESTRING(0 0,3 0,3 1)`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 0,1 0,2 0,3 0,3 1)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,2 0,2 2,0 2,0 0))`)
+	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((0 0,1 0,2 0,2 1,2 2,1 2,0 2,0 1,0 0))`)
+	tests := []struct {
+		name             string
+		g                space.Geometry
+		maxSegmentLength float64
+		want             space.Geometry
+		wantErr          error
+	}{
+		{name: "line", g: lineString, maxSegmentLength: 1, want: expectLine},
+		{name: "polygon", g: polygon, maxSegmentLength: 1.5, want: expectPolygon},
+		{name: "ring", g: space.Ring(polygon.(space.Polygon)[0]), maxSegmentLength: 1,
+			want: space.Ring(expectPolygon.(space.Polygon)[0])},
+		{name: "bound", g: space.Bound{Min: space.Point{0, 0}, Max: space.Point{2, 2}}, maxSegmentLength: 1,
+			want: space.Polygon{{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}}}},
+		{name: "point", g: space.Point{1, 1}, maxSegmentLength: 1, want: space.Point{1, 1}},
+		{name: "not positive", g: lineString, maxSegmentLength: 0, wantErr: algorithm.ErrWrongSegmentLength},
+		{name: "nil", g: nil, maxSegmentLength: 1, wantErr: spaceerr.ErrNilGeometry},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			gotGeometry, err := G.Densify(tt.g, tt.maxSegmentLength)
+			if err != tt.wantErr {
+				t.Errorf("GEOAlgorithm.Densify() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr == nil && !gotGeometry.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("GEOAlgorithm.Densify() = %v, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_GeodesicDensify(t *testing.T) {
+	line := space.LineString{{-73.8, 40.6}, {-0.5, 51.6}, {2.5, 49}}
+	G := NormalStrategy()
+	got, err := G.GeodesicDensify(line, 500000)
+	if err != nil {
+		t.Fatalf("GEOAlgorithm.GeodesicDensify() error = %v", err)
+	}
+	if !got.Equals(space.DensifyGreatCircle(line, 500000)) {
+		t.Errorf("GEOAlgorithm.GeodesicDensify() = %v, want %v", got, space.DensifyGreatCircle(line, 500000))
+	}
+	polygon := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	got, _ = G.GeodesicDensify(space.Collection{polygon, space.Point{1, 1}}, 200000)
+	coll, ok := got.(space.Collection)
+	if !ok || len(coll) != 2 || len(coll[0].(space.Polygon)[0]) <= 5 || !coll[0].IsClosed() {
+		t.Errorf("GEOAlgorithm.GeodesicDensify() = %v, want a densified polygon and a point", got)
+	}
+	if _, err := G.GeodesicDensify(line, -1); err != algorithm.ErrWrongSegmentLength {
+		t.Errorf("GEOAlgorithm.GeodesicDensify() error = %v, want %v", err, algorithm.ErrWrongSegmentLength)
+	}
+}
+
+func TestMegrezAlgorithm_GeodesicBuffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		geom     space.Geometry
+		width    float64
+		geoType  string
+		area     float64
+		isPolar  bool
+		quadsegs int
+	}{
+		{name: "point", geom: space.Point{110, 40}, width: 100000, geoType: space.TypePolygon,
+			area: 16 * 100000 * 100000 * math.Sin(math.Pi/16), quadsegs: 8},
+		{name: "point across antimeridian", geom: space.Point{179.5, 10}, width: 100000, geoType: space.TypeMultiPolygon,
+			area: 16 * 100000 * 100000 * math.Sin(math.Pi/16), quadsegs: 8},
+		{name: "point around pole", geom: space.Point{30, 89.5}, width: 100000, geoType: space.TypePolygon,
+			area: 16 * 100000 * 100000 * math.Sin(math.Pi/16), isPolar: true, quadsegs: 8},
+		{name: "line", geom: space.LineString{{110, 40}, {111, 40}}, width: 1000, geoType: space.TypePolygon,
+			area: 2*1000*space.GeodesicLength(space.LineString{{110, 40}, {111, 40}}) + 1000*1000*math.Pi, quadsegs: 32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got := g.GeodesicBuffer(tt.geom, tt.width, tt.quadsegs)
+			if got == nil || got.GeoJSONType() != tt.geoType {
+				t.Fatalf("MegrezAlgorithm.GeodesicBuffer() = %v, want %v", got, tt.geoType)
+			}
+			area := space.GeodesicArea(got)
+			if math.Abs(area-tt.area) > tt.area*1e-3 {
+				t.Errorf("MegrezAlgorithm.GeodesicBuffer() area = %v, want %v", area, tt.area)
+			}
+			bound := got.Bound()
+			if bound.Min.X() < -180 || bound.Max.X() > 180 || (bound.Max.Y() == 90) != tt.isPolar {
+				t.Errorf("MegrezAlgorithm.GeodesicBuffer() bound = %v", bound)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_GeodesicBuffer_Polygon(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    space.Polygon
+		geoType string
+	}{
+		{"polygon around pole", space.Polygon{{{0, 80}, {90, 80}, {170, 80}, {-90, 80}, {0, 80}}}, space.TypePolygon},
+		{"polygon closed along pole",
+			space.Polygon{{{-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80}, {180, 90}, {-180, 90}, {-180, 80}}},
+			space.TypePolygon},
+		{"polygon closed along south pole",
+			space.Polygon{{{-180, -80}, {-90, -75}, {0, -70}, {90, -75}, {180, -80}, {180, -90}, {-180, -90}, {-180, -80}}},
+			space.TypePolygon},
+		{"polygon across antimeridian", space.Polygon{{{170, 0}, {-170, 0}, {-170, 10}, {170, 10}, {170, 0}}},
+			space.TypeMultiPolygon},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got := g.GeodesicBuffer(tt.geom, 100000, 8)
+			if got == nil || got.GeoJSONType() != tt.geoType || !got.IsValid() {
+				t.Fatalf("MegrezAlgorithm.GeodesicBuffer() = %v, want a valid %v", got, tt.geoType)
+			}
+			if area := space.GeodesicArea(got); area <= space.GeodesicArea(tt.geom) {
+				t.Errorf("MegrezAlgorithm.GeodesicBuffer() area = %v, want it grows", area)
+			}
+			if bound := got.Bound(); bound.Min.X() < -180 || bound.Max.X() > 180 {
+				t.Errorf("MegrezAlgorithm.GeodesicBuffer() bound = %v", bound)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_DelaunayTriangulation(t *testing.T) {
+	sites := space.MultiPoint{{0, 0}, {2, 0}, {1, 1}, {1, 3}}
+	tests := []struct {
+		name      string
+		geom      space.Geometry
+		tolerance float64
+		onlyEdges bool
+		geoType   string
+		num       int
+		wantErr   bool
+	}{
+		{name: "triangles", geom: sites, geoType: space.TypeCollection, num: 3},
+		{name: "edges", geom: sites, onlyEdges: true, geoType: space.TypeMultiLineString, num: 6},
+		{name: "polygon vertices", geom: space.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, geoType: space.TypeCollection, num: 2},
+		{name: "negative tolerance", geom: sites, tolerance: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.DelaunayTriangulation(tt.geom, tt.tolerance, tt.onlyEdges)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MegrezAlgorithm.DelaunayTriangulation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GeoJSONType() != tt.geoType || got.Nums() != tt.num {
+				t.Errorf("MegrezAlgorithm.DelaunayTriangulation() = %v, want %v of %v", got, tt.geoType, tt.num)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_ConstrainedDelaunayTriangulation(t *testing.T) {
+	polygon := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}
+	constraint := space.Collection{space.LineString{{0, 0}, {10, 0}}, space.Point{5, 1}, space.Point{5, -1}}
+	tests := []struct {
+		name       string
+		geom       space.Geometry
+		tolerance  float64
+		conforming bool
+		num        int
+		area       float64
+		wantErr    bool
+	}{
+		{name: "polygon with hole", geom: polygon, num: 8, area: 96},
+		{name: "polygon with hole conforming", geom: polygon, conforming: true, num: 8, area: 96},
+		{name: "constraint", geom: constraint, num: 2, area: 10},
+		{name: "constraint conforming", geom: constraint, conforming: true, num: 4, area: 10},
+		{name: "negative tolerance", geom: polygon, tolerance: -1, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.ConstrainedDelaunayTriangulation(tt.geom, tt.tolerance, tt.conforming)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MegrezAlgorithm.ConstrainedDelaunayTriangulation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Nums() != tt.num {
+				t.Errorf("MegrezAlgorithm.ConstrainedDelaunayTriangulation() = %v, want %v triangles", got, tt.num)
+			}
+			area := 0.0
+			for _, v := range got.(space.Collection) {
+				triangleArea, _ := g.Area(v)
+				area += triangleArea
+			}
+			if math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("MegrezAlgorithm.ConstrainedDelaunayTriangulation() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_TriangulatePolygon(t *testing.T) {
+	polygon := space.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}
+	tests := []struct {
+		name    string
+		geom    space.Geometry
+		num     int
+		area    float64
+		wantErr bool
+	}{
+		{name: "polygon with hole", geom: polygon, num: 8, area: 96},
+		{name: "multi polygon", geom: space.MultiPolygon{polygon, {{{20, 0}, {25, 0}, {25, 5}, {20, 5}, {20, 0}}}}, num: 10, area: 121},
+		{name: "line", geom: space.LineString{{0, 0}, {1, 1}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.TriangulatePolygon(tt.geom)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MegrezAlgorithm.TriangulatePolygon() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Nums() != tt.num {
+				t.Errorf("MegrezAlgorithm.TriangulatePolygon() = %v, want %v triangles", got, tt.num)
+			}
+			area := 0.0
+			for _, v := range got.(space.Collection) {
+				triangleArea, _ := g.Area(v)
+				area += triangleArea
+			}
+			if math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("MegrezAlgorithm.TriangulatePolygon() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestMegrezAlgorithm_VoronoiDiagram(t *testing.T) {
+	sites := space.MultiPoint{{0, 0}, {2, 0}, {1, 1}, {1, 3}}
+	tests := []struct {
+		name  string
+		bound space.Bound
+		area  float64
+	}{
+		{name: "default bound", area: 72},
+		{name: "bound", bound: space.Bound{Min: space.Point{-10, -10}, Max: space.Point{10, 10}}, area: 400},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &megrezAlgorithm{}
+			got, err := g.VoronoiDiagram(sites, 0, tt.bound)
+			if err != nil || got.Nums() != len(sites) {
+				t.Fatalf("MegrezAlgorithm.VoronoiDiagram() = %v, %v, want %v cells", got, err, len(sites))
+			}
+			area := 0.0
+			for i, v := range got.(space.Collection) {
+				cellArea, _ := g.Area(v)
+				area += cellArea
+				if ok, _ := g.Contains(v, sites[i]); !ok {
+					t.Errorf("MegrezAlgorithm.VoronoiDiagram() cell %v not contains %v", v, sites[i])
+				}
+			}
+			if math.Abs(area-tt.area) > 1e-6 {
+				t.Errorf("MegrezAlgorithm.VoronoiDiagram() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
@@ -78,6 +78,10 @@ func TestAlgorithm_Contains(t *testing.T) {
 			g1: poly,
 			g2: p2,
 		}, want: false, wantErr: false},
+		{name: "contain not rectangle", args: args{
+			g1: space.Polygon{{{0, 0}, {10, 1}, {11, 10}, {-1, 9}, {0, 0}}},
+			g2: space.Point{5, 5},
+		}, want: true, wantErr: false},
 	}
 	for i, v := range polyTestscase {
 		want := true
@@ -1,8 +1,11 @@
 package planar
 
 import (
+	"reflect"
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
 )
@@ -140,6 +143,93 @@ func TestAlgorithm_IsSimple(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_ValidateDetail(t *testing.T) {
+	bowTie, _ := wkt.UnmarshalString(`POLYGON((0 0,10 10,10 0,0 10,0 0))`)
+	holeOutside, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0),(20 0,30 0,30 10,20 10,20 0))`)
+	nestedShells, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0,10 0,10 10,0 10,0 0)),((2 2,8 2,8 8,2 2)))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(1 1,1 1)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0),(2 2,2 8,8 8,8 2,2 2))`)
+
+	type args struct {
+		g space.Geometry
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *operation.ValidationError
+		wantErr bool
+	}{
+		{name: "bow-tie", args: args{g: bowTie},
+			want: &operation.ValidationError{Type: operation.ErrorSelfIntersection, Coordinate: matrix.Matrix{5, 5}}},
+		{name: "hole outside shell", args: args{g: holeOutside},
+			want: &operation.ValidationError{Type: operation.ErrorHoleOutsideShell, Coordinate: matrix.Matrix{20, 0}}},
+		{name: "nested shells", args: args{g: nestedShells},
+			want: &operation.ValidationError{Type: operation.ErrorNestedShells, Coordinate: matrix.Matrix{2, 2}}},
+		{name: "too few points", args: args{g: line},
+			want: &operation.ValidationError{Type: operation.ErrorTooFewPoints, Coordinate: matrix.Matrix{1, 1}}},
+		{name: "unclosed ring", args: args{g: space.Ring{{0, 0}, {10, 0}, {10, 10}}},
+			want: &operation.ValidationError{Type: operation.ErrorRingNotClosed, Coordinate: matrix.Matrix{0, 0}}},
+		{name: "valid", args: args{g: polygon}, want: nil},
+		{name: "nil", args: args{g: nil}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.ValidateDetail(tt.args.g)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDetail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateDetail() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MakeValid(t *testing.T) {
+	bowTie, _ := wkt.UnmarshalString(`POLYGON((0 0,10 10,10 0,0 10,0 0))`)
+	expectBowTie, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 10,0 0,5 5,0 10)),((10 0,10 10,5 5,10 0)))`)
+	holeOutside, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0),(20 0,30 0,30 10,20 10,20 0))`)
+	expectHoleOutside, _ := wkt.UnmarshalString(`MULTIPOLYGON(((10 10,0 10,0 0,10 0,10 10)),((30 10,20 10,20 0,30 0,30 10)))`)
+	collapsed, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 0,0 0))`)
+	expectCollapsed, _ := wkt.UnmarshalString(`LINESTRING(10 0,0 0)`)
+
+	type args struct {
+		g space.Geometry
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "bow-tie", args: args{g: bowTie}, want: expectBowTie, wantErr: false},
+		{name: "hole outside shell", args: args{g: holeOutside}, want: expectHoleOutside, wantErr: false},
+		{name: "collapsed", args: args{g: collapsed}, want: expectCollapsed, wantErr: false},
+		{name: "nil", args: args{g: nil}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.MakeValid(tt.args.g)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MakeValid() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.Equals(tt.want) {
+				t.Errorf("MakeValid() got = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+			if !got.IsValid() {
+				t.Errorf("MakeValid() got = %v is not valid", wkt.MarshalString(got))
+			}
+		})
+	}
+}
+
 func TestAlgorithm_IsRing(t *testing.T) {
 	const linestring1 = `LINESTRING(1 2, 3 4, 5 6, 5 3, 1 2)`
 	const linestring2 = `LINESTRING(1 1,2 2,2 3.5,1 3,1 2,2 1)`
@@ -7,6 +7,9 @@ import (
 var algorithmMegrez Algorithm
 var once sync.Once
 
+var algorithmGeography Algorithm
+var onceGeography sync.Once
+
 type newAlgorithm func() Algorithm
 
 // NormalStrategy returns normal algorithm.
@@ -14,6 +17,11 @@ func NormalStrategy() Algorithm {
 	return GetStrategy(NewMegrezAlgorithm)
 }
 
+// GeographyStrategy returns algorithm on the sphere, coordinates of geometries are lng lat.
+func GeographyStrategy() Algorithm {
+	return GetStrategy(NewGeographyAlgorithm)
+}
+
 // GetStrategy returns  algorithm by new Algorithm.
 func GetStrategy(f newAlgorithm) Algorithm {
 	return f()
@@ -26,3 +34,11 @@ func NewMegrezAlgorithm() Algorithm {
 	})
 	return algorithmMegrez
 }
+
+// NewGeographyAlgorithm returns Algorithm that is GeographyAlgorithm.
+func NewGeographyAlgorithm() Algorithm {
+	onceGeography.Do(func() {
+		algorithmGeography = &geographyAlgorithm{}
+	})
+	return algorithmGeography
+}
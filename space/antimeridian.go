@@ -0,0 +1,415 @@
+package space
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// SplitAntimeridian cuts the geometry of lng lat at the antimeridian as RFC 7946 section 3.1.9,
+// so that no part of the result crosses it and all longitudes are in [-180, 180].
+// An edge crosses the antimeridian if its longitudes differ by more than 180 degrees,
+// edges are the shorter way around, as the edges of GeoJSON geometries.
+// A LineString is cut into a MultiLineString and a Polygon into a MultiPolygon,
+// geometries not crossing the antimeridian are returned with normalized longitudes.
+// A ring enclosing a pole is closed along the pole on the side of its mean latitude.
+func SplitAntimeridian(geom Geometry) Geometry {
+	if geom == nil || geom.IsEmpty() {
+		return geom
+	}
+	switch g := geom.(type) {
+	case Point:
+		return Point{normalizeLng(g[0]), g[1]}
+	case MultiPoint:
+		multi := make(MultiPoint, 0, len(g))
+		for _, v := range g {
+			multi = append(multi, Point{normalizeLng(v[0]), v[1]})
+		}
+		return multi
+	case LineString:
+		return lineOfParts(splitLine(matrix.LineMatrix(g)))
+	case MultiLineString:
+		multi := MultiLineString{}
+		for _, v := range g {
+			for _, part := range splitLine(matrix.LineMatrix(v)) {
+				multi = append(multi, LineString(part))
+			}
+		}
+		return multi
+	case Ring:
+		return polygonOfParts(splitPolygon(matrix.PolygonMatrix{matrix.LineMatrix(g)}))
+	case Polygon:
+		return polygonOfParts(splitPolygon(g.ToMatrix().(matrix.PolygonMatrix)))
+	case Bound:
+		return NewGeoBound(g).ToGeometry()
+	case MultiPolygon:
+		multi := MultiPolygon{}
+		for _, v := range g {
+			for _, part := range splitPolygon(v.ToMatrix().(matrix.PolygonMatrix)) {
+				multi = append(multi, Polygon(part))
+			}
+		}
+		return multi
+	case Collection:
+		coll := make(Collection, 0, len(g))
+		for _, v := range g {
+			coll = append(coll, SplitAntimeridian(v))
+		}
+		return coll
+	}
+	return geom
+}
+
+// lineOfParts returns a LineString of one part, otherwise a MultiLineString.
+func lineOfParts(parts []matrix.LineMatrix) Geometry {
+	if len(parts) == 1 {
+		return LineString(parts[0])
+	}
+	multi := make(MultiLineString, 0, len(parts))
+	for _, v := range parts {
+		multi = append(multi, LineString(v))
+	}
+	return multi
+}
+
+// polygonOfParts returns a Polygon of one part, otherwise a MultiPolygon.
+func polygonOfParts(parts []matrix.PolygonMatrix) Geometry {
+	if len(parts) == 1 {
+		return Polygon(parts[0])
+	}
+	multi := make(MultiPolygon, 0, len(parts))
+	for _, v := range parts {
+		multi = append(multi, Polygon(v))
+	}
+	return multi
+}
+
+// unwrapLine returns the line with longitudes made continuous,
+// every edge differs less than or equal 180 degrees, starting in [-180, 180].
+// An edge along a pole keeps its change of longitude, as the edges closing RFC 7946 rings along a pole,
+// an edge along the antimeridian does not change of longitude.
+func unwrapLine(line matrix.LineMatrix) matrix.LineMatrix {
+	unwrapped := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		p := append(matrix.Matrix{}, v...)
+		if i == 0 {
+			p[0] = normalizeLng(p[0])
+		} else if prev := line[i-1]; math.Abs(prev[1]) == 90 && prev[1] == v[1] {
+			p[0] = unwrapped[i-1][0] + v[0] - prev[0]
+		} else {
+			p[0] = unwrapped[i-1][0] + math.Remainder(p[0]-unwrapped[i-1][0], 360)
+		}
+		unwrapped[i] = p
+	}
+	return unwrapped
+}
+
+// cutLines returns the longitudes of antimeridian lines, 180 + 360k, crossing the lng range (min, max).
+func cutLines(min, max float64) []float64 {
+	lines := []float64{}
+	for c := 180 + 360*math.Floor((min-180)/360); c < max; c += 360 {
+		if c > min {
+			lines = append(lines, c)
+		}
+	}
+	return lines
+}
+
+// antimeridianWindow returns the center longitude of the window between antimeridian lines containing lng,
+// a longitude on a line belongs to the window on its west.
+func antimeridianWindow(lng float64) float64 {
+	return 360 * math.Ceil((lng-180)/360)
+}
+
+// shiftLng returns the steric shifted so that its longitudes are in [-180, 180],
+// lng is a longitude inside of the part, not on an antimeridian line.
+func shiftLng(line matrix.LineMatrix, lng float64) matrix.LineMatrix {
+	offset := lng - normalizeLng(lng)
+	if offset == 0 {
+		return line
+	}
+	for _, v := range line {
+		v[0] -= offset
+	}
+	return line
+}
+
+// crossPoint returns the point where the edge ab crosses the line of longitude c.
+func crossPoint(a, b matrix.Matrix, c float64) matrix.Matrix {
+	t := (c - a[0]) / (b[0] - a[0])
+	p := append(matrix.Matrix{}, a...)
+	p[0] = c
+	for i := 1; i < len(a) && i < len(b); i++ {
+		p[i] = a[i] + t*(b[i]-a[i])
+	}
+	return p
+}
+
+// splitLine cuts the line at the antimeridian lines.
+func splitLine(line matrix.LineMatrix) []matrix.LineMatrix {
+	if len(line) == 0 {
+		return []matrix.LineMatrix{line}
+	}
+	unwrapped := unwrapLine(line)
+	parts := []matrix.LineMatrix{}
+	part := matrix.LineMatrix{unwrapped[0]}
+	window := antimeridianWindow(unwrapped[0][0])
+	for i := 1; i < len(unwrapped); i++ {
+		a, b := unwrapped[i-1], unwrapped[i]
+		for next := antimeridianWindow(b[0]); window != next; {
+			step := 360.0
+			if next < window {
+				step = -360
+			}
+			p := crossPoint(a, b, window+step/2)
+			rest := matrix.LineMatrix{append(matrix.Matrix{}, p...)}
+			if part = appendPoint(part, p); len(part) > 1 {
+				parts = append(parts, shiftLng(part, window))
+			}
+			part = rest
+			window += step
+		}
+		if len(part) > 1 || !matrix.Matrix(part[0]).Equals(matrix.Matrix(b)) {
+			part = append(part, b)
+		}
+	}
+	if len(part) > 1 || len(parts) == 0 {
+		parts = append(parts, shiftLng(part, window))
+	}
+	return parts
+}
+
+// splitPolygon cuts the polygon at the antimeridian lines into polygons.
+func splitPolygon(polygon matrix.PolygonMatrix) []matrix.PolygonMatrix {
+	rings := make([]matrix.LineMatrix, 0, len(polygon))
+	min, max := math.Inf(1), math.Inf(-1)
+	for i, v := range polygon {
+		if len(v) < 2 {
+			continue
+		}
+		ring := closePole(unwrapLine(v))
+		// exterior rings counter-clockwise and holes clockwise.
+		if (measure.Orientation{}).IsCCW(ring) != (i == 0) {
+			ring = reverseLine(ring)
+		}
+		rings = append(rings, ring)
+		for _, p := range ring {
+			min, max = math.Min(min, p[0]), math.Max(max, p[0])
+		}
+	}
+	if len(rings) == 0 {
+		return []matrix.PolygonMatrix{polygon}
+	}
+	parts := []matrix.PolygonMatrix{}
+	for _, c := range cutLines(min, max) {
+		west, east := cutRings(rings, c)
+		parts = append(parts, assembleRings(west, c-180)...)
+		rings = east
+	}
+	return append(parts, assembleRings(rings, antimeridianWindow(max))...)
+}
+
+// closePole closes the unwrapped ring enclosing a pole, whose longitudes change by 360 degrees,
+// along the pole on the side of its mean latitude.
+// The ring is rotated to start on the antimeridian, so that its longitudes are in [-180, 180] and it is not cut.
+func closePole(ring matrix.LineMatrix) matrix.LineMatrix {
+	n := len(ring) - 1
+	net := ring[n][0] - ring[0][0]
+	if math.Abs(net) < 180 {
+		return ring
+	}
+	for i := 0; i < n; i++ {
+		a, b := ring[i], ring[i+1]
+		window := antimeridianWindow(a[0])
+		if window == antimeridianWindow(b[0]) {
+			continue
+		}
+		c := window + 180
+		if b[0] < a[0] {
+			c = window - 180
+		}
+		rotated := matrix.LineMatrix{crossPoint(a, b, c)}
+		for _, v := range ring[i+1 : n] {
+			rotated = appendPoint(rotated, v)
+		}
+		for _, v := range ring[:i+1] {
+			p := append(matrix.Matrix{}, v...)
+			p[0] += net
+			rotated = appendPoint(rotated, p)
+		}
+		end := append(matrix.Matrix{}, rotated[0]...)
+		end[0] += net
+		ring = appendPoint(rotated, end)
+		break
+	}
+	// start on -180 going east or on 180 going west.
+	offset := ring[0][0] + 180
+	if net < 0 {
+		offset = ring[0][0] - 180
+	}
+	lat := 0.0
+	for _, v := range ring {
+		v[0] -= offset
+		lat += v[1]
+	}
+	pole := 90.0
+	if lat < 0 {
+		pole = -90
+	}
+	first, last := ring[0], ring[len(ring)-1]
+	ring = appendPoint(ring, matrix.Matrix{last[0], pole})
+	ring = appendPoint(ring, matrix.Matrix{first[0], pole})
+	return appendPoint(ring, append(matrix.Matrix{}, first...))
+}
+
+func reverseLine(line matrix.LineMatrix) matrix.LineMatrix {
+	reversed := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		reversed[len(line)-1-i] = v
+	}
+	return reversed
+}
+
+// cutRings cuts the oriented rings by the line of longitude c,
+// it returns the closed rings on the west and on the east of the line.
+// A ring crossing the line is cut into chains between crossing points,
+// the chains on each side are stitched along the line keeping the orientation of rings,
+// north on the west side and south on the east side.
+func cutRings(rings []matrix.LineMatrix, c float64) (west, east []matrix.LineMatrix) {
+	westChains, eastChains := []matrix.LineMatrix{}, []matrix.LineMatrix{}
+	for _, ring := range rings {
+		isWest := func(p matrix.Matrix) bool { return p[0] < c }
+		start := -1
+		for i := 0; i < len(ring)-1; i++ {
+			if isWest(ring[i]) != isWest(ring[i+1]) {
+				start = i + 1
+				break
+			}
+		}
+		if start < 0 {
+			if isWest(ring[0]) {
+				west = append(west, ring)
+			} else {
+				east = append(east, ring)
+			}
+			continue
+		}
+		// walk the ring from the first vertex after a crossing, the chain starts on the line.
+		n := len(ring) - 1
+		chain := matrix.LineMatrix{crossPoint(ring[start-1], ring[start], c)}
+		for k := 0; k < n; k++ {
+			a, b := ring[(start+k)%n], ring[(start+k+1)%n]
+			chain = appendPoint(chain, a)
+			if isWest(a) != isWest(b) {
+				p := crossPoint(a, b, c)
+				chain = appendPoint(chain, p)
+				if isWest(a) {
+					westChains = append(westChains, chain)
+				} else {
+					eastChains = append(eastChains, chain)
+				}
+				chain = matrix.LineMatrix{append(matrix.Matrix{}, p...)}
+			}
+		}
+	}
+	west = append(west, stitchChains(offLine(westChains, c), true)...)
+	east = append(east, stitchChains(offLine(eastChains, c), false)...)
+	return west, east
+}
+
+// offLine returns the chains leaving the line of longitude c,
+// chains only touching the line have no area on the side.
+func offLine(chains []matrix.LineMatrix, c float64) []matrix.LineMatrix {
+	valid := chains[:0]
+	for _, chain := range chains {
+		for _, v := range chain {
+			if v[0] != c {
+				valid = append(valid, chain)
+				break
+			}
+		}
+	}
+	return valid
+}
+
+// appendPoint appends the point if it differs from the last point.
+func appendPoint(line matrix.LineMatrix, p matrix.Matrix) matrix.LineMatrix {
+	if len(line) > 0 && matrix.Matrix(line[len(line)-1]).Equals(p) {
+		return line
+	}
+	return append(line, p)
+}
+
+// stitchChains closes the chains starting and ending on the cut line into rings,
+// the end of a chain is joined to the nearest start northward if north, otherwise southward.
+func stitchChains(chains []matrix.LineMatrix, north bool) []matrix.LineMatrix {
+	sort.Slice(chains, func(i, j int) bool { return chains[i][0][1] < chains[j][0][1] })
+	used := make([]bool, len(chains))
+	rings := []matrix.LineMatrix{}
+	for i := range chains {
+		if used[i] {
+			continue
+		}
+		used[i] = true
+		ring := append(matrix.LineMatrix{}, chains[i]...)
+		for {
+			end := ring[len(ring)-1][1]
+			next, best := -1, math.Inf(1)
+			for j, v := range chains {
+				if used[j] && j != i {
+					continue
+				}
+				d := v[0][1] - end
+				if !north {
+					d = -d
+				}
+				if d >= 0 && d < best {
+					next, best = j, d
+				}
+			}
+			if next < 0 || next == i {
+				break
+			}
+			used[next] = true
+			for _, v := range chains[next] {
+				ring = appendPoint(ring, v)
+			}
+		}
+		ring = appendPoint(ring, append(matrix.Matrix{}, ring[0]...))
+		if len(ring) >= 4 {
+			rings = append(rings, ring)
+		}
+	}
+	return rings
+}
+
+// assembleRings returns the polygons of the rings in the window centered on lng,
+// counter-clockwise rings are shells and clockwise rings are holes of the shell containing them.
+func assembleRings(rings []matrix.LineMatrix, lng float64) []matrix.PolygonMatrix {
+	polygons := []matrix.PolygonMatrix{}
+	holes := []matrix.LineMatrix{}
+	for _, v := range rings {
+		if (measure.Orientation{}).IsCCW(v) {
+			polygons = append(polygons, matrix.PolygonMatrix{v})
+		} else {
+			holes = append(holes, v)
+		}
+	}
+	for _, hole := range holes {
+		for i, polygon := range polygons {
+			if relate.InPolygon(hole[0], polygon[0]) || i == len(polygons)-1 {
+				polygons[i] = append(polygon, hole)
+				break
+			}
+		}
+	}
+	for _, polygon := range polygons {
+		for _, ring := range polygon {
+			shiftLng(ring, lng)
+		}
+	}
+	return polygons
+}
@@ -0,0 +1,96 @@
+package space
+
+import (
+	"testing"
+)
+
+func TestSplitAntimeridian(t *testing.T) {
+	tests := []struct {
+		name string
+		geom Geometry
+		want Geometry
+	}{
+		{"line not crossing", LineString{{10, 0}, {20, 10}}, LineString{{10, 0}, {20, 10}}},
+		{"line crossing", LineString{{170, 0}, {-170, 10}},
+			MultiLineString{{{170, 0}, {180, 5}}, {{-180, 5}, {-170, 10}}}},
+		{"line crossing west", LineString{{-170, 10}, {170, 0}},
+			MultiLineString{{{-170, 10}, {-180, 5}}, {{180, 5}, {170, 0}}}},
+		{"line crossing twice", LineString{{170, 0}, {190, 10}, {170, 20}},
+			MultiLineString{{{170, 0}, {180, 5}}, {{-180, 5}, {-170, 10}, {-180, 15}}, {{180, 15}, {170, 20}}}},
+		{"line on antimeridian", LineString{{170, 0}, {180, 5}, {-170, 10}},
+			MultiLineString{{{170, 0}, {180, 5}}, {{-180, 5}, {-170, 10}}}},
+		{"polygon crossing", Polygon{{{170, 0}, {-170, 0}, {-170, 10}, {170, 10}, {170, 0}}},
+			MultiPolygon{
+				{{{180, 10}, {170, 10}, {170, 0}, {180, 0}, {180, 10}}},
+				{{{-180, 0}, {-170, 0}, {-170, 10}, {-180, 10}, {-180, 0}}},
+			}},
+		{"polygon not crossing", Polygon{{{190, 0}, {200, 0}, {200, 10}, {190, 10}, {190, 0}}},
+			Polygon{{{-170, 0}, {-160, 0}, {-160, 10}, {-170, 10}, {-170, 0}}}},
+		{"polygon closed along south pole",
+			Polygon{{{-180, -80}, {-90, -75}, {0, -70}, {90, -75}, {180, -80}, {180, -90}, {-180, -90}, {-180, -80}}},
+			Polygon{{{-180, -80}, {-180, -90}, {180, -90}, {180, -80}, {90, -75}, {0, -70}, {-90, -75}, {-180, -80}}}},
+		{"polygon closed along north pole",
+			Polygon{{{-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80}, {180, 90}, {-180, 90}, {-180, 80}}},
+			Polygon{{{-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80}, {180, 90}, {-180, 90}, {-180, 80}}}},
+		{"pole ring with vertex on antimeridian", Polygon{{{0, 80}, {90, 80}, {180, 80}, {-90, 80}, {0, 80}}},
+			Polygon{{{-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80}, {180, 90}, {-180, 90}, {-180, 80}}}},
+		{"pole ring with vertex on antimeridian west", Polygon{{{0, 80}, {-90, 80}, {180, 80}, {90, 80}, {0, 80}}},
+			Polygon{{{180, 80}, {180, 90}, {-180, 90}, {-180, 80}, {-90, 80}, {0, 80}, {90, 80}, {180, 80}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitAntimeridian(tt.geom); !got.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("SplitAntimeridian() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitAntimeridian_Polygon(t *testing.T) {
+	tests := []struct {
+		name  string
+		geom  Polygon
+		parts int
+		holes int
+		area  float64
+	}{
+		{"hole crossing", Polygon{
+			{{160, -20}, {-160, -20}, {-160, 20}, {160, 20}, {160, -20}},
+			{{170, -10}, {170, 10}, {-170, 10}, {-170, -10}, {170, -10}},
+		}, 2, 0, 1600 - 400},
+		{"hole not crossing", Polygon{
+			{{160, -20}, {-160, -20}, {-160, 20}, {160, 20}, {160, -20}},
+			{{165, -10}, {165, 10}, {175, 10}, {175, -10}, {165, -10}},
+		}, 2, 1, 1600 - 200},
+		{"c shape", Polygon{
+			{{170, 0}, {-170, 0}, {-170, 5}, {175, 5}, {175, 10}, {-170, 10}, {-170, 15}, {170, 15}, {170, 0}},
+		}, 3, 0, 300 - 75},
+		{"enclosing south pole", Polygon{
+			{{-180, -80}, {-90, -80}, {0, -80}, {90, -80}, {180, -80}},
+		}, 1, 0, 360 * 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitAntimeridian(tt.geom)
+			parts, holes := 1, 0
+			if multi, ok := got.(MultiPolygon); ok {
+				parts = len(multi)
+				for _, v := range multi {
+					holes += len(v) - 1
+				}
+			} else {
+				holes = len(got.(Polygon)) - 1
+			}
+			if parts != tt.parts || holes != tt.holes {
+				t.Errorf("SplitAntimeridian() = %v, want %v parts and %v holes", got, tt.parts, tt.holes)
+			}
+			bound := got.Bound()
+			if bound.Min.X() < -180 || bound.Max.X() > 180 {
+				t.Errorf("SplitAntimeridian() = %v out of range", got)
+			}
+			if area, _ := got.Area(); area < tt.area-1e-6 || area > tt.area+1e-6 {
+				t.Errorf("SplitAntimeridian() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
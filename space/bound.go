@@ -4,6 +4,7 @@ import (
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 	"math"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/space/spaceerr"
 )
@@ -255,12 +256,37 @@ func (b Bound) SimplifyP(tolerance float64) Geometry {
 	return b.ToPolygon()
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (b Bound) SmoothChaikin(iterations int) Geometry {
+	return b.ToPolygon().SmoothChaikin(iterations)
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (b Bound) SmoothBezier(alpha float64) Geometry {
+	return b.ToPolygon().SmoothBezier(alpha)
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (b Bound) Densify(maxSegmentLength float64) Geometry {
+	return b.ToPolygon().Densify(maxSegmentLength)
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (b Bound) Buffer(width float64, quadsegs int) Geometry {
 	return b.ToPolygon().Buffer(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (b Bound) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	return b.ToPolygon().BufferWithParams(width, params)
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (b Bound) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -293,6 +319,23 @@ func (b Bound) ConvexHull() Geometry {
 	return b.ToPolygon().ConvexHull()
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (b Bound) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(b.ToPolygon())
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (b Bound) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(b.ToPolygon())
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (b Bound) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(b.ToPolygon())
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (b Bound) PointOnSurface() Geometry {
 	return b.ToPolygon().PointOnSurface()
@@ -228,6 +228,36 @@ func (c Collection) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (c Collection) SmoothChaikin(iterations int) Geometry {
+	coll := Collection{}
+	for _, v := range c {
+		coll = append(coll, v.SmoothChaikin(iterations))
+	}
+	return coll
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (c Collection) SmoothBezier(alpha float64) Geometry {
+	coll := Collection{}
+	for _, v := range c {
+		coll = append(coll, v.SmoothBezier(alpha))
+	}
+	return coll
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (c Collection) Densify(maxSegmentLength float64) Geometry {
+	coll := Collection{}
+	for _, v := range c {
+		coll = append(coll, v.Densify(maxSegmentLength))
+	}
+	return coll
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (c Collection) Buffer(width float64, quadsegs int) Geometry {
@@ -241,6 +271,20 @@ func (c Collection) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (c Collection) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(c.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (c Collection) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -282,6 +326,23 @@ func (c Collection) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (c Collection) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(c)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (c Collection) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(c)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (c Collection) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(c)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (c Collection) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(c.ToMatrix())
@@ -2,6 +2,9 @@ package space
 
 import (
 	"fmt"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
 // Coordinate coord
@@ -9,8 +12,148 @@ type Coordinate struct {
 	X float64
 	Y float64
 	Z float64
+	M float64
 }
 
 func (c Coordinate) String() string {
 	return fmt.Sprintf("%f %f", c.X, c.Y)
 }
+
+// CoordLayout describes the ordinates of the points of a geometry.
+// A point of 3 ordinates has a Z, a point of 4 ordinates has a Z and a measure M,
+// a point of the layout XYM is stored as x, y, NaN, m so that its measure is always the fourth ordinate
+// and its missing Z is NaN.
+type CoordLayout int
+
+// The layouts of the points.
+const (
+	// LayoutXY points of x and y.
+	LayoutXY CoordLayout = iota
+	// LayoutXYZ points of x, y and z.
+	LayoutXYZ
+	// LayoutXYM points of x, y and a measure m.
+	LayoutXYM
+	// LayoutXYZM points of x, y, z and a measure m.
+	LayoutXYZM
+)
+
+// HasZ returns true if the points of the layout have a Z.
+func (l CoordLayout) HasZ() bool {
+	return l == LayoutXYZ || l == LayoutXYZM
+}
+
+// HasM returns true if the points of the layout have a measure M.
+func (l CoordLayout) HasM() bool {
+	return l == LayoutXYM || l == LayoutXYZM
+}
+
+// Stride returns the number of ordinates of a point of the layout.
+func (l CoordLayout) Stride() int {
+	switch l {
+	case LayoutXYZ, LayoutXYM:
+		return 3
+	case LayoutXYZM:
+		return 4
+	}
+	return 2
+}
+
+// Point returns the point of the ordinates of the layout, x y, x y z, x y m or x y z m.
+func (l CoordLayout) Point(ordinates ...float64) Point {
+	if l == LayoutXYM && len(ordinates) > 2 {
+		return Point{ordinates[0], ordinates[1], math.NaN(), ordinates[2]}
+	}
+	return append(Point{}, ordinates...)
+}
+
+// Ordinates returns the ordinates of the point in the layout, a missing Z or M is 0.
+func (l CoordLayout) Ordinates(p Point) []float64 {
+	ordinates := []float64{p[0], p[1]}
+	if l.HasZ() {
+		z := 0.0
+		if len(p) > 2 && !math.IsNaN(p[2]) {
+			z = p[2]
+		}
+		ordinates = append(ordinates, z)
+	}
+	if l.HasM() {
+		m := 0.0
+		if len(p) > 3 {
+			m = p[3]
+		}
+		ordinates = append(ordinates, m)
+	}
+	return ordinates
+}
+
+// Layout returns the layout of the ordinates of the point, a point of 4 ordinates is XYM if its Z is NaN.
+func (p Point) Layout() CoordLayout {
+	switch {
+	case len(p) > 3 && math.IsNaN(p[2]):
+		return LayoutXYM
+	case len(p) > 3:
+		return LayoutXYZM
+	case len(p) == 3:
+		return LayoutXYZ
+	}
+	return LayoutXY
+}
+
+// Z returns the z coordinate of the point, NaN if it has none as a point of the layout XYM.
+func (p Point) Z() float64 {
+	if len(p) < 3 {
+		return math.NaN()
+	}
+	return p[2]
+}
+
+// M returns the measure of the point, NaN if it has none.
+func (p Point) M() float64 {
+	if len(p) < 4 {
+		return math.NaN()
+	}
+	return p[3]
+}
+
+// GeometryLayout returns the layout of the ordinates of the first point of the geometry,
+// LayoutXY if it is empty.
+func GeometryLayout(geom Geometry) CoordLayout {
+	if geom == nil || geom.IsEmpty() {
+		return LayoutXY
+	}
+	if p := firstPoint(geom.ToMatrix()); p != nil {
+		return Point(p).Layout()
+	}
+	return LayoutXY
+}
+
+// firstPoint returns the first point of the steric, nil if it has none.
+func firstPoint(steric matrix.Steric) matrix.Matrix {
+	switch m := steric.(type) {
+	case matrix.Matrix:
+		return m
+	case matrix.LineMatrix:
+		if len(m) > 0 {
+			return m[0]
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range m {
+			if len(v) > 0 {
+				return v[0]
+			}
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			if p := firstPoint(matrix.PolygonMatrix(v)); p != nil {
+				return p
+			}
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			if p := firstPoint(v); p != nil {
+				return p
+			}
+		}
+	}
+	return nil
+}
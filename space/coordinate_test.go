@@ -0,0 +1,57 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCoordLayout_Point(t *testing.T) {
+	tests := []struct {
+		name      string
+		layout    CoordLayout
+		ordinates []float64
+		want      Point
+		z, m      float64
+	}{
+		{"xy", LayoutXY, []float64{1, 2}, Point{1, 2}, math.NaN(), math.NaN()},
+		{"xyz", LayoutXYZ, []float64{1, 2, 3}, Point{1, 2, 3}, 3, math.NaN()},
+		{"xym", LayoutXYM, []float64{1, 2, 4}, Point{1, 2, math.NaN(), 4}, math.NaN(), 4},
+		{"xyzm", LayoutXYZM, []float64{1, 2, 3, 4}, Point{1, 2, 3, 4}, 3, 4},
+	}
+	same := func(a, b float64) bool { return a == b || math.IsNaN(a) && math.IsNaN(b) }
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.layout.Point(tt.ordinates...)
+			if !p.Equals(tt.want) || len(p) != len(tt.want) {
+				t.Errorf("Point() = %v, want %v", p, tt.want)
+			}
+			if !same(p.Z(), tt.z) || !same(p.M(), tt.m) {
+				t.Errorf("Z(), M() = %v %v, want %v %v", p.Z(), p.M(), tt.z, tt.m)
+			}
+			if got := tt.layout.Ordinates(p); !Point(got).Equals(Point(tt.ordinates)) || len(got) != len(tt.ordinates) {
+				t.Errorf("Ordinates() = %v, want %v", got, tt.ordinates)
+			}
+		})
+	}
+}
+
+func TestGeometryLayout(t *testing.T) {
+	tests := []struct {
+		name string
+		geom Geometry
+		want CoordLayout
+	}{
+		{"empty", LineString{}, LayoutXY},
+		{"line", LineString{{0, 0}, {1, 1}}, LayoutXY},
+		{"polygon z", Polygon{{{0, 0, 1}, {1, 0, 1}, {1, 1, 1}, {0, 0, 1}}}, LayoutXYZ},
+		{"multi line m", MultiLineString{{LayoutXYM.Point(0, 0, 1), LayoutXYM.Point(1, 0, 2)}}, LayoutXYM},
+		{"collection zm", Collection{Point{1, 2, 3, 4}}, LayoutXYZM},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GeometryLayout(tt.geom); got != tt.want {
+				t.Errorf("GeometryLayout() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/algorithm/relate"
 	"github.com/spatial-go/geoos/coordtransform"
 	"github.com/spatial-go/geoos/space/spaceerr"
@@ -61,6 +62,34 @@ func CreateElementValidWithCoordSys(geom Geometry, coordSys int) (*GeometryValid
 	return nil, spaceerr.ErrNotValidGeometry
 }
 
+// Validate returns the first validation error of geom with its type and coordinate, nil if it is valid.
+// A Ring is validated as the shell of a polygon, the polygons of a MultiPolygon must not overlap or be nested.
+func Validate(geom Geometry) *operation.ValidationError {
+	vop := &operation.ValidOP{Steric: validSteric(geom)}
+	return vop.Validate()
+}
+
+// validSteric returns the matrix of geom to validate.
+func validSteric(geom Geometry) matrix.Steric {
+	switch g := geom.(type) {
+	case Ring:
+		return matrix.PolygonMatrix{g.ToMatrix().(matrix.LineMatrix)}
+	case MultiPolygon:
+		matr := matrix.MultiPolygonMatrix{}
+		for _, v := range g {
+			matr = append(matr, v.ToMatrix().(matrix.PolygonMatrix))
+		}
+		return matr
+	case Collection:
+		matr := matrix.Collection{}
+		for _, v := range g {
+			matr = append(matr, validSteric(v))
+		}
+		return matr
+	}
+	return geom.ToMatrix()
+}
+
 // CoordinateSystem return Coordinate System.
 func (g GeometryValid) CoordinateSystem() int {
 	return g.coordinateSystem
@@ -118,12 +147,11 @@ func Relate(a, b Geometry) (string, error) {
 // For this function to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
 func Within(A, B Geometry) (bool, error) {
-	result := false
-	if inter, ret := aInB(A, B); ret {
-		result = inter
+	inter, ret := aInB(A, B)
+	if ret {
 		return inter, nil
 	}
-	im := relate.IM(A.ToMatrix(), B.ToMatrix(), result)
+	im := relate.IM(A.ToMatrix(), B.ToMatrix(), inter)
 	return im.IsWithin(), nil
 }
 
@@ -134,34 +162,31 @@ func Within(A, B Geometry) (bool, error) {
 // For this function to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
 func Contains(A, B Geometry) (bool, error) {
-	result := false
-	if inter, ret := aInB(B, A); ret {
-		result = inter
+	inter, ret := aInB(B, A)
+	if ret {
 		return inter, nil
 	}
-	im := relate.IM(A.ToMatrix(), B.ToMatrix(), result)
+	im := relate.IM(A.ToMatrix(), B.ToMatrix(), inter)
 	return im.IsContains(), nil
 }
 
 // Covers returns TRUE if no point in space.Geometry B is outside space.Geometry A
 func Covers(A, B Geometry) (bool, error) {
-	result := false
-	if inter, ret := aInB(B, A); ret {
-		result = inter
+	inter, ret := aInB(B, A)
+	if ret {
 		return inter, nil
 	}
-	im := relate.IM(A.ToMatrix(), B.ToMatrix(), result)
+	im := relate.IM(A.ToMatrix(), B.ToMatrix(), inter)
 	return im.IsCovers(), nil
 }
 
 // CoveredBy returns TRUE if no point in space.Geometry A is outside space.Geometry B
 func CoveredBy(A, B Geometry) (bool, error) {
-	result := false
-	if inter, ret := aInB(A, B); ret {
-		result = inter
+	inter, ret := aInB(A, B)
+	if ret {
 		return inter, nil
 	}
-	im := relate.IM(A.ToMatrix(), B.ToMatrix(), result)
+	im := relate.IM(A.ToMatrix(), B.ToMatrix(), inter)
 	return im.IsCoveredBy(), nil
 }
 
@@ -319,7 +344,7 @@ func BufferInMeter(geometry Geometry, width float64, quadsegs int) Geometry {
 	geometry = TransGeometry(geomMatrix)
 	geometry = geometry.Buffer(width, quadsegs)
 	if geometry != nil {
-		transformer.CoordType = coordtransform.MERCATORTOLL
+		transformer = coordtransform.NewTransformer(coordtransform.MERCATORTOLL)
 		geomMatrix, _ = transformer.TransformGeometry(geometry.ToMatrix())
 		geometry = TransGeometry(geomMatrix)
 	}
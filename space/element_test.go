@@ -0,0 +1,36 @@
+package space
+
+import (
+	"testing"
+)
+
+func TestContainsWithin(t *testing.T) {
+	quad := Polygon{{{0, 0}, {10, 1}, {11, 10}, {-1, 9}, {0, 0}}}
+	tests := []struct {
+		name  string
+		outer Geometry
+		inner Geometry
+		want  bool
+	}{
+		{"point in not rectangle", quad, Point{5, 5}, true},
+		{"line in not rectangle", quad, LineString{{2, 2}, {8, 7}}, true},
+		{"point outside not rectangle", quad, Point{10.8, 1}, false},
+		{"line crossing not rectangle", quad, LineString{{5, 5}, {12, 5}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := Contains(tt.outer, tt.inner); got != tt.want {
+				t.Errorf("Contains() = %v, want %v", got, tt.want)
+			}
+			if got, _ := Covers(tt.outer, tt.inner); got != tt.want {
+				t.Errorf("Covers() = %v, want %v", got, tt.want)
+			}
+			if got, _ := Within(tt.inner, tt.outer); got != tt.want {
+				t.Errorf("Within() = %v, want %v", got, tt.want)
+			}
+			if got, _ := CoveredBy(tt.inner, tt.outer); got != tt.want {
+				t.Errorf("CoveredBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package space
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// A GeoBound represents a closed box of lng lat which may cross the antimeridian.
+// Longitudes are in [-180, 180], if Min.X() > Max.X() the bound wraps across ±180°,
+// it covers the longitudes from Min.X() east to 180 and from -180 east to Max.X().
+type GeoBound struct {
+	Min, Max Point
+}
+
+// NewGeoBound returns the GeoBound of a planar bound of lng lat.
+// A bound wider than 360 degrees covers all longitudes.
+func NewGeoBound(b Bound) GeoBound {
+	if b.IsEmpty() {
+		return GeoBound{Min: emptyBound.Min, Max: emptyBound.Max}
+	}
+	if b.Max.X()-b.Min.X() >= 360 {
+		return GeoBound{Min: Point{-180, b.Min.Y()}, Max: Point{180, b.Max.Y()}}
+	}
+	return GeoBound{
+		Min: Point{normalizeLng(b.Min.X()), b.Min.Y()},
+		Max: Point{normalizeLng(b.Max.X()), b.Max.Y()},
+	}
+}
+
+// GeoBoundOf returns the smallest GeoBound containing all points of the geometry,
+// the longitudes are bounded by the complement of the largest gap between them,
+// so a geometry spanning the antimeridian gets a bound which wraps across it.
+func GeoBoundOf(geom Geometry) GeoBound {
+	if geom == nil || geom.IsEmpty() {
+		return GeoBound{Min: emptyBound.Min, Max: emptyBound.Max}
+	}
+	points := matrix.TransMatrixes(geom.ToMatrix())
+	lngs := make([]float64, 0, len(points))
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, v := range points {
+		lngs = append(lngs, normalizeLng(v[0]))
+		minY, maxY = math.Min(minY, v[1]), math.Max(maxY, v[1])
+	}
+	sort.Float64s(lngs)
+	// the gap across the antimeridian, from the last longitude east to the first.
+	gap, west, east := lngs[0]+360-lngs[len(lngs)-1], lngs[0], lngs[len(lngs)-1]
+	for i := 1; i < len(lngs); i++ {
+		if d := lngs[i] - lngs[i-1]; d > gap {
+			gap, west, east = d, lngs[i], lngs[i-1]
+		}
+	}
+	return GeoBound{Min: Point{west, minY}, Max: Point{east, maxY}}
+}
+
+// IsEmpty returns true if the bound contains no point.
+func (b GeoBound) IsEmpty() bool {
+	if b.Max == nil || b.Min == nil {
+		return true
+	}
+	return b.Min[1] > b.Max[1]
+}
+
+// IsWrapped returns true if the bound crosses the antimeridian.
+func (b GeoBound) IsWrapped() bool {
+	return !b.IsEmpty() && b.Min[0] > b.Max[0]
+}
+
+// Width returns the width of longitudes of the bound, unit degree.
+func (b GeoBound) Width() float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+	return lngWidth(b.Min[0], b.Max[0])
+}
+
+// Height returns the height of latitudes of the bound, unit degree.
+func (b GeoBound) Height() float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+	return b.Max[1] - b.Min[1]
+}
+
+// Center returns the center of the bound, its longitude is in [-180, 180].
+func (b GeoBound) Center() Point {
+	return Point{normalizeLng(b.Min[0] + b.Width()/2), (b.Min[1] + b.Max[1]) / 2}
+}
+
+// Bounds splits the bound at the antimeridian into the planar bounds,
+// it returns one bound if the bound is not wrapped and two bounds otherwise.
+func (b GeoBound) Bounds() []Bound {
+	if b.IsEmpty() {
+		return []Bound{}
+	}
+	if !b.IsWrapped() {
+		return []Bound{{Min: b.Min, Max: b.Max}}
+	}
+	return []Bound{
+		{Min: b.Min, Max: Point{180, b.Max[1]}},
+		{Min: Point{-180, b.Min[1]}, Max: b.Max},
+	}
+}
+
+// ToGeometry returns the bound as a Polygon, or a MultiPolygon cut at the antimeridian if it is wrapped.
+func (b GeoBound) ToGeometry() Geometry {
+	bounds := b.Bounds()
+	if len(bounds) == 1 {
+		return bounds[0].ToPolygon()
+	}
+	multi := MultiPolygon{}
+	for _, v := range bounds {
+		multi = append(multi, v.ToPolygon())
+	}
+	return multi
+}
+
+// Contains determines if the point is within the bound.
+// Points on the boundary are considered within.
+func (b GeoBound) Contains(point Point) bool {
+	if b.IsEmpty() || point[1] < b.Min[1] || b.Max[1] < point[1] {
+		return false
+	}
+	return b.containsLng(normalizeLng(point[0]))
+}
+
+// ContainsBound determines if the bound is within the bound.
+func (b GeoBound) ContainsBound(bound GeoBound) bool {
+	if b.IsEmpty() || bound.IsEmpty() {
+		return false
+	}
+	for _, v := range bound.Bounds() {
+		contained := false
+		for _, w := range b.Bounds() {
+			if w.ContainsBound(v) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectsBound Tests if the region defined by other intersects the region of this bound.
+func (b GeoBound) IntersectsBound(other GeoBound) bool {
+	for _, v := range b.Bounds() {
+		for _, w := range other.Bounds() {
+			if v.IntersectsBound(w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Extend grows the bound to include the new point,
+// it grows east or west by the direction which makes the smaller bound.
+func (b GeoBound) Extend(point Point) GeoBound {
+	lng := normalizeLng(point[0])
+	if b.IsEmpty() {
+		return GeoBound{Min: Point{lng, point[1]}, Max: Point{lng, point[1]}}
+	}
+	c := GeoBound{Min: Point{b.Min[0], math.Min(b.Min[1], point[1])}, Max: Point{b.Max[0], math.Max(b.Max[1], point[1])}}
+	if b.containsLng(lng) {
+		return c
+	}
+	if lngWidth(b.Max[0], lng) <= lngWidth(lng, b.Min[0]) {
+		c.Max[0] = lng
+	} else {
+		c.Min[0] = lng
+	}
+	return c
+}
+
+// Union returns the smallest bound containing the two bounds.
+func (b GeoBound) Union(other GeoBound) GeoBound {
+	if b.IsEmpty() {
+		return other
+	}
+	if other.IsEmpty() {
+		return b
+	}
+	c := GeoBound{
+		Min: Point{b.Min[0], math.Min(b.Min[1], other.Min[1])},
+		Max: Point{b.Max[0], math.Max(b.Max[1], other.Max[1])},
+	}
+	bMin, bMax := b.containsLng(other.Min[0]), b.containsLng(other.Max[0])
+	oMin, oMax := other.containsLng(b.Min[0]), other.containsLng(b.Max[0])
+	switch {
+	case bMin && bMax && oMin && oMax:
+		// the bounds cover all longitudes together.
+		if b.Width()+other.Width() >= 360 {
+			c.Min[0], c.Max[0] = -180, 180
+		} else if other.Width() > b.Width() {
+			c.Min[0], c.Max[0] = other.Min[0], other.Max[0]
+		}
+	case bMin && bMax:
+	case oMin && oMax:
+		c.Min[0], c.Max[0] = other.Min[0], other.Max[0]
+	case bMin:
+		c.Max[0] = other.Max[0]
+	case oMin:
+		c.Min[0] = other.Min[0]
+	default:
+		if lngWidth(b.Min[0], other.Max[0]) <= lngWidth(other.Min[0], b.Max[0]) {
+			c.Max[0] = other.Max[0]
+		} else {
+			c.Min[0] = other.Min[0]
+		}
+	}
+	return c
+}
+
+// EqualsBound returns if two bounds are equal.
+func (b GeoBound) EqualsBound(c GeoBound) bool {
+	return b.Min.EqualsPoint(c.Min) && b.Max.EqualsPoint(c.Max)
+}
+
+// containsLng returns true if the normalized longitude is within the bound.
+// The antimeridian is both -180 and 180.
+func (b GeoBound) containsLng(lng float64) bool {
+	if math.Abs(lng) == 180 {
+		return b.within(-180) || b.within(180)
+	}
+	return b.within(lng)
+}
+
+func (b GeoBound) within(lng float64) bool {
+	if b.Min[0] <= b.Max[0] {
+		return b.Min[0] <= lng && lng <= b.Max[0]
+	}
+	return b.Min[0] <= lng || lng <= b.Max[0]
+}
+
+// normalizeLng returns the longitude in [-180, 180].
+func normalizeLng(lng float64) float64 {
+	if lng >= -180 && lng <= 180 {
+		return lng
+	}
+	lng = math.Mod(lng+180, 360)
+	if lng < 0 {
+		lng += 360
+	}
+	return lng - 180
+}
+
+// lngWidth returns the width of longitudes from west east to east, unit degree.
+func lngWidth(west, east float64) float64 {
+	if west == -180 && east == 180 {
+		return 360
+	}
+	if east >= west {
+		return east - west
+	}
+	return east - west + 360
+}
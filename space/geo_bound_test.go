@@ -0,0 +1,84 @@
+package space
+
+import (
+	"testing"
+)
+
+func TestGeoBoundOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		geom    Geometry
+		want    GeoBound
+		wrapped bool
+	}{
+		{"pacific line", LineString{{170, 10}, {-170, 20}, {-160, 15}},
+			GeoBound{Min: Point{170, 10}, Max: Point{-160, 20}}, true},
+		{"europe line", LineString{{0, 40}, {10, 50}, {20, 45}},
+			GeoBound{Min: Point{0, 40}, Max: Point{20, 50}}, false},
+		{"beyond 180", MultiPoint{{175, 0}, {185, 5}},
+			GeoBound{Min: Point{175, 0}, Max: Point{-175, 5}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GeoBoundOf(tt.geom)
+			if !got.EqualsBound(tt.want) {
+				t.Errorf("GeoBoundOf() = %v, want %v", got, tt.want)
+			}
+			if got.IsWrapped() != tt.wrapped {
+				t.Errorf("IsWrapped() = %v, want %v", got.IsWrapped(), tt.wrapped)
+			}
+		})
+	}
+}
+
+func TestGeoBound_Relate(t *testing.T) {
+	pacific := GeoBound{Min: Point{170, -10}, Max: Point{-170, 10}}
+	tests := []struct {
+		name       string
+		b, other   GeoBound
+		intersects bool
+		contains   bool
+	}{
+		{"across antimeridian", pacific, GeoBound{Min: Point{175, 0}, Max: Point{-175, 5}}, true, true},
+		{"east part", pacific, GeoBound{Min: Point{-179, 0}, Max: Point{-175, 5}}, true, true},
+		{"atlantic", pacific, GeoBound{Min: Point{-40, 0}, Max: Point{-10, 5}}, false, false},
+		{"overlap", pacific, GeoBound{Min: Point{-175, 0}, Max: Point{-160, 5}}, true, false},
+		{"world", GeoBound{Min: Point{-180, -90}, Max: Point{180, 90}}, pacific, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.b.IntersectsBound(tt.other); got != tt.intersects {
+				t.Errorf("IntersectsBound() = %v, want %v", got, tt.intersects)
+			}
+			if got := tt.b.ContainsBound(tt.other); got != tt.contains {
+				t.Errorf("ContainsBound() = %v, want %v", got, tt.contains)
+			}
+		})
+	}
+	if !pacific.Contains(Point{180, 0}) || !pacific.Contains(Point{-180, 0}) || pacific.Contains(Point{0, 0}) {
+		t.Errorf("Contains() wrong of %v", pacific)
+	}
+}
+
+func TestGeoBound_ExtendUnion(t *testing.T) {
+	b := GeoBound{Min: Point{170, 0}, Max: Point{175, 5}}
+	if got, want := b.Extend(Point{-175, 10}), (GeoBound{Min: Point{170, 0}, Max: Point{-175, 10}}); !got.EqualsBound(want) {
+		t.Errorf("Extend() = %v, want %v", got, want)
+	}
+	if got, want := b.Extend(Point{160, -5}), (GeoBound{Min: Point{160, -5}, Max: Point{175, 5}}); !got.EqualsBound(want) {
+		t.Errorf("Extend() = %v, want %v", got, want)
+	}
+	other := GeoBound{Min: Point{-170, 0}, Max: Point{-160, 5}}
+	if got, want := b.Union(other), (GeoBound{Min: Point{170, 0}, Max: Point{-160, 5}}); !got.EqualsBound(want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+	if got := b.Union(other).Width(); got != 30 {
+		t.Errorf("Width() = %v, want %v", got, 30)
+	}
+	if got, want := b.Union(other).Center(), (Point{-175, 2.5}); !got.EqualsPoint(want) {
+		t.Errorf("Center() = %v, want %v", got, want)
+	}
+	if got := len(b.Union(other).Bounds()); got != 2 {
+		t.Errorf("Bounds() = %v, want %v", got, 2)
+	}
+}
@@ -0,0 +1,115 @@
+package space
+
+import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
+)
+
+// geodesicOf returns the geodesic of the ellipsoid the geometry is on,
+// it is CGCS2000 for CGCS2000 geometries and WGS84 for the others.
+func geodesicOf(geom Geometry) *measure.Geodesic {
+	if geom.CoordinateSystem() == CGCS2000 {
+		return measure.CGCS2000Geodesic
+	}
+	return measure.WGS84Geodesic
+}
+
+// GeodesicArea returns the area of a polygonal geometry on the ellipsoid, unit m².
+// Coordinates are lng lat and edges are geodesics.
+func GeodesicArea(geom Geometry) float64 {
+	if geom == nil || geom.IsEmpty() {
+		return 0
+	}
+	area, _ := geodesicOf(geom).AreaPerimeter(geom.ToMatrix())
+	return area
+}
+
+// GeodesicPerimeter returns the perimeter of a polygonal geometry on the ellipsoid, unit m.
+func GeodesicPerimeter(geom Geometry) float64 {
+	if geom == nil || geom.IsEmpty() {
+		return 0
+	}
+	_, perimeter := geodesicOf(geom).AreaPerimeter(geom.ToMatrix())
+	return perimeter
+}
+
+// GeodesicLength returns the length of the geometry on the ellipsoid, unit m.
+// As Length, it is the length of lines or the length of polygon rings.
+func GeodesicLength(geom Geometry) float64 {
+	if geom == nil || geom.IsEmpty() {
+		return 0
+	}
+	return geodesicOf(geom).Length(geom.ToMatrix())
+}
+
+// GeodesicDistance returns the minimum distance between the two geometries on the ellipsoid, unit m,
+// the edges of lines and polygons are geodesics.
+func GeodesicDistance(from, to Geometry) (float64, error) {
+	if from == nil || from.IsEmpty() || to == nil || to.IsEmpty() {
+		return 0, nil
+	}
+	g := geodesicOf(from)
+	elem := &measure.ElementDistance{From: from.ToMatrix(), To: to.ToMatrix(), F: g.Distance, Segment: g.SegmentDistance}
+	return elem.Distance()
+}
+
+// DensifyGreatCircle returns the line with points inserted along great circles on the sphere,
+// so that no segment is longer than maxLength, unit m.
+func DensifyGreatCircle(line LineString, maxLength float64) LineString {
+	return LineString(measure.DensifyGreatCircle(matrix.LineMatrix(line), maxLength))
+}
+
+// GeodesicDensify returns the geometry of lng lat with points inserted along great circles on the sphere,
+// so that no segment is longer than maxLength, unit m.
+// Inserted longitudes are in [-180, 180], a geometry crossing the antimeridian may be cut by SplitAntimeridian.
+func GeodesicDensify(geom Geometry, maxLength float64) Geometry {
+	if geom == nil || geom.IsEmpty() {
+		return geom
+	}
+	switch g := geom.(type) {
+	case Ring:
+		return Ring(DensifyGreatCircle(LineString(g), maxLength))
+	case Bound:
+		return GeodesicDensify(g.ToPolygon(), maxLength)
+	case Collection:
+		coll := Collection{}
+		for _, v := range g {
+			coll = append(coll, GeodesicDensify(v, maxLength))
+		}
+		return coll
+	}
+	return TransGeometry(operation.DensifyGreatCircle(geom.ToMatrix(), maxLength))
+}
+
+// GeodesicBuffer returns a geometry that represents all points whose geodesic distance on the ellipsoid
+// from this geometry of lng lat is less than or equal to width, unit m.
+// Unlike BufferInMeter it does not project, the offset curves are computed by geodesic direct,
+// quadsegs facets approximate a quarter circle. The result is cut at the antimeridian by SplitAntimeridian,
+// a buffer enclosing a pole is closed along the pole.
+// A negative width shrinks polygons, parts of a multi geometry are buffered separately.
+func GeodesicBuffer(geom Geometry, width float64, quadsegs int) Geometry {
+	if geom == nil || geom.IsEmpty() {
+		return nil
+	}
+	steric := buffer.GeodesicBuffer(geom.ToMatrix(), width, quadsegs, geodesicOf(geom))
+	if steric == nil {
+		return nil
+	}
+	result := SplitAntimeridian(TransGeometry(steric))
+	coll, ok := result.(Collection)
+	if !ok {
+		return result
+	}
+	multi := MultiPolygon{}
+	for _, v := range coll {
+		switch g := v.(type) {
+		case Polygon:
+			multi = append(multi, g)
+		case MultiPolygon:
+			multi = append(multi, g...)
+		}
+	}
+	return multi
+}
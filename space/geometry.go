@@ -1,6 +1,7 @@
 package space
 
 import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 )
@@ -54,6 +55,11 @@ type Geometry interface {
 	// from this space.Geometry is less than or equal to distance.
 	Buffer(width float64, quadsegs int) Geometry
 
+	// BufferWithParams Returns a geometry that represents all points whose distance
+	// from this space.Geometry is less than or equal to distance,
+	// the end cap style, join style, mitre limit and single side are given by the parameters.
+	BufferWithParams(width float64, params *buffer.CurveParameters) Geometry
+
 	// BufferInMeter Returns a geometry that represents all points whose distance
 	// from this space.Geometry is less than or equal to distance.
 	BufferInMeter(width float64, quadsegs int) Geometry
@@ -68,6 +74,17 @@ type Geometry interface {
 	// The convex hull of one or more identical points is a Point.
 	ConvexHull() Geometry
 
+	// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+	MinimumBoundingCircle() *Circle
+
+	// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+	// nil if the geometry is empty.
+	MinimumAreaRectangle() *Rectangle
+
+	// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+	// the minimum width of the geometry, nil if the geometry is empty.
+	MinimumWidthRectangle() *Rectangle
+
 	// Distance returns distance Between the two Geometry.
 	Distance(g Geometry) (float64, error)
 
@@ -128,6 +145,18 @@ type Geometry interface {
 	// Unlike Simplify, SimplifyP guarantees it will preserve topology.
 	SimplifyP(tolerance float64) Geometry
 
+	// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+	// Rings stay closed and polygons stay valid.
+	SmoothChaikin(iterations int) Geometry
+
+	// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+	// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+	SmoothBezier(alpha float64) Geometry
+
+	// Densify returns the geometry with points inserted along its segments,
+	// so that no segment is longer than maxSegmentLength.
+	Densify(maxSegmentLength float64) Geometry
+
 	// SpheroidDistance returns  spheroid distance Between the two Geometry.
 	SpheroidDistance(g Geometry) (float64, error)
 
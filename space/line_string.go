@@ -195,6 +195,24 @@ func (ls LineString) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (ls LineString) SmoothChaikin(iterations int) Geometry {
+	return TransGeometry(operation.ChaikinSmooth(ls.ToMatrix(), iterations))
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (ls LineString) SmoothBezier(alpha float64) Geometry {
+	return TransGeometry(operation.BezierSmooth(ls.ToMatrix(), alpha))
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (ls LineString) Densify(maxSegmentLength float64) Geometry {
+	return TransGeometry(operation.Densify(ls.ToMatrix(), maxSegmentLength))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (ls LineString) Buffer(width float64, quadsegs int) Geometry {
@@ -208,6 +226,20 @@ func (ls LineString) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (ls LineString) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(ls.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (ls LineString) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -261,6 +293,23 @@ func (ls LineString) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (ls LineString) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(ls)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (ls LineString) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(ls)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (ls LineString) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(ls)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (ls LineString) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(ls.ToMatrix())
@@ -206,6 +206,24 @@ func (mls MultiLineString) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (mls MultiLineString) SmoothChaikin(iterations int) Geometry {
+	return TransGeometry(operation.ChaikinSmooth(mls.ToMatrix(), iterations))
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (mls MultiLineString) SmoothBezier(alpha float64) Geometry {
+	return TransGeometry(operation.BezierSmooth(mls.ToMatrix(), alpha))
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (mls MultiLineString) Densify(maxSegmentLength float64) Geometry {
+	return TransGeometry(operation.Densify(mls.ToMatrix(), maxSegmentLength))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (mls MultiLineString) Buffer(width float64, quadsegs int) Geometry {
@@ -219,6 +237,20 @@ func (mls MultiLineString) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (mls MultiLineString) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(mls.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (mls MultiLineString) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -252,6 +284,23 @@ func (mls MultiLineString) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (mls MultiLineString) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(mls)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (mls MultiLineString) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(mls)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (mls MultiLineString) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(mls)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (mls MultiLineString) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(mls.ToMatrix())
@@ -163,6 +163,24 @@ func (mp MultiPoint) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (mp MultiPoint) SmoothChaikin(iterations int) Geometry {
+	return TransGeometry(operation.ChaikinSmooth(mp.ToMatrix(), iterations))
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (mp MultiPoint) SmoothBezier(alpha float64) Geometry {
+	return TransGeometry(operation.BezierSmooth(mp.ToMatrix(), alpha))
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (mp MultiPoint) Densify(maxSegmentLength float64) Geometry {
+	return TransGeometry(operation.Densify(mp.ToMatrix(), maxSegmentLength))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (mp MultiPoint) Buffer(width float64, quadsegs int) Geometry {
@@ -176,6 +194,20 @@ func (mp MultiPoint) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (mp MultiPoint) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(mp.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (mp MultiPoint) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -224,6 +256,23 @@ func (mp MultiPoint) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (mp MultiPoint) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(mp)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (mp MultiPoint) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(mp)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (mp MultiPoint) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(mp)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (mp MultiPoint) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(mp.ToMatrix())
@@ -182,6 +182,24 @@ func (mp MultiPolygon) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (mp MultiPolygon) SmoothChaikin(iterations int) Geometry {
+	return transMultiPolygon(operation.ChaikinSmooth(validSteric(mp), iterations))
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (mp MultiPolygon) SmoothBezier(alpha float64) Geometry {
+	return transMultiPolygon(operation.BezierSmooth(validSteric(mp), alpha))
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (mp MultiPolygon) Densify(maxSegmentLength float64) Geometry {
+	return TransGeometry(operation.Densify(mp.ToMatrix(), maxSegmentLength))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (mp MultiPolygon) Buffer(width float64, quadsegs int) Geometry {
@@ -195,6 +213,20 @@ func (mp MultiPolygon) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (mp MultiPolygon) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(mp.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (mp MultiPolygon) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -228,6 +260,23 @@ func (mp MultiPolygon) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (mp MultiPolygon) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(mp)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (mp MultiPolygon) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(mp)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (mp MultiPolygon) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(mp)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (mp MultiPolygon) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(mp.ToMatrix())
@@ -275,3 +324,12 @@ func (mp MultiPolygon) Filter(f matrix.Filter) Geometry {
 	}
 	return mp
 }
+
+// transMultiPolygon returns the MultiPolygon of the multi polygon matrix.
+func transMultiPolygon(matr matrix.Steric) MultiPolygon {
+	mp := MultiPolygon{}
+	for _, v := range matr.(matrix.MultiPolygonMatrix) {
+		mp = append(mp, Polygon(v))
+	}
+	return mp
+}
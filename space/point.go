@@ -9,6 +9,7 @@ import (
 	"github.com/spatial-go/geoos/algorithm/buffer/simplify"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
@@ -166,6 +167,24 @@ func (p Point) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (p Point) SmoothChaikin(iterations int) Geometry {
+	return TransGeometry(operation.ChaikinSmooth(p.ToMatrix(), iterations))
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (p Point) SmoothBezier(alpha float64) Geometry {
+	return TransGeometry(operation.BezierSmooth(p.ToMatrix(), alpha))
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (p Point) Densify(maxSegmentLength float64) Geometry {
+	return TransGeometry(operation.Densify(p.ToMatrix(), maxSegmentLength))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (p Point) Buffer(width float64, quadsegs int) Geometry {
@@ -179,6 +198,20 @@ func (p Point) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (p Point) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(p.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (p Point) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -217,6 +250,23 @@ func (p Point) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (p Point) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(p)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (p Point) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(p)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (p Point) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(p)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (p Point) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(p.ToMatrix())
@@ -236,6 +236,24 @@ func (p Polygon) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (p Polygon) SmoothChaikin(iterations int) Geometry {
+	return TransGeometry(operation.ChaikinSmooth(p.ToMatrix(), iterations))
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (p Polygon) SmoothBezier(alpha float64) Geometry {
+	return TransGeometry(operation.BezierSmooth(p.ToMatrix(), alpha))
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (p Polygon) Densify(maxSegmentLength float64) Geometry {
+	return TransGeometry(operation.Densify(p.ToMatrix(), maxSegmentLength))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (p Polygon) Buffer(width float64, quadsegs int) Geometry {
@@ -249,6 +267,20 @@ func (p Polygon) Buffer(width float64, quadsegs int) Geometry {
 	return nil
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (p Polygon) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	buff := buffer.BufferWithParams(p.ToMatrix(), width, params)
+	switch b := buff.(type) {
+	case matrix.LineMatrix:
+		return LineString(b)
+	case matrix.PolygonMatrix:
+		return Polygon(b)
+	}
+	return nil
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (p Polygon) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -282,6 +314,23 @@ func (p Polygon) ConvexHull() Geometry {
 	return TransGeometry(result)
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (p Polygon) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(p)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (p Polygon) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(p)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (p Polygon) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(p)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (p Polygon) PointOnSurface() Geometry {
 	m := buffer.InteriorPoint(p.ToMatrix())
@@ -1,10 +1,12 @@
 package space
 
 import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/buffer/simplify"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
@@ -57,7 +59,7 @@ func (r Ring) EqualsExact(g Geometry, tolerance float64) bool {
 	if r.GeoJSONType() != g.GeoJSONType() {
 		return false
 	}
-	return LineString(r).Equals(LineString(g.(Ring)))
+	return LineString(r).EqualsExact(LineString(g.(Ring)), tolerance)
 }
 
 // Area returns the area of a polygonal geometry.
@@ -134,12 +136,39 @@ func (r Ring) SimplifyP(tolerance float64) Geometry {
 	return TransGeometry(result)
 }
 
+// SmoothChaikin returns the geometry smoothed by cutting the corners of its lines and rings iterations times.
+// Rings stay closed and polygons stay valid.
+func (r Ring) SmoothChaikin(iterations int) Geometry {
+	result := operation.ChaikinSmooth(validSteric(r), iterations)
+	return Ring(result.(matrix.PolygonMatrix)[0])
+}
+
+// SmoothBezier returns the geometry smoothed by cubic Bezier curves through its points,
+// alpha sets the tension of the curves. Rings stay closed and polygons stay valid.
+func (r Ring) SmoothBezier(alpha float64) Geometry {
+	result := operation.BezierSmooth(validSteric(r), alpha)
+	return Ring(result.(matrix.PolygonMatrix)[0])
+}
+
+// Densify returns the geometry with points inserted along its segments,
+// so that no segment is longer than maxSegmentLength.
+func (r Ring) Densify(maxSegmentLength float64) Geometry {
+	return Ring(operation.Densify(r.ToMatrix(), maxSegmentLength).(matrix.LineMatrix))
+}
+
 // Buffer Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (r Ring) Buffer(width float64, quadsegs int) Geometry {
 	return LineString(r).Buffer(width, quadsegs)
 }
 
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// the end cap style, join style, mitre limit and single side are given by the parameters.
+func (r Ring) BufferWithParams(width float64, params *buffer.CurveParameters) Geometry {
+	return LineString(r).BufferWithParams(width, params)
+}
+
 // BufferInMeter Returns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
 func (r Ring) BufferInMeter(width float64, quadsegs int) Geometry {
@@ -176,6 +205,23 @@ func (r Ring) ConvexHull() Geometry {
 	return LineString(r).ConvexHull()
 }
 
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func (r Ring) MinimumBoundingCircle() *Circle {
+	return MinimumBoundingCircle(r)
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func (r Ring) MinimumAreaRectangle() *Rectangle {
+	return MinimumAreaRectangle(r)
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func (r Ring) MinimumWidthRectangle() *Rectangle {
+	return MinimumWidthRectangle(r)
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (r Ring) PointOnSurface() Geometry {
 	return LineString(r).PointOnSurface()
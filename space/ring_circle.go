@@ -23,7 +23,9 @@ func CreateCircle(centre Point, radius float64) (*Circle, error) {
 // CreateCircleWithSegments Returns valid circle.
 func CreateCircleWithSegments(centre Point, radius float64, segments int) (*Circle, error) {
 	circle := &Circle{Centre: centre, Radius: radius, Segments: segments}
-	circle.Polygon = centre.Buffer(radius, segments).(Polygon)
+	if polygon, ok := centre.Buffer(radius, segments).(Polygon); ok {
+		circle.Polygon = polygon
+	}
 	return circle, nil
 }
 
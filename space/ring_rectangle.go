@@ -0,0 +1,65 @@
+package space
+
+import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Rectangle describes a rectangle rotated by Angle around its Centre.
+// Length is the length of the sides in the direction of Angle, Width the length of the other sides,
+// Width is not greater than Length. Angle is in radians in [0, π) from the x axis.
+// If Width is zero the Polygon is the zero-width ring collapsed on the sides of Length,
+// on Centre if Length is zero too.
+type Rectangle struct {
+	Polygon
+	Centre Point
+	Length float64
+	Width  float64
+	Angle  float64
+}
+
+// CreateRectangle Returns the rectangle of centre, length, width and angle.
+func CreateRectangle(centre Point, length, width, angle float64) *Rectangle {
+	rect := &Rectangle{Centre: centre, Length: length, Width: width, Angle: angle}
+	oriented := &buffer.OrientedRectangle{Centre: centre.ToMatrix().(matrix.Matrix), Length: length, Width: width, Angle: angle}
+	rect.Polygon = Polygon{oriented.Ring()}
+	return rect
+}
+
+// MinimumBoundingCircle returns the smallest circle containing the geometry, nil if the geometry is empty.
+func MinimumBoundingCircle(geom Geometry) *Circle {
+	if geom == nil || geom.IsEmpty() {
+		return nil
+	}
+	centre, radius := buffer.MinimumBoundingCircle(geom.ToMatrix())
+	if centre == nil {
+		return nil
+	}
+	circle, _ := CreateCircle(Point(centre), radius)
+	return circle
+}
+
+// MinimumAreaRectangle returns the rotated rectangle of minimum area containing the geometry,
+// nil if the geometry is empty.
+func MinimumAreaRectangle(geom Geometry) *Rectangle {
+	if geom == nil || geom.IsEmpty() {
+		return nil
+	}
+	return transRectangle(buffer.MinimumAreaRectangle(geom.ToMatrix()))
+}
+
+// MinimumWidthRectangle returns the rotated rectangle containing the geometry whose Width is
+// the minimum width of the geometry, nil if the geometry is empty.
+func MinimumWidthRectangle(geom Geometry) *Rectangle {
+	if geom == nil || geom.IsEmpty() {
+		return nil
+	}
+	return transRectangle(buffer.MinimumWidthRectangle(geom.ToMatrix()))
+}
+
+func transRectangle(rect *buffer.OrientedRectangle) *Rectangle {
+	if rect == nil {
+		return nil
+	}
+	return CreateRectangle(Point(rect.Centre), rect.Length, rect.Width, rect.Angle)
+}
@@ -0,0 +1,82 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCreateRectangle(t *testing.T) {
+	tests := []struct {
+		name string
+		rect *Rectangle
+		want Polygon
+	}{
+		{"rectangle", CreateRectangle(Point{2, 1}, 4, 2, 0), Polygon{{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}}},
+		{"line", CreateRectangle(Point{2, 0}, 4, 0, 0), Polygon{{{0, 0}, {4, 0}, {4, 0}, {0, 0}, {0, 0}}}},
+		{"point", CreateRectangle(Point{2, 0}, 0, 0, 0), Polygon{{{2, 0}, {2, 0}, {2, 0}, {2, 0}, {2, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.rect.Polygon.EqualsExact(tt.want, 1e-9) {
+				t.Errorf("CreateRectangle() = %v, want %v", tt.rect.Polygon, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinimumBoundingCircle(t *testing.T) {
+	tests := []struct {
+		name   string
+		geom   Geometry
+		centre Point
+		radius float64
+	}{
+		{"polygon", Polygon{{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}}, Point{2, 1}, math.Sqrt(5)},
+		{"multi point", MultiPoint{{0, 0}, {2, 0}, {1, 3}}, Point{1, 4.0 / 3}, 5.0 / 3},
+		{"line string", LineString{{0, 0}, {2, 0}, {1, 0.5}}, Point{1, 0}, 1},
+		{"point", Point{1, 2}, Point{1, 2}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.geom.MinimumBoundingCircle()
+			if !got.Centre.EqualsExact(tt.centre, 1e-9) || math.Abs(got.Radius-tt.radius) > 1e-9 {
+				t.Errorf("MinimumBoundingCircle() = %v %v, want %v %v", got.Centre, got.Radius, tt.centre, tt.radius)
+			}
+		})
+	}
+	if got := (MultiPoint{}).MinimumBoundingCircle(); got != nil {
+		t.Errorf("MinimumBoundingCircle() = %v, want nil", got)
+	}
+}
+
+func TestMinimumRectangle(t *testing.T) {
+	// an L-shaped building rotated by 45 degrees.
+	building := Polygon{{{0, 0}, {4, 4}, {3, 5}, {1, 3}, {-1, 5}, {-2, 4}, {0, 0}}}
+	area := building.MinimumAreaRectangle()
+	if math.Abs(area.Length*area.Width-4*math.Sqrt2*3*math.Sqrt2) > 1e-9 || math.Abs(area.Angle-math.Pi/4) > 1e-9 {
+		t.Errorf("MinimumAreaRectangle() = %v x %v angle %v, want %v x %v angle %v",
+			area.Length, area.Width, area.Angle, 4*math.Sqrt2, 3*math.Sqrt2, math.Pi/4)
+	}
+	width := building.MinimumWidthRectangle()
+	if math.Abs(width.Width-3*math.Sqrt2) > 1e-9 {
+		t.Errorf("MinimumWidthRectangle() width = %v, want %v", width.Width, 3*math.Sqrt2)
+	}
+	collapsed := []struct {
+		geom Geometry
+		want Polygon
+	}{
+		{Point{1, 2}, Polygon{{{1, 2}, {1, 2}, {1, 2}, {1, 2}, {1, 2}}}},
+		{LineString{{0, 0}, {1, 1}, {2, 2}}, Polygon{{{0, 0}, {2, 2}, {2, 2}, {0, 0}, {0, 0}}}},
+	}
+	for _, tt := range collapsed {
+		if got := tt.geom.MinimumAreaRectangle(); !got.Polygon.EqualsExact(tt.want, 1e-9) {
+			t.Errorf("MinimumAreaRectangle() = %v, want %v", got.Polygon, tt.want)
+		}
+		if got := tt.geom.MinimumWidthRectangle(); !got.Polygon.EqualsExact(tt.want, 1e-9) {
+			t.Errorf("MinimumWidthRectangle() = %v, want %v", got.Polygon, tt.want)
+		}
+	}
+	if got := (LineString{}).MinimumAreaRectangle(); got != nil {
+		t.Errorf("MinimumAreaRectangle() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,113 @@
+package space
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/coordtransform"
+)
+
+// registers the coordinate systems defined by space which are not EPSG codes.
+func init() {
+	crs := []*coordtransform.CRS{
+		{SRID: GCJ02, Name: "GCJ02", Ellipsoid: coordtransform.WGS84Ellipsoid,
+			ToWGS84: coordtransform.GCJ02ToWGS84, FromWGS84: coordtransform.WGS84ToGCJ02},
+		{SRID: GCJ02Web, Name: "GCJ02 / Pseudo-Mercator", Ellipsoid: coordtransform.WGS84Ellipsoid,
+			Projection: coordtransform.WebMercator{},
+			ToWGS84:    coordtransform.GCJ02ToWGS84, FromWGS84: coordtransform.WGS84ToGCJ02},
+		{SRID: BD09, Name: "BD09", Ellipsoid: coordtransform.WGS84Ellipsoid,
+			ToWGS84: coordtransform.BD09ToWGS84, FromWGS84: coordtransform.WGS84ToBD09},
+		{SRID: BD09Web, Name: "BD09 / Pseudo-Mercator", Ellipsoid: coordtransform.WGS84Ellipsoid,
+			Projection: coordtransform.WebMercator{},
+			ToWGS84:    coordtransform.BD09ToWGS84, FromWGS84: coordtransform.WGS84ToBD09},
+		{SRID: CGCS2000, Name: "China Geodetic Coordinate System 2000", Ellipsoid: coordtransform.CGCS2000Ellipsoid},
+	}
+	if bj54, err := coordtransform.Lookup(coordtransform.EPSGBeijing1954); err == nil {
+		alias := *bj54
+		alias.SRID = BJ54
+		crs = append(crs, &alias)
+	}
+	for _, v := range crs {
+		_ = coordtransform.Register(v)
+	}
+}
+
+// RegisterXA80 registers Xian 1980 with the helmert parameters transforming it to WGS84,
+// e.g. estimated by coordtransform.EstimateHelmertGeodetic from local control points.
+func RegisterXA80(params coordtransform.HelmertParameters) error {
+	return coordtransform.Register(coordtransform.HelmertCRS(XA80, "Xian 1980", coordtransform.IAG1975Ellipsoid, params))
+}
+
+// Transform returns the geometry reprojected from fromSRID to toSRID,
+// the srid are the keys of coordtransform registry, e.g. WGS84, GCJ02 or EPSG codes.
+// The input geometry is not changed.
+func Transform(geom Geometry, fromSRID, toSRID int) (Geometry, error) {
+	transformer, err := coordtransform.NewTransformerWithSRID(fromSRID, toSRID)
+	if err != nil {
+		return nil, err
+	}
+	if geom == nil || geom.IsEmpty() {
+		return geom, nil
+	}
+	result, err := transformer.TransformGeometry(unwrapValid(geom).ToMatrix())
+	if err != nil {
+		return nil, err
+	}
+	return wrapTransformed(geom, result, toSRID), nil
+}
+
+// TransformGeometries returns the geometries reprojected from fromSRID to toSRID in parallel
+// by a pool of workers, workers <= 0 means runtime.NumCPU().
+// The results keep the order of geoms, and the input geometries are not changed.
+func TransformGeometries(geoms []Geometry, fromSRID, toSRID, workers int) ([]Geometry, error) {
+	transformer, err := coordtransform.NewTransformerWithSRID(fromSRID, toSRID)
+	if err != nil {
+		return nil, err
+	}
+	stericList := make([]matrix.Steric, len(geoms))
+	for i, v := range geoms {
+		if v != nil && !v.IsEmpty() {
+			stericList[i] = unwrapValid(v).ToMatrix()
+		}
+	}
+	results, err := transformer.TransformGeometries(stericList, workers)
+	if err != nil {
+		return nil, err
+	}
+	transformed := make([]Geometry, len(geoms))
+	for i, v := range geoms {
+		if results[i] == nil {
+			transformed[i] = v
+			continue
+		}
+		transformed[i] = wrapTransformed(v, results[i], toSRID)
+	}
+	return transformed, nil
+}
+
+// unwrapValid returns the geometry wrapped by GeometryValid.
+func unwrapValid(geom Geometry) Geometry {
+	switch g := geom.(type) {
+	case GeometryValid:
+		return g.Geometry
+	case *GeometryValid:
+		return g.Geometry
+	}
+	return geom
+}
+
+// wrapTransformed returns the transformed steric as the same type of the origin geometry.
+func wrapTransformed(origin Geometry, result matrix.Steric, toSRID int) Geometry {
+	switch g := origin.(type) {
+	case GeometryValid:
+		return GeometryValid{wrapTransformed(g.Geometry, result, toSRID), toSRID}
+	case *GeometryValid:
+		return &GeometryValid{wrapTransformed(g.Geometry, result, toSRID), toSRID}
+	case Ring:
+		return Ring(result.(matrix.LineMatrix))
+	}
+	return TransGeometry(result)
+}
+
+// TransformTo returns the geometry reprojected from its coordinate system to toSRID.
+func TransformTo(geom Geometry, toSRID int) (Geometry, error) {
+	return Transform(geom, geom.CoordinateSystem(), toSRID)
+}
@@ -0,0 +1,107 @@
+package space
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/coordtransform"
+)
+
+func TestTransform(t *testing.T) {
+	utm50N := coordtransform.EPSGUTMNorth + 50
+	square := Polygon{{{500000, 4400000}, {501000, 4400000}, {501000, 4401000}, {500000, 4401000}, {500000, 4400000}}}
+	tests := []struct {
+		name             string
+		geom             Geometry
+		fromSRID, toSRID int
+	}{
+		{name: "point gcj02", geom: Point{116.3912757, 39.906217}, fromSRID: WGS84, toSRID: GCJ02},
+		{name: "line bd09 web", geom: LineString{{116.39, 39.90}, {116.40, 39.91}}, fromSRID: WGS84, toSRID: BD09Web},
+		{name: "polygon utm", geom: square, fromSRID: utm50N, toSRID: WGS84},
+		{name: "multi point cgcs2000", geom: MultiPoint{{116.39, 39.90}, {116.40, 39.91}}, fromSRID: CGCS2000, toSRID: GCJ02Web},
+		{name: "ring mercator", geom: Ring{{116.39, 39.90}, {116.40, 39.90}, {116.40, 39.91}, {116.39, 39.90}}, fromSRID: WGS84, toSRID: PseudoMercator},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Transform(tt.geom, tt.fromSRID, tt.toSRID)
+			if err != nil {
+				t.Fatalf("Transform() error = %v", err)
+			}
+			if got.GeoJSONType() != tt.geom.GeoJSONType() {
+				t.Errorf("Transform() type = %v, want %v", got.GeoJSONType(), tt.geom.GeoJSONType())
+			}
+			if got.EqualsExact(tt.geom, 1e-6) {
+				t.Errorf("Transform() got = %v, want changed", got)
+			}
+			back, _ := Transform(got, tt.toSRID, tt.fromSRID)
+			if !back.EqualsExact(tt.geom, 1e-6) {
+				t.Errorf("Transform() back = %v, want %v", back, tt.geom)
+			}
+		})
+	}
+
+	wgs, _ := Transform(square, utm50N, WGS84)
+	area, _ := wgs.Area()
+	utm, _ := Transform(wgs, WGS84, utm50N)
+	utmArea, _ := utm.Area()
+	if area > 1 || math.Abs(utmArea-1000000) > 1e-3 {
+		t.Errorf("Transform() area = %v %v, want %v", area, utmArea, 1000000)
+	}
+
+	valid, _ := CreateElementValidWithCoordSys(Point{116.3912757, 39.906217}, WGS84)
+	got, _ := TransformTo(valid, GCJ02)
+	if got.CoordinateSystem() != GCJ02 {
+		t.Errorf("TransformTo() coordinate system = %v, want %v", got.CoordinateSystem(), GCJ02)
+	}
+	if _, err := Transform(square, 1, WGS84); err == nil {
+		t.Errorf("Transform() error = nil, want unknown srid")
+	}
+}
+
+func TestRegisterXA80(t *testing.T) {
+	params := coordtransform.HelmertParameters{DX: -24.0, DY: 123.0, DZ: 94.0}
+	if err := RegisterXA80(params); err != nil {
+		t.Fatalf("RegisterXA80() error = %v", err)
+	}
+	for _, from := range []int{XA80, BJ54} {
+		got, err := Transform(Point{116.39, 39.9}, from, CGCS2000)
+		if err != nil {
+			t.Fatalf("Transform() error = %v", err)
+		}
+		if got.EqualsExact(Point{116.39, 39.9}, 1e-5) {
+			t.Errorf("Transform() got = %v, want shifted", got)
+		}
+	}
+}
+
+func TestTransformGeometries(t *testing.T) {
+	geoms := []Geometry{nil, Point{116.3912757, 39.906217}, LineString{{116.39, 39.90}, {116.40, 39.91}},
+		Ring{{116.39, 39.90}, {116.40, 39.90}, {116.40, 39.91}, {116.39, 39.90}}, MultiPolygon{}}
+	for i := 0; i < 200; i++ {
+		geoms = append(geoms, Polygon{{{116, 39}, {116 + float64(i)*0.001, 39}, {116, 39.1}, {116, 39}}})
+	}
+	valid, _ := CreateElementValidWithCoordSys(Point{116.3912757, 39.906217}, WGS84)
+	geoms = append(geoms, valid)
+	got, err := TransformGeometries(geoms, WGS84, GCJ02, 8)
+	if err != nil {
+		t.Fatalf("TransformGeometries() error = %v", err)
+	}
+	if len(got) != len(geoms) || got[0] != nil {
+		t.Fatalf("TransformGeometries() got = %v", got)
+	}
+	for i, v := range geoms[1 : len(geoms)-1] {
+		want, _ := Transform(v, WGS84, GCJ02)
+		if !got[i+1].EqualsExact(want, 0) || got[i+1].GeoJSONType() != v.GeoJSONType() {
+			t.Errorf("TransformGeometries() got = %v, want %v", got[i+1], want)
+		}
+	}
+	if got[len(got)-1].CoordinateSystem() != GCJ02 {
+		t.Errorf("TransformGeometries() coordinate system = %v, want %v", got[len(got)-1].CoordinateSystem(), GCJ02)
+	}
+	if !geoms[1].Equals(Point{116.3912757, 39.906217}) {
+		t.Errorf("TransformGeometries() changed the input geometry %v", geoms[1])
+	}
+	if _, err := TransformGeometries(geoms, 1, WGS84, 0); err == nil {
+		t.Errorf("TransformGeometries() error = nil, want unknown srid")
+	}
+}